@@ -40,11 +40,17 @@ import (
 	apiutil "k8s.io/kubernetes/pkg/api/util"
 	"k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/apiserver"
+	rbacauthorizer "k8s.io/kubernetes/pkg/auth/authorizer/rbac"
 	"k8s.io/kubernetes/pkg/capabilities"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/cloudprovider"
 	"k8s.io/kubernetes/pkg/master"
 	"k8s.io/kubernetes/pkg/master/ports"
+	clusterroleetcd "k8s.io/kubernetes/pkg/registry/clusterrole/etcd"
+	clusterrolebindingetcd "k8s.io/kubernetes/pkg/registry/clusterrolebinding/etcd"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	roleetcd "k8s.io/kubernetes/pkg/registry/role/etcd"
+	rolebindingetcd "k8s.io/kubernetes/pkg/registry/rolebinding/etcd"
 	"k8s.io/kubernetes/pkg/storage"
 	"k8s.io/kubernetes/pkg/tools"
 	"k8s.io/kubernetes/pkg/util"
@@ -94,6 +100,7 @@ type APIServer struct {
 	KeystoneURL                string
 	AuthorizationMode          string
 	AuthorizationPolicyFile    string
+	AuthorizationRBACSuperUser string
 	AdmissionControl           string
 	AdmissionControlConfigFile string
 	EtcdServerList             []string
@@ -118,6 +125,16 @@ type APIServer struct {
 	SSHKeyfile                 string
 	MaxConnectionBytesPerSec   int64
 	KubernetesServiceNodePort  int
+	AuditLogPath               string
+	AuditLogMaxAge             int
+	AuditLogMaxBackups         int
+	AuditLogMaxSize            int
+
+	WebhookTokenAuthnConfigFile              string
+	WebhookTokenAuthnCacheTTL                time.Duration
+	AuthorizationWebhookConfigFile           string
+	AuthorizationWebhookCacheAuthorizedTTL   time.Duration
+	AuthorizationWebhookCacheUnauthorizedTTL time.Duration
 }
 
 // NewAPIServer creates a new APIServer object with default parameters
@@ -145,6 +162,10 @@ func NewAPIServer() *APIServer {
 			EnableHttps: true,
 			HTTPTimeout: time.Duration(5) * time.Second,
 		},
+
+		WebhookTokenAuthnCacheTTL:                2 * time.Minute,
+		AuthorizationWebhookCacheAuthorizedTTL:   5 * time.Minute,
+		AuthorizationWebhookCacheUnauthorizedTTL: 30 * time.Second,
 	}
 
 	return &s
@@ -227,8 +248,14 @@ func (s *APIServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.ServiceAccountKeyFile, "service-account-key-file", s.ServiceAccountKeyFile, "File containing PEM-encoded x509 RSA private or public key, used to verify ServiceAccount tokens. If unspecified, --tls-private-key-file is used.")
 	fs.BoolVar(&s.ServiceAccountLookup, "service-account-lookup", s.ServiceAccountLookup, "If true, validate ServiceAccount tokens exist in etcd as part of authentication.")
 	fs.StringVar(&s.KeystoneURL, "experimental-keystone-url", s.KeystoneURL, "If passed, activates the keystone authentication plugin")
+	fs.StringVar(&s.WebhookTokenAuthnConfigFile, "authentication-token-webhook-config-file", s.WebhookTokenAuthnConfigFile, "File with webhook configuration for token authentication in kubeconfig format. The API server will query the remote service to determine authentication for bearer tokens.")
+	fs.DurationVar(&s.WebhookTokenAuthnCacheTTL, "authentication-token-webhook-cache-ttl", s.WebhookTokenAuthnCacheTTL, "The duration to cache responses from the webhook token authenticator.")
 	fs.StringVar(&s.AuthorizationMode, "authorization-mode", s.AuthorizationMode, "Ordered list of plug-ins to do authorization on secure port. Comma-delimited list of: "+strings.Join(apiserver.AuthorizationModeChoices, ","))
 	fs.StringVar(&s.AuthorizationPolicyFile, "authorization-policy-file", s.AuthorizationPolicyFile, "File with authorization policy in csv format, used with --authorization-mode=ABAC, on the secure port.")
+	fs.StringVar(&s.AuthorizationRBACSuperUser, "authorization-rbac-super-user", s.AuthorizationRBACSuperUser, "If specified, a username which avoids RBAC authorization checks and role binding privilege escalation checks, to be used with --authorization-mode=RBAC.")
+	fs.StringVar(&s.AuthorizationWebhookConfigFile, "authorization-webhook-config-file", s.AuthorizationWebhookConfigFile, "File with webhook configuration in kubeconfig format, used with --authorization-mode=Webhook. The API server will query the remote service to determine access on the API server's secure port.")
+	fs.DurationVar(&s.AuthorizationWebhookCacheAuthorizedTTL, "authorization-webhook-cache-authorized-ttl", s.AuthorizationWebhookCacheAuthorizedTTL, "The duration to cache 'authorized' responses from the webhook authorizer.")
+	fs.DurationVar(&s.AuthorizationWebhookCacheUnauthorizedTTL, "authorization-webhook-cache-unauthorized-ttl", s.AuthorizationWebhookCacheUnauthorizedTTL, "The duration to cache 'unauthorized' responses from the webhook authorizer.")
 	fs.StringVar(&s.AdmissionControl, "admission-control", s.AdmissionControl, "Ordered list of plug-ins to do admission control of resources into cluster. Comma-delimited list of: "+strings.Join(admission.GetPlugins(), ", "))
 	fs.StringVar(&s.AdmissionControlConfigFile, "admission-control-config-file", s.AdmissionControlConfigFile, "File with admission control configuration.")
 	fs.StringSliceVar(&s.EtcdServerList, "etcd-servers", s.EtcdServerList, "List of etcd servers to watch (http://ip:port), comma separated. Mutually exclusive with -etcd-config")
@@ -247,6 +274,10 @@ func (s *APIServer) AddFlags(fs *pflag.FlagSet) {
 	fs.Var(&s.RuntimeConfig, "runtime-config", "A set of key=value pairs that describe runtime configuration that may be passed to apiserver. apis/<groupVersion> key can be used to turn on/off specific api versions. apis/<groupVersion>/<resource> can be used to turn on/off specific resources. api/all and api/legacy are special keys to control all and legacy api versions respectively.")
 	fs.StringVar(&s.ClusterName, "cluster-name", s.ClusterName, "The instance prefix for the cluster")
 	fs.BoolVar(&s.EnableProfiling, "profiling", true, "Enable profiling via web interface host:port/debug/pprof/")
+	fs.StringVar(&s.AuditLogPath, "audit-log-path", s.AuditLogPath, "If set, all requests coming to the apiserver will be logged to this file, one JSON record per line.")
+	fs.IntVar(&s.AuditLogMaxAge, "audit-log-maxage", s.AuditLogMaxAge, "The maximum number of days to retain old audit log files based on the timestamp encoded in their filename.")
+	fs.IntVar(&s.AuditLogMaxBackups, "audit-log-maxbackup", s.AuditLogMaxBackups, "The maximum number of old audit log files to retain.")
+	fs.IntVar(&s.AuditLogMaxSize, "audit-log-maxsize", s.AuditLogMaxSize, "The maximum size in megabytes of the audit log file before it gets rotated. Defaults to 100MB.")
 	// TODO: enable cache in integration tests.
 	fs.BoolVar(&s.EnableWatchCache, "watch-cache", true, "Enable watch caching in the apiserver")
 	fs.StringVar(&s.ExternalHost, "external-hostname", "", "The hostname to use when generating externalized URLs for this master (e.g. Swagger API Docs.)")
@@ -431,6 +462,10 @@ func (s *APIServer) Run(_ []string) error {
 	if err != nil {
 		glog.Fatalf("error in parsing runtime-config: %s", err)
 	}
+	// The rbac API is not in every KUBE_API_VERSIONS setting; it is only served when registered.
+	if _, err := latest.Group("rbac"); err != nil {
+		apiGroupVersionOverrides["rbac/v1alpha1"] = master.APIGroupVersionOverride{Disable: true}
+	}
 
 	clientConfig := &client.Config{
 		Host: net.JoinHostPort(s.InsecureBindAddress.String(), strconv.Itoa(s.InsecurePort)),
@@ -480,6 +515,18 @@ func (s *APIServer) Run(_ []string) error {
 		storageDestinations.AddAPIGroup("extensions", expEtcdStorage)
 	}
 
+	if !apiGroupVersionOverrides["rbac/v1alpha1"].Disable {
+		rbacGroup := latest.GroupOrDie("rbac")
+		if _, found := storageVersions[rbacGroup.Group]; !found {
+			glog.Fatalf("Couldn't find the storage version for group: %q in storageVersions: %v", rbacGroup.Group, storageVersions)
+		}
+		rbacEtcdStorage, err := newEtcd(s.EtcdConfigFile, s.EtcdServerList, rbacGroup.InterfacesFor, storageVersions[rbacGroup.Group], s.EtcdPathPrefix)
+		if err != nil {
+			glog.Fatalf("Invalid rbac storage version or misconfigured etcd: %v", err)
+		}
+		storageDestinations.AddAPIGroup("rbac", rbacEtcdStorage)
+	}
+
 	updateEtcdOverrides(s.EtcdServersOverrides, storageVersions, s.EtcdPathPrefix, &storageDestinations, newEtcd)
 
 	n := s.ServiceClusterIPRange
@@ -504,6 +551,9 @@ func (s *APIServer) Run(_ []string) error {
 		ServiceAccountLookup:  s.ServiceAccountLookup,
 		Storage:               etcdStorage,
 		KeystoneURL:           s.KeystoneURL,
+
+		WebhookTokenAuthnConfigFile: s.WebhookTokenAuthnConfigFile,
+		WebhookTokenAuthnCacheTTL:   s.WebhookTokenAuthnCacheTTL,
 	})
 
 	if err != nil {
@@ -511,7 +561,31 @@ func (s *APIServer) Run(_ []string) error {
 	}
 
 	authorizationModeNames := strings.Split(s.AuthorizationMode, ",")
-	authorizer, err := apiserver.NewAuthorizerFromAuthorizationConfig(authorizationModeNames, s.AuthorizationPolicyFile)
+	authorizationConfig := apiserver.AuthorizationConfig{
+		PolicyFile:    s.AuthorizationPolicyFile,
+		RBACSuperUser: s.AuthorizationRBACSuperUser,
+
+		WebhookConfigFile:           s.AuthorizationWebhookConfigFile,
+		WebhookCacheAuthorizedTTL:   s.AuthorizationWebhookCacheAuthorizedTTL,
+		WebhookCacheUnauthorizedTTL: s.AuthorizationWebhookCacheUnauthorizedTTL,
+	}
+	for _, mode := range authorizationModeNames {
+		if mode != apiserver.ModeRBAC {
+			continue
+		}
+		if apiGroupVersionOverrides["rbac/v1alpha1"].Disable {
+			glog.Fatalf("RBAC authorization mode requires the rbac/v1alpha1 API to be enabled")
+		}
+		// The authorizer reads roles and bindings straight from storage into its caches.
+		authorizationConfig.RBACRuleResolver = rbacauthorizer.NewCachedRuleResolver(
+			roleetcd.NewREST(storageDestinations.Get("rbac", "roles"), generic.UndecoratedStorage),
+			rolebindingetcd.NewREST(storageDestinations.Get("rbac", "rolebindings"), generic.UndecoratedStorage),
+			clusterroleetcd.NewREST(storageDestinations.Get("rbac", "clusterroles"), generic.UndecoratedStorage),
+			clusterrolebindingetcd.NewREST(storageDestinations.Get("rbac", "clusterrolebindings"), generic.UndecoratedStorage),
+			util.NeverStop,
+		)
+	}
+	authorizer, err := apiserver.NewAuthorizerFromAuthorizationConfig(authorizationModeNames, authorizationConfig)
 	if err != nil {
 		glog.Fatalf("Invalid Authorization Config: %v", err)
 	}
@@ -564,6 +638,11 @@ func (s *APIServer) Run(_ []string) error {
 		Authenticator:             authenticator,
 		SupportsBasicAuth:         len(s.BasicAuthFile) > 0,
 		Authorizer:                authorizer,
+		AuthorizerRBACSuperUser:   s.AuthorizationRBACSuperUser,
+		AuditLogPath:              s.AuditLogPath,
+		AuditLogMaxAge:            s.AuditLogMaxAge,
+		AuditLogMaxBackups:        s.AuditLogMaxBackups,
+		AuditLogMaxSize:           s.AuditLogMaxSize,
 		AdmissionControl:          admissionController,
 		APIGroupVersionOverrides:  apiGroupVersionOverrides,
 		MasterServiceNamespace:    s.MasterServiceNamespace,
@@ -728,9 +807,21 @@ func (s *APIServer) parseRuntimeConfig() (map[string]master.APIGroupVersionOverr
 		}
 	}
 
+	// "rbac/v1alpha1={true|false} allows users to enable/disable the rbac API.
+	// This takes preference over api/all, if specified.
+	rbacGroupVersion := "rbac/v1alpha1"
+	disableRBAC := !s.getRuntimeConfigValue(rbacGroupVersion, !disableAllAPIs)
+	if disableRBAC {
+		apiGroupVersionOverrides[rbacGroupVersion] = master.APIGroupVersionOverride{
+			Disable: true,
+		}
+	}
+
 	for key := range s.RuntimeConfig {
 		if strings.HasPrefix(key, v1GroupVersion+"/") {
 			return nil, fmt.Errorf("api/v1 resources cannot be enabled/disabled individually")
+		} else if strings.HasPrefix(key, rbacGroupVersion+"/") {
+			return nil, fmt.Errorf("rbac/v1alpha1 resources cannot be enabled/disabled individually")
 		} else if strings.HasPrefix(key, extensionsGroupVersion+"/") {
 			resource := strings.TrimPrefix(key, extensionsGroupVersion+"/")
 
@@ -198,6 +198,26 @@ func TestParseRuntimeConfig(t *testing.T) {
 			},
 			err: false,
 		},
+		{
+			// Disable rbac.
+			runtimeConfig: map[string]string{
+				"rbac/v1alpha1": "false",
+			},
+			apiGroupVersionOverrides: map[string]master.APIGroupVersionOverride{
+				"rbac/v1alpha1": {
+					Disable: true,
+				},
+			},
+			err: false,
+		},
+		{
+			// Cannot override rbac resources.
+			runtimeConfig: map[string]string{
+				"rbac/v1alpha1/roles": "false",
+			},
+			apiGroupVersionOverrides: map[string]master.APIGroupVersionOverride{},
+			err: true,
+		},
 		{
 			// Disable deployments.
 			runtimeConfig: map[string]string{
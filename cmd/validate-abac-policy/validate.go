@@ -0,0 +1,61 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// validate-abac-policy is a simple utility for checking ABAC policy files
+// before handing them to the apiserver with --authorization-policy-file.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"k8s.io/kubernetes/pkg/auth/authorizer/abac"
+	"k8s.io/kubernetes/pkg/util"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	flag "github.com/spf13/pflag"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s POLICY_FILE...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.CommandLine.SetNormalizeFunc(util.WordSepNormalizeFunc)
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	valid := true
+	for _, path := range flag.Args() {
+		if _, err := abac.NewFromFile(path); err != nil {
+			valid = false
+			if agg, ok := err.(utilerrors.Aggregate); ok {
+				for _, err := range agg.Errors() {
+					fmt.Fprintln(os.Stderr, err)
+				}
+			} else {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			}
+		}
+	}
+	if !valid {
+		os.Exit(1)
+	}
+}
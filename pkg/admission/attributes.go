@@ -76,3 +76,21 @@ func (record *attributesRecord) GetObject() runtime.Object {
 func (record *attributesRecord) GetUserInfo() user.Info {
 	return record.userInfo
 }
+
+func (record *attributesRecord) IsDryRun() bool {
+	return false
+}
+
+// dryRunAttributes marks the wrapped attributes as belonging to a dry run.
+type dryRunAttributes struct {
+	Attributes
+}
+
+// NewDryRunAttributes returns a copy of a that reports the request as a dry run.
+func NewDryRunAttributes(a Attributes) Attributes {
+	return &dryRunAttributes{a}
+}
+
+func (a *dryRunAttributes) IsDryRun() bool {
+	return true
+}
@@ -44,6 +44,9 @@ type Attributes interface {
 	GetKind() string
 	// GetUserInfo is information about the requesting user
 	GetUserInfo() user.Info
+	// IsDryRun indicates that the changes of the request will not be persisted. Admission controllers
+	// with side effects, such as recording quota usage, must not perform them for a dry run.
+	IsDryRun() bool
 }
 
 // Interface is an abstract, pluggable interface for Admission Control decisions.
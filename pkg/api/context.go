@@ -55,6 +55,9 @@ const namespaceKey key = 0
 // userKey is the context key for the request user.
 const userKey key = 1
 
+// dryRunKey is the context key for requests that must not be persisted.
+const dryRunKey key = 2
+
 // NewContext instantiates a base context object for request flows.
 func NewContext() Context {
 	return context.TODO()
@@ -119,3 +122,15 @@ func UserFrom(ctx Context) (user.Info, bool) {
 	user, ok := ctx.Value(userKey).(user.Info)
 	return user, ok
 }
+
+// WithDryRun returns a copy of parent marking the request as a dry run, whose
+// changes are validated and admitted but never persisted.
+func WithDryRun(parent Context) Context {
+	return WithValue(parent, dryRunKey, true)
+}
+
+// IsDryRun returns true if the request on the ctx is a dry run.
+func IsDryRun(ctx Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey).(bool)
+	return dryRun
+}
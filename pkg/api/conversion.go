@@ -44,6 +44,10 @@ func init() {
 			obj.Stderr = true
 			obj.Stdout = true
 		},
+		func(obj *PodDebugOptions) {
+			obj.Stderr = true
+			obj.Stdout = true
+		},
 	)
 	Scheme.AddConversionFuncs(
 		func(in *unversioned.Time, out *unversioned.Time, s conversion.Scope) error {
@@ -37,6 +37,34 @@ func deepCopy_api_AWSElasticBlockStoreVolumeSource(in AWSElasticBlockStoreVolume
 	return nil
 }
 
+func deepCopy_api_Affinity(in Affinity, out *Affinity, c *conversion.Cloner) error {
+	if in.NodeAffinity != nil {
+		out.NodeAffinity = new(NodeAffinity)
+		if err := deepCopy_api_NodeAffinity(*in.NodeAffinity, out.NodeAffinity, c); err != nil {
+			return err
+		}
+	} else {
+		out.NodeAffinity = nil
+	}
+	if in.PodAffinity != nil {
+		out.PodAffinity = new(PodAffinity)
+		if err := deepCopy_api_PodAffinity(*in.PodAffinity, out.PodAffinity, c); err != nil {
+			return err
+		}
+	} else {
+		out.PodAffinity = nil
+	}
+	if in.PodAntiAffinity != nil {
+		out.PodAntiAffinity = new(PodAntiAffinity)
+		if err := deepCopy_api_PodAntiAffinity(*in.PodAntiAffinity, out.PodAntiAffinity, c); err != nil {
+			return err
+		}
+	} else {
+		out.PodAntiAffinity = nil
+	}
+	return nil
+}
+
 func deepCopy_api_Binding(in Binding, out *Binding, c *conversion.Cloner) error {
 	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
 		return err
@@ -148,6 +176,69 @@ func deepCopy_api_ComponentStatusList(in ComponentStatusList, out *ComponentStat
 	return nil
 }
 
+func deepCopy_api_ConfigMap(in ConfigMap, out *ConfigMap, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_ObjectMeta(in.ObjectMeta, &out.ObjectMeta, c); err != nil {
+		return err
+	}
+	if in.Data != nil {
+		out.Data = make(map[string]string)
+		for key, val := range in.Data {
+			out.Data[key] = val
+		}
+	} else {
+		out.Data = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ConfigMapKeySelector(in ConfigMapKeySelector, out *ConfigMapKeySelector, c *conversion.Cloner) error {
+	if err := deepCopy_api_LocalObjectReference(in.LocalObjectReference, &out.LocalObjectReference, c); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func deepCopy_api_ConfigMapList(in ConfigMapList, out *ConfigMapList, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_unversioned_ListMeta(in.ListMeta, &out.ListMeta, c); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]ConfigMap, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_ConfigMap(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ConfigMapVolumeSource(in ConfigMapVolumeSource, out *ConfigMapVolumeSource, c *conversion.Cloner) error {
+	if err := deepCopy_api_LocalObjectReference(in.LocalObjectReference, &out.LocalObjectReference, c); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]KeyToPath, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_KeyToPath(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
 func deepCopy_api_Container(in Container, out *Container, c *conversion.Cloner) error {
 	out.Name = in.Name
 	out.Image = in.Image
@@ -484,6 +575,22 @@ func deepCopy_api_EnvVarSource(in EnvVarSource, out *EnvVarSource, c *conversion
 	} else {
 		out.FieldRef = nil
 	}
+	if in.ConfigMapKeyRef != nil {
+		out.ConfigMapKeyRef = new(ConfigMapKeySelector)
+		if err := deepCopy_api_ConfigMapKeySelector(*in.ConfigMapKeyRef, out.ConfigMapKeyRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapKeyRef = nil
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(SecretKeySelector)
+		if err := deepCopy_api_SecretKeySelector(*in.SecretKeyRef, out.SecretKeyRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
 	return nil
 }
 
@@ -539,6 +646,24 @@ func deepCopy_api_EventSource(in EventSource, out *EventSource, c *conversion.Cl
 	return nil
 }
 
+func deepCopy_api_Eviction(in Eviction, out *Eviction, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_ObjectMeta(in.ObjectMeta, &out.ObjectMeta, c); err != nil {
+		return err
+	}
+	if in.DeleteOptions != nil {
+		out.DeleteOptions = new(DeleteOptions)
+		if err := deepCopy_api_DeleteOptions(*in.DeleteOptions, out.DeleteOptions, c); err != nil {
+			return err
+		}
+	} else {
+		out.DeleteOptions = nil
+	}
+	return nil
+}
+
 func deepCopy_api_ExecAction(in ExecAction, out *ExecAction, c *conversion.Cloner) error {
 	if in.Command != nil {
 		out.Command = make([]string, len(in.Command))
@@ -649,6 +774,12 @@ func deepCopy_api_ISCSIVolumeSource(in ISCSIVolumeSource, out *ISCSIVolumeSource
 	return nil
 }
 
+func deepCopy_api_KeyToPath(in KeyToPath, out *KeyToPath, c *conversion.Cloner) error {
+	out.Key = in.Key
+	out.Path = in.Path
+	return nil
+}
+
 func deepCopy_api_Lifecycle(in Lifecycle, out *Lifecycle, c *conversion.Cloner) error {
 	if in.PostStart != nil {
 		out.PostStart = new(Handler)
@@ -912,6 +1043,28 @@ func deepCopy_api_NodeAddress(in NodeAddress, out *NodeAddress, c *conversion.Cl
 	return nil
 }
 
+func deepCopy_api_NodeAffinity(in NodeAffinity, out *NodeAffinity, c *conversion.Cloner) error {
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = new(NodeSelector)
+		if err := deepCopy_api_NodeSelector(*in.RequiredDuringSchedulingIgnoredDuringExecution, out.RequiredDuringSchedulingIgnoredDuringExecution, c); err != nil {
+			return err
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]PreferredSchedulingTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_PreferredSchedulingTerm(in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
 func deepCopy_api_NodeCondition(in NodeCondition, out *NodeCondition, c *conversion.Cloner) error {
 	out.Type = in.Type
 	out.Status = in.Status
@@ -953,11 +1106,63 @@ func deepCopy_api_NodeList(in NodeList, out *NodeList, c *conversion.Cloner) err
 	return nil
 }
 
+func deepCopy_api_NodeSelector(in NodeSelector, out *NodeSelector, c *conversion.Cloner) error {
+	if in.NodeSelectorTerms != nil {
+		out.NodeSelectorTerms = make([]NodeSelectorTerm, len(in.NodeSelectorTerms))
+		for i := range in.NodeSelectorTerms {
+			if err := deepCopy_api_NodeSelectorTerm(in.NodeSelectorTerms[i], &out.NodeSelectorTerms[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.NodeSelectorTerms = nil
+	}
+	return nil
+}
+
+func deepCopy_api_NodeSelectorRequirement(in NodeSelectorRequirement, out *NodeSelectorRequirement, c *conversion.Cloner) error {
+	out.Key = in.Key
+	out.Operator = in.Operator
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func deepCopy_api_NodeSelectorTerm(in NodeSelectorTerm, out *NodeSelectorTerm, c *conversion.Cloner) error {
+	if in.MatchExpressions != nil {
+		out.MatchExpressions = make([]NodeSelectorRequirement, len(in.MatchExpressions))
+		for i := range in.MatchExpressions {
+			if err := deepCopy_api_NodeSelectorRequirement(in.MatchExpressions[i], &out.MatchExpressions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchExpressions = nil
+	}
+	return nil
+}
+
 func deepCopy_api_NodeSpec(in NodeSpec, out *NodeSpec, c *conversion.Cloner) error {
 	out.PodCIDR = in.PodCIDR
 	out.ExternalID = in.ExternalID
 	out.ProviderID = in.ProviderID
 	out.Unschedulable = in.Unschedulable
+	if in.Taints != nil {
+		out.Taints = make([]Taint, len(in.Taints))
+		for i := range in.Taints {
+			if err := deepCopy_api_Taint(in.Taints[i], &out.Taints[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Taints = nil
+	}
 	return nil
 }
 
@@ -1347,6 +1552,75 @@ func deepCopy_api_Pod(in Pod, out *Pod, c *conversion.Cloner) error {
 	return nil
 }
 
+func deepCopy_api_PodAffinity(in PodAffinity, out *PodAffinity, c *conversion.Cloner) error {
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_PodAffinityTerm(in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_WeightedPodAffinityTerm(in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PodAffinityTerm(in PodAffinityTerm, out *PodAffinityTerm, c *conversion.Cloner) error {
+	if in.LabelSelector != nil {
+		out.LabelSelector = make(map[string]string)
+		for key, val := range in.LabelSelector {
+			out.LabelSelector[key] = val
+		}
+	} else {
+		out.LabelSelector = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.TopologyKey = in.TopologyKey
+	return nil
+}
+
+func deepCopy_api_PodAntiAffinity(in PodAntiAffinity, out *PodAntiAffinity, c *conversion.Cloner) error {
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_PodAffinityTerm(in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_WeightedPodAffinityTerm(in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
 func deepCopy_api_PodAttachOptions(in PodAttachOptions, out *PodAttachOptions, c *conversion.Cloner) error {
 	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
 		return err
@@ -1373,6 +1647,28 @@ func deepCopy_api_PodCondition(in PodCondition, out *PodCondition, c *conversion
 	return nil
 }
 
+func deepCopy_api_PodDebugOptions(in PodDebugOptions, out *PodDebugOptions, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	out.Stdin = in.Stdin
+	out.Stdout = in.Stdout
+	out.Stderr = in.Stderr
+	out.TTY = in.TTY
+	out.Container = in.Container
+	out.Target = in.Target
+	out.Image = in.Image
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	return nil
+}
+
 func deepCopy_api_PodExecOptions(in PodExecOptions, out *PodExecOptions, c *conversion.Cloner) error {
 	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
 		return err
@@ -1562,6 +1858,24 @@ func deepCopy_api_PodSpec(in PodSpec, out *PodSpec, c *conversion.Cloner) error
 	} else {
 		out.ImagePullSecrets = nil
 	}
+	if in.Affinity != nil {
+		out.Affinity = new(Affinity)
+		if err := deepCopy_api_Affinity(*in.Affinity, out.Affinity, c); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	if in.Tolerations != nil {
+		out.Tolerations = make([]Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := deepCopy_api_Toleration(in.Tolerations[i], &out.Tolerations[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
 	return nil
 }
 
@@ -1658,6 +1972,14 @@ func deepCopy_api_PodTemplateSpec(in PodTemplateSpec, out *PodTemplateSpec, c *c
 	return nil
 }
 
+func deepCopy_api_PreferredSchedulingTerm(in PreferredSchedulingTerm, out *PreferredSchedulingTerm, c *conversion.Cloner) error {
+	out.Weight = in.Weight
+	if err := deepCopy_api_NodeSelectorTerm(in.Preference, &out.Preference, c); err != nil {
+		return err
+	}
+	return nil
+}
+
 func deepCopy_api_Probe(in Probe, out *Probe, c *conversion.Cloner) error {
 	if err := deepCopy_api_Handler(in.Handler, &out.Handler, c); err != nil {
 		return err
@@ -1917,6 +2239,14 @@ func deepCopy_api_Secret(in Secret, out *Secret, c *conversion.Cloner) error {
 	return nil
 }
 
+func deepCopy_api_SecretKeySelector(in SecretKeySelector, out *SecretKeySelector, c *conversion.Cloner) error {
+	if err := deepCopy_api_LocalObjectReference(in.LocalObjectReference, &out.LocalObjectReference, c); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
 func deepCopy_api_SecretList(in SecretList, out *SecretList, c *conversion.Cloner) error {
 	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
 		return err
@@ -2135,6 +2465,21 @@ func deepCopy_api_TCPSocketAction(in TCPSocketAction, out *TCPSocketAction, c *c
 	return nil
 }
 
+func deepCopy_api_Taint(in Taint, out *Taint, c *conversion.Cloner) error {
+	out.Key = in.Key
+	out.Value = in.Value
+	out.Effect = in.Effect
+	return nil
+}
+
+func deepCopy_api_Toleration(in Toleration, out *Toleration, c *conversion.Cloner) error {
+	out.Key = in.Key
+	out.Operator = in.Operator
+	out.Value = in.Value
+	out.Effect = in.Effect
+	return nil
+}
+
 func deepCopy_api_Volume(in Volume, out *Volume, c *conversion.Cloner) error {
 	out.Name = in.Name
 	if err := deepCopy_api_VolumeSource(in.VolumeSource, &out.VolumeSource, c); err != nil {
@@ -2279,6 +2624,22 @@ func deepCopy_api_VolumeSource(in VolumeSource, out *VolumeSource, c *conversion
 	} else {
 		out.FC = nil
 	}
+	if in.ConfigMap != nil {
+		out.ConfigMap = new(ConfigMapVolumeSource)
+		if err := deepCopy_api_ConfigMapVolumeSource(*in.ConfigMap, out.ConfigMap, c); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMap = nil
+	}
+	return nil
+}
+
+func deepCopy_api_WeightedPodAffinityTerm(in WeightedPodAffinityTerm, out *WeightedPodAffinityTerm, c *conversion.Cloner) error {
+	out.Weight = in.Weight
+	if err := deepCopy_api_PodAffinityTerm(in.PodAffinityTerm, &out.PodAffinityTerm, c); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2327,6 +2688,7 @@ func deepCopy_intstr_IntOrString(in intstr.IntOrString, out *intstr.IntOrString,
 func init() {
 	err := Scheme.AddGeneratedDeepCopyFuncs(
 		deepCopy_api_AWSElasticBlockStoreVolumeSource,
+		deepCopy_api_Affinity,
 		deepCopy_api_Binding,
 		deepCopy_api_Capabilities,
 		deepCopy_api_CephFSVolumeSource,
@@ -2334,6 +2696,10 @@ func init() {
 		deepCopy_api_ComponentCondition,
 		deepCopy_api_ComponentStatus,
 		deepCopy_api_ComponentStatusList,
+		deepCopy_api_ConfigMap,
+		deepCopy_api_ConfigMapKeySelector,
+		deepCopy_api_ConfigMapList,
+		deepCopy_api_ConfigMapVolumeSource,
 		deepCopy_api_Container,
 		deepCopy_api_ContainerPort,
 		deepCopy_api_ContainerState,
@@ -2356,6 +2722,7 @@ func init() {
 		deepCopy_api_Event,
 		deepCopy_api_EventList,
 		deepCopy_api_EventSource,
+		deepCopy_api_Eviction,
 		deepCopy_api_ExecAction,
 		deepCopy_api_FCVolumeSource,
 		deepCopy_api_FlockerVolumeSource,
@@ -2366,6 +2733,7 @@ func init() {
 		deepCopy_api_Handler,
 		deepCopy_api_HostPathVolumeSource,
 		deepCopy_api_ISCSIVolumeSource,
+		deepCopy_api_KeyToPath,
 		deepCopy_api_Lifecycle,
 		deepCopy_api_LimitRange,
 		deepCopy_api_LimitRangeItem,
@@ -2382,9 +2750,13 @@ func init() {
 		deepCopy_api_NamespaceStatus,
 		deepCopy_api_Node,
 		deepCopy_api_NodeAddress,
+		deepCopy_api_NodeAffinity,
 		deepCopy_api_NodeCondition,
 		deepCopy_api_NodeDaemonEndpoints,
 		deepCopy_api_NodeList,
+		deepCopy_api_NodeSelector,
+		deepCopy_api_NodeSelectorRequirement,
+		deepCopy_api_NodeSelectorTerm,
 		deepCopy_api_NodeSpec,
 		deepCopy_api_NodeStatus,
 		deepCopy_api_NodeSystemInfo,
@@ -2402,8 +2774,12 @@ func init() {
 		deepCopy_api_PersistentVolumeSpec,
 		deepCopy_api_PersistentVolumeStatus,
 		deepCopy_api_Pod,
+		deepCopy_api_PodAffinity,
+		deepCopy_api_PodAffinityTerm,
+		deepCopy_api_PodAntiAffinity,
 		deepCopy_api_PodAttachOptions,
 		deepCopy_api_PodCondition,
+		deepCopy_api_PodDebugOptions,
 		deepCopy_api_PodExecOptions,
 		deepCopy_api_PodList,
 		deepCopy_api_PodLogOptions,
@@ -2415,6 +2791,7 @@ func init() {
 		deepCopy_api_PodTemplate,
 		deepCopy_api_PodTemplateList,
 		deepCopy_api_PodTemplateSpec,
+		deepCopy_api_PreferredSchedulingTerm,
 		deepCopy_api_Probe,
 		deepCopy_api_RBDVolumeSource,
 		deepCopy_api_RangeAllocation,
@@ -2429,6 +2806,7 @@ func init() {
 		deepCopy_api_ResourceRequirements,
 		deepCopy_api_SELinuxOptions,
 		deepCopy_api_Secret,
+		deepCopy_api_SecretKeySelector,
 		deepCopy_api_SecretList,
 		deepCopy_api_SecretVolumeSource,
 		deepCopy_api_SecurityContext,
@@ -2441,9 +2819,12 @@ func init() {
 		deepCopy_api_ServiceSpec,
 		deepCopy_api_ServiceStatus,
 		deepCopy_api_TCPSocketAction,
+		deepCopy_api_Taint,
+		deepCopy_api_Toleration,
 		deepCopy_api_Volume,
 		deepCopy_api_VolumeMount,
 		deepCopy_api_VolumeSource,
+		deepCopy_api_WeightedPodAffinityTerm,
 		deepCopy_resource_Quantity,
 		deepCopy_unversioned_ListMeta,
 		deepCopy_unversioned_Time,
@@ -230,6 +230,21 @@ func NewServerTimeout(kind, operation string, retryAfterSeconds int) error {
 	}}
 }
 
+// NewTooManyRequests creates an error that indicates that the client must try again later because
+// the specified endpoint is not accepting requests. More specific details should be provided
+// if client should know why the failure was limited.
+func NewTooManyRequests(message string, retryAfterSeconds int) error {
+	return &StatusError{unversioned.Status{
+		Status:  unversioned.StatusFailure,
+		Code:    StatusTooManyRequests,
+		Reason:  unversioned.StatusReasonTooManyRequests,
+		Message: message,
+		Details: &unversioned.StatusDetails{
+			RetryAfterSeconds: int32(retryAfterSeconds),
+		},
+	}}
+}
+
 // NewInternalError returns an error indicating the item is invalid and cannot be processed.
 func NewInternalError(err error) error {
 	return &StatusError{unversioned.Status{
@@ -380,6 +395,12 @@ func IsServerTimeout(err error) bool {
 	return reasonForError(err) == unversioned.StatusReasonServerTimeout
 }
 
+// IsTooManyRequests determines if err is an error which indicates that there are too many requests
+// that the server cannot handle.
+func IsTooManyRequests(err error) bool {
+	return reasonForError(err) == unversioned.StatusReasonTooManyRequests
+}
+
 // IsUnexpectedServerError returns true if the server response was not in the expected API format,
 // and may be the result of another HTTP actor.
 func IsUnexpectedServerError(err error) bool {
@@ -409,7 +430,7 @@ func SuggestsClientDelay(err error) (int, bool) {
 	case *StatusError:
 		if t.Status().Details != nil {
 			switch t.Status().Reason {
-			case unversioned.StatusReasonServerTimeout, unversioned.StatusReasonTimeout:
+			case unversioned.StatusReasonServerTimeout, unversioned.StatusReasonTimeout, unversioned.StatusReasonTooManyRequests:
 				return int(t.Status().Details.RetryAfterSeconds), true
 			}
 		}
@@ -53,6 +53,9 @@ func TestErrorNew(t *testing.T) {
 	if IsMethodNotSupported(err) {
 		t.Errorf("expected to not be %s", unversioned.StatusReasonMethodNotAllowed)
 	}
+	if IsTooManyRequests(err) {
+		t.Errorf("expected to not be %s", unversioned.StatusReasonTooManyRequests)
+	}
 
 	if !IsConflict(NewConflict("test", "2", errors.New("message"))) {
 		t.Errorf("expected to be conflict")
@@ -84,6 +87,9 @@ func TestErrorNew(t *testing.T) {
 	if !IsMethodNotSupported(NewMethodNotSupported("foo", "delete")) {
 		t.Errorf("expected to be %s", unversioned.StatusReasonMethodNotAllowed)
 	}
+	if !IsTooManyRequests(NewTooManyRequests("too many", 10)) {
+		t.Errorf("expected to be %s", unversioned.StatusReasonTooManyRequests)
+	}
 }
 
 func TestNewInvalid(t *testing.T) {
@@ -17,10 +17,13 @@ limitations under the License.
 package api
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 
 	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	"speter.net/go/exp/math/dec/inf"
 )
@@ -175,3 +178,75 @@ func TestRemoveDuplicateAccessModes(t *testing.T) {
 		t.Errorf("Expected 2 distinct modes in set but found %v", len(modes))
 	}
 }
+
+func TestNodeSelectorRequirementsAsSelector(t *testing.T) {
+	matchExpressions := []NodeSelectorRequirement{{
+		Key:      "foo",
+		Operator: NodeSelectorOpIn,
+		Values:   []string{"bar", "baz"},
+	}}
+	mustParse := func(s string) labels.Selector {
+		out, e := labels.Parse(s)
+		if e != nil {
+			panic(e)
+		}
+		return out
+	}
+	tc := []struct {
+		in        []NodeSelectorRequirement
+		out       labels.Selector
+		expectErr bool
+	}{
+		{in: nil, out: labels.Nothing()},
+		{in: []NodeSelectorRequirement{}, out: labels.Nothing()},
+		{
+			in:  matchExpressions,
+			out: mustParse("foo in (baz,bar)"),
+		},
+		{
+			in: []NodeSelectorRequirement{{
+				Key:      "foo",
+				Operator: NodeSelectorOpExists,
+				Values:   []string{"bar", "baz"},
+			}},
+			expectErr: true,
+		},
+		{
+			in: []NodeSelectorRequirement{{
+				Key:      "foo",
+				Operator: NodeSelectorOpGt,
+				Values:   []string{"1"},
+			}},
+			out: labels.LabelSelector{*getRequirement("foo", labels.GreaterThanOperator, "1", t)},
+		},
+		{
+			in: []NodeSelectorRequirement{{
+				Key:      "foo",
+				Operator: NodeSelectorOpLt,
+				Values:   []string{"a"},
+			}},
+			expectErr: true,
+		},
+	}
+
+	for i, tc := range tc {
+		out, err := NodeSelectorRequirementsAsSelector(tc.in)
+		if err == nil && tc.expectErr {
+			t.Errorf("[%v]expected error but got none.", i)
+		}
+		if err != nil && !tc.expectErr {
+			t.Errorf("[%v]did not expect error but got: %v", i, err)
+		}
+		if !reflect.DeepEqual(out, tc.out) {
+			t.Errorf("[%v]expected:\n\t%+v\nbut got:\n\t%+v", i, tc.out, out)
+		}
+	}
+}
+
+func getRequirement(key string, op labels.Operator, value string, t *testing.T) *labels.Requirement {
+	req, err := labels.NewRequirement(key, op, sets.NewString(value))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return req
+}
@@ -89,6 +89,7 @@ func init() {
 		"PodLogOptions",
 		"PodExecOptions",
 		"PodAttachOptions",
+		"PodDebugOptions",
 		"PodProxyOptions",
 		"ThirdPartyResource",
 		"ThirdPartyResourceData",
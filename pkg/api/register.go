@@ -43,6 +43,7 @@ func init() {
 		&Endpoints{},
 		&EndpointsList{},
 		&Binding{},
+		&Eviction{},
 		&Event{},
 		&EventList{},
 		&List{},
@@ -56,6 +57,8 @@ func init() {
 		&ServiceAccountList{},
 		&Secret{},
 		&SecretList{},
+		&ConfigMap{},
+		&ConfigMapList{},
 		&PersistentVolume{},
 		&PersistentVolumeList{},
 		&PersistentVolumeClaim{},
@@ -64,6 +67,7 @@ func init() {
 		&PodAttachOptions{},
 		&PodLogOptions{},
 		&PodExecOptions{},
+		&PodDebugOptions{},
 		&PodProxyOptions{},
 		&ComponentStatus{},
 		&ComponentStatusList{},
@@ -95,6 +99,7 @@ func (*EndpointsList) IsAnAPIObject()             {}
 func (*Node) IsAnAPIObject()                      {}
 func (*NodeList) IsAnAPIObject()                  {}
 func (*Binding) IsAnAPIObject()                   {}
+func (*Eviction) IsAnAPIObject()                  {}
 func (*Event) IsAnAPIObject()                     {}
 func (*EventList) IsAnAPIObject()                 {}
 func (*List) IsAnAPIObject()                      {}
@@ -108,6 +113,8 @@ func (*ServiceAccount) IsAnAPIObject()            {}
 func (*ServiceAccountList) IsAnAPIObject()        {}
 func (*Secret) IsAnAPIObject()                    {}
 func (*SecretList) IsAnAPIObject()                {}
+func (*ConfigMap) IsAnAPIObject()                 {}
+func (*ConfigMapList) IsAnAPIObject()             {}
 func (*PersistentVolume) IsAnAPIObject()          {}
 func (*PersistentVolumeList) IsAnAPIObject()      {}
 func (*PersistentVolumeClaim) IsAnAPIObject()     {}
@@ -117,6 +124,7 @@ func (*ListOptions) IsAnAPIObject()               {}
 func (*PodAttachOptions) IsAnAPIObject()          {}
 func (*PodLogOptions) IsAnAPIObject()             {}
 func (*PodExecOptions) IsAnAPIObject()            {}
+func (*PodDebugOptions) IsAnAPIObject()           {}
 func (*PodProxyOptions) IsAnAPIObject()           {}
 func (*ComponentStatus) IsAnAPIObject()           {}
 func (*ComponentStatusList) IsAnAPIObject()       {}
@@ -35,6 +35,7 @@ func init() {
 		unversioned.GroupVersion{Group: "extensions", Version: "v1beta1"}:       true,
 		unversioned.GroupVersion{Group: "componentconfig", Version: "v1alpha1"}: true,
 		unversioned.GroupVersion{Group: "metrics", Version: "v1alpha1"}:         true,
+		unversioned.GroupVersion{Group: "rbac", Version: "v1alpha1"}:            true,
 	}
 
 	// The default list of supported api versions, in order of most preferred to the least.
@@ -42,6 +43,7 @@ func init() {
 		{Group: "", Version: "v1"},
 		{Group: "extensions", Version: "v1beta1"},
 		{Group: "componentconfig", Version: "v1alpha1"},
+		{Group: "rbac", Version: "v1alpha1"},
 	}
 
 	// Env var KUBE_API_VERSIONS is a comma separated list of API versions that should be registered in the scheme.
@@ -26,6 +26,7 @@ import (
 	_ "k8s.io/kubernetes/pkg/api/install"
 	_ "k8s.io/kubernetes/pkg/apis/extensions/install"
 	_ "k8s.io/kubernetes/pkg/apis/metrics/install"
+	_ "k8s.io/kubernetes/pkg/apis/rbac/install"
 
 	"k8s.io/kubernetes/pkg/api/latest"
 	"k8s.io/kubernetes/pkg/api/meta"
@@ -38,6 +39,7 @@ var (
 	Groups     = make(map[string]TestGroup)
 	Default    TestGroup
 	Extensions TestGroup
+	Rbac       TestGroup
 )
 
 type TestGroup struct {
@@ -71,9 +73,13 @@ func init() {
 	if _, ok := Groups["extensions"]; !ok {
 		Groups["extensions"] = TestGroup{"extensions", latest.GroupOrDie("extensions").Version, latest.GroupOrDie("extensions").GroupVersion}
 	}
+	if _, ok := Groups["rbac"]; !ok {
+		Groups["rbac"] = TestGroup{"rbac", latest.GroupOrDie("rbac").Version, latest.GroupOrDie("rbac").GroupVersion}
+	}
 
 	Default = Groups[""]
 	Extensions = Groups["extensions"]
+	Rbac = Groups["rbac"]
 }
 
 // Version returns the API version to test against, as set by the KUBE_TEST_API env var.
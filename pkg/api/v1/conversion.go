@@ -315,6 +315,24 @@ func convert_api_PodSpec_To_v1_PodSpec(in *api.PodSpec, out *PodSpec, s conversi
 	} else {
 		out.ImagePullSecrets = nil
 	}
+	if in.Affinity != nil {
+		out.Affinity = new(Affinity)
+		if err := s.Convert(in.Affinity, out.Affinity, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	if in.Tolerations != nil {
+		out.Tolerations = make([]Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := s.Convert(&in.Tolerations[i], &out.Tolerations[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
 	return nil
 }
 
@@ -396,6 +414,24 @@ func convert_v1_PodSpec_To_api_PodSpec(in *PodSpec, out *api.PodSpec, s conversi
 	} else {
 		out.ImagePullSecrets = nil
 	}
+	if in.Affinity != nil {
+		out.Affinity = new(api.Affinity)
+		if err := s.Convert(in.Affinity, out.Affinity, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	if in.Tolerations != nil {
+		out.Tolerations = make([]api.Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := s.Convert(&in.Tolerations[i], &out.Tolerations[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
 
 	return nil
 }
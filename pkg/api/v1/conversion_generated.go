@@ -202,6 +202,97 @@ func convert_api_ComponentStatusList_To_v1_ComponentStatusList(in *api.Component
 	return autoconvert_api_ComponentStatusList_To_v1_ComponentStatusList(in, out, s)
 }
 
+func autoconvert_api_ConfigMap_To_v1_ConfigMap(in *api.ConfigMap, out *ConfigMap, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ConfigMap))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Data != nil {
+		out.Data = make(map[string]string)
+		for key, val := range in.Data {
+			out.Data[key] = val
+		}
+	} else {
+		out.Data = nil
+	}
+	return nil
+}
+
+func convert_api_ConfigMap_To_v1_ConfigMap(in *api.ConfigMap, out *ConfigMap, s conversion.Scope) error {
+	return autoconvert_api_ConfigMap_To_v1_ConfigMap(in, out, s)
+}
+
+func autoconvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in *api.ConfigMapKeySelector, out *ConfigMapKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ConfigMapKeySelector))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in *api.ConfigMapKeySelector, out *ConfigMapKeySelector, s conversion.Scope) error {
+	return autoconvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in, out, s)
+}
+
+func autoconvert_api_ConfigMapList_To_v1_ConfigMapList(in *api.ConfigMapList, out *ConfigMapList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ConfigMapList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]ConfigMap, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_ConfigMap_To_v1_ConfigMap(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_ConfigMapList_To_v1_ConfigMapList(in *api.ConfigMapList, out *ConfigMapList, s conversion.Scope) error {
+	return autoconvert_api_ConfigMapList_To_v1_ConfigMapList(in, out, s)
+}
+
+func autoconvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in *api.ConfigMapVolumeSource, out *ConfigMapVolumeSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ConfigMapVolumeSource))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]KeyToPath, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_KeyToPath_To_v1_KeyToPath(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in *api.ConfigMapVolumeSource, out *ConfigMapVolumeSource, s conversion.Scope) error {
+	return autoconvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in, out, s)
+}
+
 func autoconvert_api_Container_To_v1_Container(in *api.Container, out *Container, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Container))(in)
@@ -667,6 +758,22 @@ func autoconvert_api_EnvVarSource_To_v1_EnvVarSource(in *api.EnvVarSource, out *
 	} else {
 		out.FieldRef = nil
 	}
+	if in.ConfigMapKeyRef != nil {
+		out.ConfigMapKeyRef = new(ConfigMapKeySelector)
+		if err := convert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in.ConfigMapKeyRef, out.ConfigMapKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapKeyRef = nil
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(SecretKeySelector)
+		if err := convert_api_SecretKeySelector_To_v1_SecretKeySelector(in.SecretKeyRef, out.SecretKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
 	return nil
 }
 
@@ -747,6 +854,31 @@ func convert_api_EventSource_To_v1_EventSource(in *api.EventSource, out *EventSo
 	return autoconvert_api_EventSource_To_v1_EventSource(in, out, s)
 }
 
+func autoconvert_api_Eviction_To_v1_Eviction(in *api.Eviction, out *Eviction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.Eviction))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.DeleteOptions != nil {
+		out.DeleteOptions = new(DeleteOptions)
+		if err := convert_api_DeleteOptions_To_v1_DeleteOptions(in.DeleteOptions, out.DeleteOptions, s); err != nil {
+			return err
+		}
+	} else {
+		out.DeleteOptions = nil
+	}
+	return nil
+}
+
+func convert_api_Eviction_To_v1_Eviction(in *api.Eviction, out *Eviction, s conversion.Scope) error {
+	return autoconvert_api_Eviction_To_v1_Eviction(in, out, s)
+}
+
 func autoconvert_api_ExecAction_To_v1_ExecAction(in *api.ExecAction, out *ExecAction, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.ExecAction))(in)
@@ -927,6 +1059,19 @@ func convert_api_ISCSIVolumeSource_To_v1_ISCSIVolumeSource(in *api.ISCSIVolumeSo
 	return autoconvert_api_ISCSIVolumeSource_To_v1_ISCSIVolumeSource(in, out, s)
 }
 
+func autoconvert_api_KeyToPath_To_v1_KeyToPath(in *api.KeyToPath, out *KeyToPath, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.KeyToPath))(in)
+	}
+	out.Key = in.Key
+	out.Path = in.Path
+	return nil
+}
+
+func convert_api_KeyToPath_To_v1_KeyToPath(in *api.KeyToPath, out *KeyToPath, s conversion.Scope) error {
+	return autoconvert_api_KeyToPath_To_v1_KeyToPath(in, out, s)
+}
+
 func autoconvert_api_Lifecycle_To_v1_Lifecycle(in *api.Lifecycle, out *Lifecycle, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Lifecycle))(in)
@@ -1361,6 +1506,16 @@ func autoconvert_api_NodeSpec_To_v1_NodeSpec(in *api.NodeSpec, out *NodeSpec, s
 	out.ExternalID = in.ExternalID
 	out.ProviderID = in.ProviderID
 	out.Unschedulable = in.Unschedulable
+	if in.Taints != nil {
+		out.Taints = make([]Taint, len(in.Taints))
+		for i := range in.Taints {
+			if err := convert_api_Taint_To_v1_Taint(&in.Taints[i], &out.Taints[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Taints = nil
+	}
 	return nil
 }
 
@@ -1901,6 +2056,35 @@ func convert_api_PodCondition_To_v1_PodCondition(in *api.PodCondition, out *PodC
 	return autoconvert_api_PodCondition_To_v1_PodCondition(in, out, s)
 }
 
+func autoconvert_api_PodDebugOptions_To_v1_PodDebugOptions(in *api.PodDebugOptions, out *PodDebugOptions, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.PodDebugOptions))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	out.Stdin = in.Stdin
+	out.Stdout = in.Stdout
+	out.Stderr = in.Stderr
+	out.TTY = in.TTY
+	out.Container = in.Container
+	out.Target = in.Target
+	out.Image = in.Image
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	return nil
+}
+
+func convert_api_PodDebugOptions_To_v1_PodDebugOptions(in *api.PodDebugOptions, out *PodDebugOptions, s conversion.Scope) error {
+	return autoconvert_api_PodDebugOptions_To_v1_PodDebugOptions(in, out, s)
+}
+
 func autoconvert_api_PodExecOptions_To_v1_PodExecOptions(in *api.PodExecOptions, out *PodExecOptions, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.PodExecOptions))(in)
@@ -2078,6 +2262,23 @@ func autoconvert_api_PodSpec_To_v1_PodSpec(in *api.PodSpec, out *PodSpec, s conv
 	} else {
 		out.ImagePullSecrets = nil
 	}
+	if in.Affinity != nil {
+		if err := s.Convert(&in.Affinity, &out.Affinity, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	if in.Tolerations != nil {
+		out.Tolerations = make([]Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := s.Convert(&in.Tolerations[i], &out.Tolerations[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
 	return nil
 }
 
@@ -2558,6 +2759,21 @@ func convert_api_Secret_To_v1_Secret(in *api.Secret, out *Secret, s conversion.S
 	return autoconvert_api_Secret_To_v1_Secret(in, out, s)
 }
 
+func autoconvert_api_SecretKeySelector_To_v1_SecretKeySelector(in *api.SecretKeySelector, out *SecretKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.SecretKeySelector))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_api_SecretKeySelector_To_v1_SecretKeySelector(in *api.SecretKeySelector, out *SecretKeySelector, s conversion.Scope) error {
+	return autoconvert_api_SecretKeySelector_To_v1_SecretKeySelector(in, out, s)
+}
+
 func autoconvert_api_SecretList_To_v1_SecretList(in *api.SecretList, out *SecretList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.SecretList))(in)
@@ -2856,6 +3072,20 @@ func convert_api_TCPSocketAction_To_v1_TCPSocketAction(in *api.TCPSocketAction,
 	return autoconvert_api_TCPSocketAction_To_v1_TCPSocketAction(in, out, s)
 }
 
+func autoconvert_api_Taint_To_v1_Taint(in *api.Taint, out *Taint, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.Taint))(in)
+	}
+	out.Key = in.Key
+	out.Value = in.Value
+	out.Effect = TaintEffect(in.Effect)
+	return nil
+}
+
+func convert_api_Taint_To_v1_Taint(in *api.Taint, out *Taint, s conversion.Scope) error {
+	return autoconvert_api_Taint_To_v1_Taint(in, out, s)
+}
+
 func autoconvert_api_Volume_To_v1_Volume(in *api.Volume, out *Volume, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Volume))(in)
@@ -3017,6 +3247,14 @@ func autoconvert_api_VolumeSource_To_v1_VolumeSource(in *api.VolumeSource, out *
 	} else {
 		out.FC = nil
 	}
+	if in.ConfigMap != nil {
+		out.ConfigMap = new(ConfigMapVolumeSource)
+		if err := convert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in.ConfigMap, out.ConfigMap, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMap = nil
+	}
 	return nil
 }
 
@@ -3227,6 +3465,97 @@ func convert_v1_ComponentStatusList_To_api_ComponentStatusList(in *ComponentStat
 	return autoconvert_v1_ComponentStatusList_To_api_ComponentStatusList(in, out, s)
 }
 
+func autoconvert_v1_ConfigMap_To_api_ConfigMap(in *ConfigMap, out *api.ConfigMap, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*ConfigMap))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Data != nil {
+		out.Data = make(map[string]string)
+		for key, val := range in.Data {
+			out.Data[key] = val
+		}
+	} else {
+		out.Data = nil
+	}
+	return nil
+}
+
+func convert_v1_ConfigMap_To_api_ConfigMap(in *ConfigMap, out *api.ConfigMap, s conversion.Scope) error {
+	return autoconvert_v1_ConfigMap_To_api_ConfigMap(in, out, s)
+}
+
+func autoconvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in *ConfigMapKeySelector, out *api.ConfigMapKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*ConfigMapKeySelector))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in *ConfigMapKeySelector, out *api.ConfigMapKeySelector, s conversion.Scope) error {
+	return autoconvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in, out, s)
+}
+
+func autoconvert_v1_ConfigMapList_To_api_ConfigMapList(in *ConfigMapList, out *api.ConfigMapList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*ConfigMapList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]api.ConfigMap, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_ConfigMap_To_api_ConfigMap(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_ConfigMapList_To_api_ConfigMapList(in *ConfigMapList, out *api.ConfigMapList, s conversion.Scope) error {
+	return autoconvert_v1_ConfigMapList_To_api_ConfigMapList(in, out, s)
+}
+
+func autoconvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in *ConfigMapVolumeSource, out *api.ConfigMapVolumeSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*ConfigMapVolumeSource))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]api.KeyToPath, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_KeyToPath_To_api_KeyToPath(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in *ConfigMapVolumeSource, out *api.ConfigMapVolumeSource, s conversion.Scope) error {
+	return autoconvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in, out, s)
+}
+
 func autoconvert_v1_Container_To_api_Container(in *Container, out *api.Container, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*Container))(in)
@@ -3692,6 +4021,22 @@ func autoconvert_v1_EnvVarSource_To_api_EnvVarSource(in *EnvVarSource, out *api.
 	} else {
 		out.FieldRef = nil
 	}
+	if in.ConfigMapKeyRef != nil {
+		out.ConfigMapKeyRef = new(api.ConfigMapKeySelector)
+		if err := convert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in.ConfigMapKeyRef, out.ConfigMapKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapKeyRef = nil
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(api.SecretKeySelector)
+		if err := convert_v1_SecretKeySelector_To_api_SecretKeySelector(in.SecretKeyRef, out.SecretKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
 	return nil
 }
 
@@ -3772,6 +4117,31 @@ func convert_v1_EventSource_To_api_EventSource(in *EventSource, out *api.EventSo
 	return autoconvert_v1_EventSource_To_api_EventSource(in, out, s)
 }
 
+func autoconvert_v1_Eviction_To_api_Eviction(in *Eviction, out *api.Eviction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*Eviction))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.DeleteOptions != nil {
+		out.DeleteOptions = new(api.DeleteOptions)
+		if err := convert_v1_DeleteOptions_To_api_DeleteOptions(in.DeleteOptions, out.DeleteOptions, s); err != nil {
+			return err
+		}
+	} else {
+		out.DeleteOptions = nil
+	}
+	return nil
+}
+
+func convert_v1_Eviction_To_api_Eviction(in *Eviction, out *api.Eviction, s conversion.Scope) error {
+	return autoconvert_v1_Eviction_To_api_Eviction(in, out, s)
+}
+
 func autoconvert_v1_ExecAction_To_api_ExecAction(in *ExecAction, out *api.ExecAction, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*ExecAction))(in)
@@ -3952,6 +4322,19 @@ func convert_v1_ISCSIVolumeSource_To_api_ISCSIVolumeSource(in *ISCSIVolumeSource
 	return autoconvert_v1_ISCSIVolumeSource_To_api_ISCSIVolumeSource(in, out, s)
 }
 
+func autoconvert_v1_KeyToPath_To_api_KeyToPath(in *KeyToPath, out *api.KeyToPath, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*KeyToPath))(in)
+	}
+	out.Key = in.Key
+	out.Path = in.Path
+	return nil
+}
+
+func convert_v1_KeyToPath_To_api_KeyToPath(in *KeyToPath, out *api.KeyToPath, s conversion.Scope) error {
+	return autoconvert_v1_KeyToPath_To_api_KeyToPath(in, out, s)
+}
+
 func autoconvert_v1_Lifecycle_To_api_Lifecycle(in *Lifecycle, out *api.Lifecycle, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*Lifecycle))(in)
@@ -4414,6 +4797,16 @@ func autoconvert_v1_NodeSpec_To_api_NodeSpec(in *NodeSpec, out *api.NodeSpec, s
 	out.ExternalID = in.ExternalID
 	out.ProviderID = in.ProviderID
 	out.Unschedulable = in.Unschedulable
+	if in.Taints != nil {
+		out.Taints = make([]api.Taint, len(in.Taints))
+		for i := range in.Taints {
+			if err := convert_v1_Taint_To_api_Taint(&in.Taints[i], &out.Taints[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Taints = nil
+	}
 	return nil
 }
 
@@ -4954,6 +5347,35 @@ func convert_v1_PodCondition_To_api_PodCondition(in *PodCondition, out *api.PodC
 	return autoconvert_v1_PodCondition_To_api_PodCondition(in, out, s)
 }
 
+func autoconvert_v1_PodDebugOptions_To_api_PodDebugOptions(in *PodDebugOptions, out *api.PodDebugOptions, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*PodDebugOptions))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	out.Stdin = in.Stdin
+	out.Stdout = in.Stdout
+	out.Stderr = in.Stderr
+	out.TTY = in.TTY
+	out.Container = in.Container
+	out.Target = in.Target
+	out.Image = in.Image
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	return nil
+}
+
+func convert_v1_PodDebugOptions_To_api_PodDebugOptions(in *PodDebugOptions, out *api.PodDebugOptions, s conversion.Scope) error {
+	return autoconvert_v1_PodDebugOptions_To_api_PodDebugOptions(in, out, s)
+}
+
 func autoconvert_v1_PodExecOptions_To_api_PodExecOptions(in *PodExecOptions, out *api.PodExecOptions, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*PodExecOptions))(in)
@@ -5135,6 +5557,23 @@ func autoconvert_v1_PodSpec_To_api_PodSpec(in *PodSpec, out *api.PodSpec, s conv
 	} else {
 		out.ImagePullSecrets = nil
 	}
+	if in.Affinity != nil {
+		if err := s.Convert(&in.Affinity, &out.Affinity, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	if in.Tolerations != nil {
+		out.Tolerations = make([]api.Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := s.Convert(&in.Tolerations[i], &out.Tolerations[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
 	return nil
 }
 
@@ -5613,6 +6052,21 @@ func convert_v1_Secret_To_api_Secret(in *Secret, out *api.Secret, s conversion.S
 	return autoconvert_v1_Secret_To_api_Secret(in, out, s)
 }
 
+func autoconvert_v1_SecretKeySelector_To_api_SecretKeySelector(in *SecretKeySelector, out *api.SecretKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*SecretKeySelector))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_v1_SecretKeySelector_To_api_SecretKeySelector(in *SecretKeySelector, out *api.SecretKeySelector, s conversion.Scope) error {
+	return autoconvert_v1_SecretKeySelector_To_api_SecretKeySelector(in, out, s)
+}
+
 func autoconvert_v1_SecretList_To_api_SecretList(in *SecretList, out *api.SecretList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*SecretList))(in)
@@ -5912,6 +6366,20 @@ func convert_v1_TCPSocketAction_To_api_TCPSocketAction(in *TCPSocketAction, out
 	return autoconvert_v1_TCPSocketAction_To_api_TCPSocketAction(in, out, s)
 }
 
+func autoconvert_v1_Taint_To_api_Taint(in *Taint, out *api.Taint, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*Taint))(in)
+	}
+	out.Key = in.Key
+	out.Value = in.Value
+	out.Effect = api.TaintEffect(in.Effect)
+	return nil
+}
+
+func convert_v1_Taint_To_api_Taint(in *Taint, out *api.Taint, s conversion.Scope) error {
+	return autoconvert_v1_Taint_To_api_Taint(in, out, s)
+}
+
 func autoconvert_v1_Volume_To_api_Volume(in *Volume, out *api.Volume, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*Volume))(in)
@@ -6073,6 +6541,14 @@ func autoconvert_v1_VolumeSource_To_api_VolumeSource(in *VolumeSource, out *api.
 	} else {
 		out.FC = nil
 	}
+	if in.ConfigMap != nil {
+		out.ConfigMap = new(api.ConfigMapVolumeSource)
+		if err := convert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in.ConfigMap, out.ConfigMap, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMap = nil
+	}
 	return nil
 }
 
@@ -6090,6 +6566,10 @@ func init() {
 		autoconvert_api_ComponentCondition_To_v1_ComponentCondition,
 		autoconvert_api_ComponentStatusList_To_v1_ComponentStatusList,
 		autoconvert_api_ComponentStatus_To_v1_ComponentStatus,
+		autoconvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector,
+		autoconvert_api_ConfigMapList_To_v1_ConfigMapList,
+		autoconvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource,
+		autoconvert_api_ConfigMap_To_v1_ConfigMap,
 		autoconvert_api_ContainerPort_To_v1_ContainerPort,
 		autoconvert_api_ContainerStateRunning_To_v1_ContainerStateRunning,
 		autoconvert_api_ContainerStateTerminated_To_v1_ContainerStateTerminated,
@@ -6112,6 +6592,7 @@ func init() {
 		autoconvert_api_EventList_To_v1_EventList,
 		autoconvert_api_EventSource_To_v1_EventSource,
 		autoconvert_api_Event_To_v1_Event,
+		autoconvert_api_Eviction_To_v1_Eviction,
 		autoconvert_api_ExecAction_To_v1_ExecAction,
 		autoconvert_api_FCVolumeSource_To_v1_FCVolumeSource,
 		autoconvert_api_FlockerVolumeSource_To_v1_FlockerVolumeSource,
@@ -6122,6 +6603,7 @@ func init() {
 		autoconvert_api_Handler_To_v1_Handler,
 		autoconvert_api_HostPathVolumeSource_To_v1_HostPathVolumeSource,
 		autoconvert_api_ISCSIVolumeSource_To_v1_ISCSIVolumeSource,
+		autoconvert_api_KeyToPath_To_v1_KeyToPath,
 		autoconvert_api_Lifecycle_To_v1_Lifecycle,
 		autoconvert_api_LimitRangeItem_To_v1_LimitRangeItem,
 		autoconvert_api_LimitRangeList_To_v1_LimitRangeList,
@@ -6159,6 +6641,7 @@ func init() {
 		autoconvert_api_PersistentVolume_To_v1_PersistentVolume,
 		autoconvert_api_PodAttachOptions_To_v1_PodAttachOptions,
 		autoconvert_api_PodCondition_To_v1_PodCondition,
+		autoconvert_api_PodDebugOptions_To_v1_PodDebugOptions,
 		autoconvert_api_PodExecOptions_To_v1_PodExecOptions,
 		autoconvert_api_PodList_To_v1_PodList,
 		autoconvert_api_PodLogOptions_To_v1_PodLogOptions,
@@ -6183,6 +6666,7 @@ func init() {
 		autoconvert_api_ResourceQuota_To_v1_ResourceQuota,
 		autoconvert_api_ResourceRequirements_To_v1_ResourceRequirements,
 		autoconvert_api_SELinuxOptions_To_v1_SELinuxOptions,
+		autoconvert_api_SecretKeySelector_To_v1_SecretKeySelector,
 		autoconvert_api_SecretList_To_v1_SecretList,
 		autoconvert_api_SecretVolumeSource_To_v1_SecretVolumeSource,
 		autoconvert_api_Secret_To_v1_Secret,
@@ -6196,6 +6680,7 @@ func init() {
 		autoconvert_api_ServiceStatus_To_v1_ServiceStatus,
 		autoconvert_api_Service_To_v1_Service,
 		autoconvert_api_TCPSocketAction_To_v1_TCPSocketAction,
+		autoconvert_api_Taint_To_v1_Taint,
 		autoconvert_api_VolumeMount_To_v1_VolumeMount,
 		autoconvert_api_VolumeSource_To_v1_VolumeSource,
 		autoconvert_api_Volume_To_v1_Volume,
@@ -6208,6 +6693,10 @@ func init() {
 		autoconvert_v1_ComponentCondition_To_api_ComponentCondition,
 		autoconvert_v1_ComponentStatusList_To_api_ComponentStatusList,
 		autoconvert_v1_ComponentStatus_To_api_ComponentStatus,
+		autoconvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector,
+		autoconvert_v1_ConfigMapList_To_api_ConfigMapList,
+		autoconvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource,
+		autoconvert_v1_ConfigMap_To_api_ConfigMap,
 		autoconvert_v1_ContainerPort_To_api_ContainerPort,
 		autoconvert_v1_ContainerStateRunning_To_api_ContainerStateRunning,
 		autoconvert_v1_ContainerStateTerminated_To_api_ContainerStateTerminated,
@@ -6230,6 +6719,7 @@ func init() {
 		autoconvert_v1_EventList_To_api_EventList,
 		autoconvert_v1_EventSource_To_api_EventSource,
 		autoconvert_v1_Event_To_api_Event,
+		autoconvert_v1_Eviction_To_api_Eviction,
 		autoconvert_v1_ExecAction_To_api_ExecAction,
 		autoconvert_v1_FCVolumeSource_To_api_FCVolumeSource,
 		autoconvert_v1_FlockerVolumeSource_To_api_FlockerVolumeSource,
@@ -6240,6 +6730,7 @@ func init() {
 		autoconvert_v1_Handler_To_api_Handler,
 		autoconvert_v1_HostPathVolumeSource_To_api_HostPathVolumeSource,
 		autoconvert_v1_ISCSIVolumeSource_To_api_ISCSIVolumeSource,
+		autoconvert_v1_KeyToPath_To_api_KeyToPath,
 		autoconvert_v1_Lifecycle_To_api_Lifecycle,
 		autoconvert_v1_LimitRangeItem_To_api_LimitRangeItem,
 		autoconvert_v1_LimitRangeList_To_api_LimitRangeList,
@@ -6278,6 +6769,7 @@ func init() {
 		autoconvert_v1_PersistentVolume_To_api_PersistentVolume,
 		autoconvert_v1_PodAttachOptions_To_api_PodAttachOptions,
 		autoconvert_v1_PodCondition_To_api_PodCondition,
+		autoconvert_v1_PodDebugOptions_To_api_PodDebugOptions,
 		autoconvert_v1_PodExecOptions_To_api_PodExecOptions,
 		autoconvert_v1_PodList_To_api_PodList,
 		autoconvert_v1_PodLogOptions_To_api_PodLogOptions,
@@ -6302,6 +6794,7 @@ func init() {
 		autoconvert_v1_ResourceQuota_To_api_ResourceQuota,
 		autoconvert_v1_ResourceRequirements_To_api_ResourceRequirements,
 		autoconvert_v1_SELinuxOptions_To_api_SELinuxOptions,
+		autoconvert_v1_SecretKeySelector_To_api_SecretKeySelector,
 		autoconvert_v1_SecretList_To_api_SecretList,
 		autoconvert_v1_SecretVolumeSource_To_api_SecretVolumeSource,
 		autoconvert_v1_Secret_To_api_Secret,
@@ -6315,6 +6808,7 @@ func init() {
 		autoconvert_v1_ServiceStatus_To_api_ServiceStatus,
 		autoconvert_v1_Service_To_api_Service,
 		autoconvert_v1_TCPSocketAction_To_api_TCPSocketAction,
+		autoconvert_v1_Taint_To_api_Taint,
 		autoconvert_v1_VolumeMount_To_api_VolumeMount,
 		autoconvert_v1_VolumeSource_To_api_VolumeSource,
 		autoconvert_v1_Volume_To_api_Volume,
@@ -58,6 +58,7 @@ func addKnownTypes() {
 		&Node{},
 		&NodeList{},
 		&Binding{},
+		&Eviction{},
 		&Event{},
 		&EventList{},
 		&List{},
@@ -69,6 +70,8 @@ func addKnownTypes() {
 		&NamespaceList{},
 		&Secret{},
 		&SecretList{},
+		&ConfigMap{},
+		&ConfigMapList{},
 		&ServiceAccount{},
 		&ServiceAccountList{},
 		&PersistentVolume{},
@@ -80,6 +83,7 @@ func addKnownTypes() {
 		&PodAttachOptions{},
 		&PodLogOptions{},
 		&PodExecOptions{},
+		&PodDebugOptions{},
 		&PodProxyOptions{},
 		&ComponentStatus{},
 		&ComponentStatusList{},
@@ -105,6 +109,7 @@ func (*EndpointsList) IsAnAPIObject()             {}
 func (*Node) IsAnAPIObject()                      {}
 func (*NodeList) IsAnAPIObject()                  {}
 func (*Binding) IsAnAPIObject()                   {}
+func (*Eviction) IsAnAPIObject()                  {}
 func (*Event) IsAnAPIObject()                     {}
 func (*EventList) IsAnAPIObject()                 {}
 func (*List) IsAnAPIObject()                      {}
@@ -116,6 +121,8 @@ func (*Namespace) IsAnAPIObject()                 {}
 func (*NamespaceList) IsAnAPIObject()             {}
 func (*Secret) IsAnAPIObject()                    {}
 func (*SecretList) IsAnAPIObject()                {}
+func (*ConfigMap) IsAnAPIObject()                 {}
+func (*ConfigMapList) IsAnAPIObject()             {}
 func (*ServiceAccount) IsAnAPIObject()            {}
 func (*ServiceAccountList) IsAnAPIObject()        {}
 func (*PersistentVolume) IsAnAPIObject()          {}
@@ -127,6 +134,7 @@ func (*ListOptions) IsAnAPIObject()               {}
 func (*PodAttachOptions) IsAnAPIObject()          {}
 func (*PodLogOptions) IsAnAPIObject()             {}
 func (*PodExecOptions) IsAnAPIObject()            {}
+func (*PodDebugOptions) IsAnAPIObject()           {}
 func (*PodProxyOptions) IsAnAPIObject()           {}
 func (*ComponentStatus) IsAnAPIObject()           {}
 func (*ComponentStatusList) IsAnAPIObject()       {}
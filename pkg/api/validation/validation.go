@@ -24,6 +24,7 @@ import (
 	"path"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"k8s.io/kubernetes/pkg/api"
@@ -169,6 +170,13 @@ func ValidateSecretName(name string, prefix bool) (bool, string) {
 	return NameIsDNSSubdomain(name, prefix)
 }
 
+// ValidateConfigMapName can be used to check whether the given ConfigMap name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateConfigMapName(name string, prefix bool) (bool, string) {
+	return NameIsDNSSubdomain(name, prefix)
+}
+
 // ValidateServiceAccountName can be used to check whether the given service account name is valid.
 // Prefix indicates this name will be used as part of generation, in which case
 // trailing dashes are allowed.
@@ -423,6 +431,10 @@ func validateSource(source *api.VolumeSource) validation.ErrorList {
 		numVolumes++
 		allErrs = append(allErrs, validateFCVolumeSource(source.FC).Prefix("fc")...)
 	}
+	if source.ConfigMap != nil {
+		numVolumes++
+		allErrs = append(allErrs, validateConfigMapVolumeSource(source.ConfigMap).Prefix("configMap")...)
+	}
 	if numVolumes != 1 {
 		allErrs = append(allErrs, validation.NewInvalidError("", source, "exactly 1 volume type is required"))
 	}
@@ -518,6 +530,41 @@ func validateSecretVolumeSource(secretSource *api.SecretVolumeSource) validation
 	return allErrs
 }
 
+func validateConfigMapVolumeSource(configMapSource *api.ConfigMapVolumeSource) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	if len(configMapSource.Name) == 0 {
+		allErrs = append(allErrs, validation.NewRequiredError("name"))
+	}
+	for i, kp := range configMapSource.Items {
+		allErrs = append(allErrs, validateKeyToPath(&kp).PrefixIndex(i).Prefix("items")...)
+	}
+	return allErrs
+}
+
+func validateKeyToPath(kp *api.KeyToPath) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	if len(kp.Key) == 0 {
+		allErrs = append(allErrs, validation.NewRequiredError("key"))
+	}
+	if len(kp.Path) == 0 {
+		allErrs = append(allErrs, validation.NewRequiredError("path"))
+		return allErrs
+	}
+	if path.IsAbs(kp.Path) {
+		allErrs = append(allErrs, validation.NewInvalidError("path", kp.Path, "must not be an absolute path"))
+	}
+	items := strings.Split(kp.Path, string(os.PathSeparator))
+	for _, item := range items {
+		if item == ".." {
+			allErrs = append(allErrs, validation.NewInvalidError("path", kp.Path, "must not contain \"..\"."))
+		}
+	}
+	if strings.HasPrefix(items[0], "..") && len(items[0]) > 2 {
+		allErrs = append(allErrs, validation.NewInvalidError("path", kp.Path, "must not start with \"..\"."))
+	}
+	return allErrs
+}
+
 func validatePersistentClaimVolumeSource(claim *api.PersistentVolumeClaimVolumeSource) validation.ErrorList {
 	allErrs := validation.ErrorList{}
 	if claim.ClaimName == "" {
@@ -823,12 +870,22 @@ func validateEnvVarValueFrom(ev api.EnvVar) validation.ErrorList {
 
 	numSources := 0
 
-	switch {
-	case ev.ValueFrom.FieldRef != nil:
+	if ev.ValueFrom.FieldRef != nil {
 		numSources++
 		allErrs = append(allErrs, validateObjectFieldSelector(ev.ValueFrom.FieldRef, &validFieldPathExpressionsEnv).Prefix("fieldRef")...)
 	}
+	if ev.ValueFrom.ConfigMapKeyRef != nil {
+		numSources++
+		allErrs = append(allErrs, validateConfigMapKeySelector(ev.ValueFrom.ConfigMapKeyRef).Prefix("configMapKeyRef")...)
+	}
+	if ev.ValueFrom.SecretKeyRef != nil {
+		numSources++
+		allErrs = append(allErrs, validateSecretKeySelector(ev.ValueFrom.SecretKeyRef).Prefix("secretKeyRef")...)
+	}
 
+	if numSources > 1 {
+		allErrs = append(allErrs, validation.NewInvalidError("", "", "may not have more than one field specified at a time"))
+	}
 	if ev.Value != "" && numSources != 0 {
 		allErrs = append(allErrs, validation.NewInvalidError("", "", "sources cannot be specified when value is not empty"))
 	}
@@ -836,6 +893,36 @@ func validateEnvVarValueFrom(ev api.EnvVar) validation.ErrorList {
 	return allErrs
 }
 
+func validateConfigMapKeySelector(s *api.ConfigMapKeySelector) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+
+	if len(s.Name) == 0 {
+		allErrs = append(allErrs, validation.NewRequiredError("name"))
+	}
+	if len(s.Key) == 0 {
+		allErrs = append(allErrs, validation.NewRequiredError("key"))
+	} else if !IsSecretKey(s.Key) {
+		allErrs = append(allErrs, validation.NewInvalidError("key", s.Key, fmt.Sprintf("must have at most %d characters and match regex %s", validation.DNS1123SubdomainMaxLength, SecretKeyFmt)))
+	}
+
+	return allErrs
+}
+
+func validateSecretKeySelector(s *api.SecretKeySelector) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+
+	if len(s.Name) == 0 {
+		allErrs = append(allErrs, validation.NewRequiredError("name"))
+	}
+	if len(s.Key) == 0 {
+		allErrs = append(allErrs, validation.NewRequiredError("key"))
+	} else if !IsSecretKey(s.Key) {
+		allErrs = append(allErrs, validation.NewInvalidError("key", s.Key, fmt.Sprintf("must have at most %d characters and match regex %s", validation.DNS1123SubdomainMaxLength, SecretKeyFmt)))
+	}
+
+	return allErrs
+}
+
 func validateObjectFieldSelector(fs *api.ObjectFieldSelector, expressions *sets.String) validation.ErrorList {
 	allErrs := validation.ErrorList{}
 
@@ -1106,6 +1193,207 @@ func validateImagePullSecrets(imagePullSecrets []api.LocalObjectReference) valid
 	return allErrors
 }
 
+// validateAffinity tests that the specified affinity scheduling rules have valid data.
+func validateAffinity(affinity *api.Affinity) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	if affinity.NodeAffinity != nil {
+		allErrs = append(allErrs, validateNodeAffinity(affinity.NodeAffinity).Prefix("nodeAffinity")...)
+	}
+	if affinity.PodAffinity != nil {
+		allErrs = append(allErrs, validatePodAffinityTerms(affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution).Prefix("podAffinity.requiredDuringSchedulingIgnoredDuringExecution")...)
+		allErrs = append(allErrs, validateWeightedPodAffinityTerms(affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution).Prefix("podAffinity.preferredDuringSchedulingIgnoredDuringExecution")...)
+	}
+	if affinity.PodAntiAffinity != nil {
+		allErrs = append(allErrs, validatePodAffinityTerms(affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).Prefix("podAntiAffinity.requiredDuringSchedulingIgnoredDuringExecution")...)
+		allErrs = append(allErrs, validateWeightedPodAffinityTerms(affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution).Prefix("podAntiAffinity.preferredDuringSchedulingIgnoredDuringExecution")...)
+	}
+	return allErrs
+}
+
+// validateNodeAffinity tests that the specified node affinity scheduling rules have valid data.
+func validateNodeAffinity(na *api.NodeAffinity) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	if na.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		allErrs = append(allErrs, validateNodeSelector(na.RequiredDuringSchedulingIgnoredDuringExecution).Prefix("requiredDuringSchedulingIgnoredDuringExecution")...)
+	}
+	allErrs = append(allErrs, validatePreferredSchedulingTerms(na.PreferredDuringSchedulingIgnoredDuringExecution).Prefix("preferredDuringSchedulingIgnoredDuringExecution")...)
+	return allErrs
+}
+
+// validateNodeSelector tests that the specified node selector has valid data.
+func validateNodeSelector(nodeSelector *api.NodeSelector) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		return append(allErrs, validation.NewRequiredError("nodeSelectorTerms"))
+	}
+	for i, term := range nodeSelector.NodeSelectorTerms {
+		allErrs = append(allErrs, validateNodeSelectorTerm(term).PrefixIndex(i).Prefix("nodeSelectorTerms")...)
+	}
+	return allErrs
+}
+
+func validateNodeSelectorTerm(term api.NodeSelectorTerm) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	if len(term.MatchExpressions) == 0 {
+		return append(allErrs, validation.NewRequiredError("matchExpressions"))
+	}
+	for j, req := range term.MatchExpressions {
+		allErrs = append(allErrs, validateNodeSelectorRequirement(req).PrefixIndex(j).Prefix("matchExpressions")...)
+	}
+	return allErrs
+}
+
+// validateNodeSelectorRequirement tests that the specified node selector requirement has valid data.
+func validateNodeSelectorRequirement(rq api.NodeSelectorRequirement) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	switch rq.Operator {
+	case api.NodeSelectorOpIn, api.NodeSelectorOpNotIn:
+		if len(rq.Values) == 0 {
+			allErrs = append(allErrs, validation.NewRequiredError("values"))
+		}
+	case api.NodeSelectorOpExists, api.NodeSelectorOpDoesNotExist:
+		if len(rq.Values) > 0 {
+			allErrs = append(allErrs, validation.NewForbiddenError("values", rq.Values))
+		}
+	case api.NodeSelectorOpGt, api.NodeSelectorOpLt:
+		if len(rq.Values) != 1 {
+			allErrs = append(allErrs, validation.NewInvalidError("values", rq.Values, "must be specified single value when `operator` is 'Lt' or 'Gt'"))
+		} else if _, err := strconv.ParseInt(rq.Values[0], 10, 64); err != nil {
+			allErrs = append(allErrs, validation.NewInvalidError("values", rq.Values[0], "must be an integer when `operator` is 'Lt' or 'Gt'"))
+		}
+	default:
+		allErrs = append(allErrs, validation.NewNotSupportedError("operator", rq.Operator, []string{
+			string(api.NodeSelectorOpIn), string(api.NodeSelectorOpNotIn), string(api.NodeSelectorOpExists),
+			string(api.NodeSelectorOpDoesNotExist), string(api.NodeSelectorOpGt), string(api.NodeSelectorOpLt),
+		}))
+	}
+	allErrs = append(allErrs, ValidateLabelName(rq.Key, "key")...)
+	return allErrs
+}
+
+// validatePreferredSchedulingTerms tests that the specified preferred scheduling terms have valid data.
+func validatePreferredSchedulingTerms(terms []api.PreferredSchedulingTerm) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	for i, term := range terms {
+		idxErrs := validation.ErrorList{}
+		if term.Weight <= 0 || term.Weight > 100 {
+			idxErrs = append(idxErrs, validation.NewInvalidError("weight", term.Weight, "must be in the range 1-100"))
+		}
+		idxErrs = append(idxErrs, validateNodeSelectorTerm(term.Preference).Prefix("preference")...)
+		allErrs = append(allErrs, idxErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
+func validatePodAffinityTerms(terms []api.PodAffinityTerm) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	for i, term := range terms {
+		allErrs = append(allErrs, validatePodAffinityTerm(term).PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
+func validateWeightedPodAffinityTerms(terms []api.WeightedPodAffinityTerm) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	for i, term := range terms {
+		termErrs := validation.ErrorList{}
+		if term.Weight <= 0 || term.Weight > 100 {
+			termErrs = append(termErrs, validation.NewInvalidError("weight", term.Weight, "must be in the range 1-100"))
+		}
+		termErrs = append(termErrs, validatePodAffinityTerm(term.PodAffinityTerm).Prefix("podAffinityTerm")...)
+		allErrs = append(allErrs, termErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
+func validatePodAffinityTerm(term api.PodAffinityTerm) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, ValidateLabels(term.LabelSelector, "labelSelector")...)
+	for _, namespace := range term.Namespaces {
+		if ok, msg := ValidateNamespaceName(namespace, false); !ok {
+			allErrs = append(allErrs, validation.NewInvalidError("namespaces", namespace, msg))
+		}
+	}
+	if len(term.TopologyKey) == 0 {
+		allErrs = append(allErrs, validation.NewRequiredError("topologyKey"))
+	} else {
+		allErrs = append(allErrs, ValidateLabelName(term.TopologyKey, "topologyKey")...)
+	}
+	return allErrs
+}
+
+// validateTolerations tests if given tolerations have valid data.
+func validateTolerations(tolerations []api.Toleration) validation.ErrorList {
+	allErrors := validation.ErrorList{}
+	for i, toleration := range tolerations {
+		idxErrs := validation.ErrorList{}
+		if len(toleration.Key) == 0 {
+			idxErrs = append(idxErrs, validation.NewRequiredError("key"))
+		} else {
+			idxErrs = append(idxErrs, ValidateLabelName(toleration.Key, "key")...)
+		}
+		switch toleration.Operator {
+		case api.TolerationOpEqual, "":
+			if !validation.IsValidLabelValue(toleration.Value) {
+				idxErrs = append(idxErrs, validation.NewInvalidError("value", toleration.Value, labelValueErrorMsg))
+			}
+		case api.TolerationOpExists:
+			if len(toleration.Value) > 0 {
+				idxErrs = append(idxErrs, validation.NewInvalidError("value", toleration.Value, "value must be empty when operator is Exists"))
+			}
+		default:
+			idxErrs = append(idxErrs, validation.NewNotSupportedError("operator", toleration.Operator, []string{string(api.TolerationOpEqual), string(api.TolerationOpExists)}))
+		}
+		if len(toleration.Effect) > 0 {
+			idxErrs = append(idxErrs, validateTaintEffect(toleration.Effect).Prefix("effect")...)
+		}
+		allErrors = append(allErrors, idxErrs.PrefixIndex(i)...)
+	}
+	return allErrors
+}
+
+// validateTaints tests if given taints have valid data.
+func validateTaints(taints []api.Taint) validation.ErrorList {
+	allErrors := validation.ErrorList{}
+	uniqueTaints := map[api.TaintEffect]sets.String{}
+	for i, taint := range taints {
+		idxErrs := validation.ErrorList{}
+		if len(taint.Key) == 0 {
+			idxErrs = append(idxErrs, validation.NewRequiredError("key"))
+		} else {
+			idxErrs = append(idxErrs, ValidateLabelName(taint.Key, "key")...)
+		}
+		if !validation.IsValidLabelValue(taint.Value) {
+			idxErrs = append(idxErrs, validation.NewInvalidError("value", taint.Value, labelValueErrorMsg))
+		}
+		if len(taint.Effect) == 0 {
+			idxErrs = append(idxErrs, validation.NewRequiredError("effect"))
+		} else {
+			idxErrs = append(idxErrs, validateTaintEffect(taint.Effect).Prefix("effect")...)
+		}
+		// A node may have only one taint per key and effect.
+		if uniqueTaints[taint.Effect] == nil {
+			uniqueTaints[taint.Effect] = sets.NewString()
+		}
+		if uniqueTaints[taint.Effect].Has(taint.Key) {
+			idxErrs = append(idxErrs, validation.NewDuplicateError("key", taint.Key))
+		}
+		uniqueTaints[taint.Effect].Insert(taint.Key)
+		allErrors = append(allErrors, idxErrs.PrefixIndex(i)...)
+	}
+	return allErrors
+}
+
+func validateTaintEffect(effect api.TaintEffect) validation.ErrorList {
+	allErrors := validation.ErrorList{}
+	switch effect {
+	case api.TaintEffectNoSchedule, api.TaintEffectPreferNoSchedule:
+	default:
+		allErrors = append(allErrors, validation.NewNotSupportedError("", effect, []string{string(api.TaintEffectNoSchedule), string(api.TaintEffectPreferNoSchedule)}))
+	}
+	return allErrors
+}
+
 // ValidatePod tests if required fields in the pod are set.
 func ValidatePod(pod *api.Pod) validation.ErrorList {
 	allErrs := validation.ErrorList{}
@@ -1130,6 +1418,10 @@ func ValidatePodSpec(spec *api.PodSpec) validation.ErrorList {
 	allErrs = append(allErrs, ValidateLabels(spec.NodeSelector, "nodeSelector")...)
 	allErrs = append(allErrs, ValidatePodSecurityContext(spec.SecurityContext, spec).Prefix("securityContext")...)
 	allErrs = append(allErrs, validateImagePullSecrets(spec.ImagePullSecrets).Prefix("imagePullSecrets")...)
+	if spec.Affinity != nil {
+		allErrs = append(allErrs, validateAffinity(spec.Affinity).Prefix("affinity")...)
+	}
+	allErrs = append(allErrs, validateTolerations(spec.Tolerations).Prefix("tolerations")...)
 	if len(spec.ServiceAccountName) > 0 {
 		if ok, msg := ValidateServiceAccountName(spec.ServiceAccountName, false); !ok {
 			allErrs = append(allErrs, validation.NewInvalidError("serviceAccountName", spec.ServiceAccountName, msg))
@@ -1461,6 +1753,7 @@ func ValidateNode(node *api.Node) validation.ErrorList {
 	if len(node.Spec.ExternalID) == 0 {
 		allErrs = append(allErrs, validation.NewRequiredError("spec.ExternalID"))
 	}
+	allErrs = append(allErrs, validateTaints(node.Spec.Taints).Prefix("spec.taints")...)
 
 	// TODO(rjnagal): Ignore PodCIDR till its completely implemented.
 	return allErrs
@@ -1477,6 +1770,8 @@ func ValidateNodeUpdate(node, oldNode *api.Node) validation.ErrorList {
 	// 	allErrs = append(allErrs, validation.NewInvalidError("status", node.Status, "status must be empty"))
 	// }
 
+	allErrs = append(allErrs, validateTaints(node.Spec.Taints).Prefix("spec.taints")...)
+
 	// Validte no duplicate addresses in node status.
 	addresses := make(map[api.NodeAddress]bool)
 	for _, address := range node.Status.Addresses {
@@ -1495,6 +1790,8 @@ func ValidateNodeUpdate(node, oldNode *api.Node) validation.ErrorList {
 	oldNode.Spec.PodCIDR = node.Spec.PodCIDR
 	// Allow users to unschedule node
 	oldNode.Spec.Unschedulable = node.Spec.Unschedulable
+	// Allow users to taint and untaint the node
+	oldNode.Spec.Taints = node.Spec.Taints
 	// Clear status
 	oldNode.Status = node.Status
 
@@ -1700,6 +1997,14 @@ func ValidateSecret(secret *api.Secret) validation.ErrorList {
 			allErrs = append(allErrs, validation.NewInvalidError(fmt.Sprintf("data[%s]", api.DockerConfigKey), "<secret contents redacted>", err.Error()))
 		}
 
+	case api.SecretTypeTLS:
+		if _, exists := secret.Data[api.TLSCertKey]; !exists {
+			allErrs = append(allErrs, validation.NewRequiredError(fmt.Sprintf("data[%s]", api.TLSCertKey)))
+		}
+		if _, exists := secret.Data[api.TLSPrivateKeyKey]; !exists {
+			allErrs = append(allErrs, validation.NewRequiredError(fmt.Sprintf("data[%s]", api.TLSPrivateKeyKey)))
+		}
+
 	default:
 		// no-op
 	}
@@ -1722,6 +2027,29 @@ func ValidateSecretUpdate(newSecret, oldSecret *api.Secret) validation.ErrorList
 	return allErrs
 }
 
+// ValidateConfigMap tests whether required fields in the ConfigMap are set.
+func ValidateConfigMap(cfg *api.ConfigMap) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&cfg.ObjectMeta, true, ValidateConfigMapName).Prefix("metadata")...)
+
+	for key := range cfg.Data {
+		if !IsSecretKey(key) {
+			allErrs = append(allErrs, validation.NewInvalidError(fmt.Sprintf("data[%s]", key), key, fmt.Sprintf("must have at most %d characters and match regex %s", validation.DNS1123SubdomainMaxLength, SecretKeyFmt)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateConfigMapUpdate tests if required fields in the ConfigMap are set.
+func ValidateConfigMapUpdate(newCfg, oldCfg *api.ConfigMap) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&newCfg.ObjectMeta, &oldCfg.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateConfigMap(newCfg)...)
+
+	return allErrs
+}
+
 func validateBasicResource(quantity resource.Quantity) validation.ErrorList {
 	if quantity.Value() < 0 {
 		return validation.ErrorList{validation.NewInvalidError("", quantity.Value(), "must be a valid resource quantity")}
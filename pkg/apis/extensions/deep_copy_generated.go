@@ -37,6 +37,34 @@ func deepCopy_api_AWSElasticBlockStoreVolumeSource(in api.AWSElasticBlockStoreVo
 	return nil
 }
 
+func deepCopy_api_Affinity(in api.Affinity, out *api.Affinity, c *conversion.Cloner) error {
+	if in.NodeAffinity != nil {
+		out.NodeAffinity = new(api.NodeAffinity)
+		if err := deepCopy_api_NodeAffinity(*in.NodeAffinity, out.NodeAffinity, c); err != nil {
+			return err
+		}
+	} else {
+		out.NodeAffinity = nil
+	}
+	if in.PodAffinity != nil {
+		out.PodAffinity = new(api.PodAffinity)
+		if err := deepCopy_api_PodAffinity(*in.PodAffinity, out.PodAffinity, c); err != nil {
+			return err
+		}
+	} else {
+		out.PodAffinity = nil
+	}
+	if in.PodAntiAffinity != nil {
+		out.PodAntiAffinity = new(api.PodAntiAffinity)
+		if err := deepCopy_api_PodAntiAffinity(*in.PodAntiAffinity, out.PodAntiAffinity, c); err != nil {
+			return err
+		}
+	} else {
+		out.PodAntiAffinity = nil
+	}
+	return nil
+}
+
 func deepCopy_api_Capabilities(in api.Capabilities, out *api.Capabilities, c *conversion.Cloner) error {
 	if in.Add != nil {
 		out.Add = make([]api.Capability, len(in.Add))
@@ -87,6 +115,31 @@ func deepCopy_api_CinderVolumeSource(in api.CinderVolumeSource, out *api.CinderV
 	return nil
 }
 
+func deepCopy_api_ConfigMapKeySelector(in api.ConfigMapKeySelector, out *api.ConfigMapKeySelector, c *conversion.Cloner) error {
+	if err := deepCopy_api_LocalObjectReference(in.LocalObjectReference, &out.LocalObjectReference, c); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func deepCopy_api_ConfigMapVolumeSource(in api.ConfigMapVolumeSource, out *api.ConfigMapVolumeSource, c *conversion.Cloner) error {
+	if err := deepCopy_api_LocalObjectReference(in.LocalObjectReference, &out.LocalObjectReference, c); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]api.KeyToPath, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_KeyToPath(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
 func deepCopy_api_Container(in api.Container, out *api.Container, c *conversion.Cloner) error {
 	out.Name = in.Name
 	out.Image = in.Image
@@ -239,6 +292,22 @@ func deepCopy_api_EnvVarSource(in api.EnvVarSource, out *api.EnvVarSource, c *co
 	} else {
 		out.FieldRef = nil
 	}
+	if in.ConfigMapKeyRef != nil {
+		out.ConfigMapKeyRef = new(api.ConfigMapKeySelector)
+		if err := deepCopy_api_ConfigMapKeySelector(*in.ConfigMapKeyRef, out.ConfigMapKeyRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapKeyRef = nil
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(api.SecretKeySelector)
+		if err := deepCopy_api_SecretKeySelector(*in.SecretKeyRef, out.SecretKeyRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
 	return nil
 }
 
@@ -352,6 +421,12 @@ func deepCopy_api_ISCSIVolumeSource(in api.ISCSIVolumeSource, out *api.ISCSIVolu
 	return nil
 }
 
+func deepCopy_api_KeyToPath(in api.KeyToPath, out *api.KeyToPath, c *conversion.Cloner) error {
+	out.Key = in.Key
+	out.Path = in.Path
+	return nil
+}
+
 func deepCopy_api_Lifecycle(in api.Lifecycle, out *api.Lifecycle, c *conversion.Cloner) error {
 	if in.PostStart != nil {
 		out.PostStart = new(api.Handler)
@@ -404,6 +479,70 @@ func deepCopy_api_NFSVolumeSource(in api.NFSVolumeSource, out *api.NFSVolumeSour
 	return nil
 }
 
+func deepCopy_api_NodeAffinity(in api.NodeAffinity, out *api.NodeAffinity, c *conversion.Cloner) error {
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = new(api.NodeSelector)
+		if err := deepCopy_api_NodeSelector(*in.RequiredDuringSchedulingIgnoredDuringExecution, out.RequiredDuringSchedulingIgnoredDuringExecution, c); err != nil {
+			return err
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]api.PreferredSchedulingTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_PreferredSchedulingTerm(in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func deepCopy_api_NodeSelector(in api.NodeSelector, out *api.NodeSelector, c *conversion.Cloner) error {
+	if in.NodeSelectorTerms != nil {
+		out.NodeSelectorTerms = make([]api.NodeSelectorTerm, len(in.NodeSelectorTerms))
+		for i := range in.NodeSelectorTerms {
+			if err := deepCopy_api_NodeSelectorTerm(in.NodeSelectorTerms[i], &out.NodeSelectorTerms[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.NodeSelectorTerms = nil
+	}
+	return nil
+}
+
+func deepCopy_api_NodeSelectorRequirement(in api.NodeSelectorRequirement, out *api.NodeSelectorRequirement, c *conversion.Cloner) error {
+	out.Key = in.Key
+	out.Operator = in.Operator
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func deepCopy_api_NodeSelectorTerm(in api.NodeSelectorTerm, out *api.NodeSelectorTerm, c *conversion.Cloner) error {
+	if in.MatchExpressions != nil {
+		out.MatchExpressions = make([]api.NodeSelectorRequirement, len(in.MatchExpressions))
+		for i := range in.MatchExpressions {
+			if err := deepCopy_api_NodeSelectorRequirement(in.MatchExpressions[i], &out.MatchExpressions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchExpressions = nil
+	}
+	return nil
+}
+
 func deepCopy_api_ObjectFieldSelector(in api.ObjectFieldSelector, out *api.ObjectFieldSelector, c *conversion.Cloner) error {
 	out.APIVersion = in.APIVersion
 	out.FieldPath = in.FieldPath
@@ -460,6 +599,75 @@ func deepCopy_api_PersistentVolumeClaimVolumeSource(in api.PersistentVolumeClaim
 	return nil
 }
 
+func deepCopy_api_PodAffinity(in api.PodAffinity, out *api.PodAffinity, c *conversion.Cloner) error {
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]api.PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_PodAffinityTerm(in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]api.WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_WeightedPodAffinityTerm(in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PodAffinityTerm(in api.PodAffinityTerm, out *api.PodAffinityTerm, c *conversion.Cloner) error {
+	if in.LabelSelector != nil {
+		out.LabelSelector = make(map[string]string)
+		for key, val := range in.LabelSelector {
+			out.LabelSelector[key] = val
+		}
+	} else {
+		out.LabelSelector = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.TopologyKey = in.TopologyKey
+	return nil
+}
+
+func deepCopy_api_PodAntiAffinity(in api.PodAntiAffinity, out *api.PodAntiAffinity, c *conversion.Cloner) error {
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]api.PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_PodAffinityTerm(in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]api.WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_WeightedPodAffinityTerm(in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
 func deepCopy_api_PodSecurityContext(in api.PodSecurityContext, out *api.PodSecurityContext, c *conversion.Cloner) error {
 	out.HostNetwork = in.HostNetwork
 	out.HostPID = in.HostPID
@@ -564,6 +772,24 @@ func deepCopy_api_PodSpec(in api.PodSpec, out *api.PodSpec, c *conversion.Cloner
 	} else {
 		out.ImagePullSecrets = nil
 	}
+	if in.Affinity != nil {
+		out.Affinity = new(api.Affinity)
+		if err := deepCopy_api_Affinity(*in.Affinity, out.Affinity, c); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	if in.Tolerations != nil {
+		out.Tolerations = make([]api.Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := deepCopy_api_Toleration(in.Tolerations[i], &out.Tolerations[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
 	return nil
 }
 
@@ -577,6 +803,14 @@ func deepCopy_api_PodTemplateSpec(in api.PodTemplateSpec, out *api.PodTemplateSp
 	return nil
 }
 
+func deepCopy_api_PreferredSchedulingTerm(in api.PreferredSchedulingTerm, out *api.PreferredSchedulingTerm, c *conversion.Cloner) error {
+	out.Weight = in.Weight
+	if err := deepCopy_api_NodeSelectorTerm(in.Preference, &out.Preference, c); err != nil {
+		return err
+	}
+	return nil
+}
+
 func deepCopy_api_Probe(in api.Probe, out *api.Probe, c *conversion.Cloner) error {
 	if err := deepCopy_api_Handler(in.Handler, &out.Handler, c); err != nil {
 		return err
@@ -651,6 +885,14 @@ func deepCopy_api_SELinuxOptions(in api.SELinuxOptions, out *api.SELinuxOptions,
 	return nil
 }
 
+func deepCopy_api_SecretKeySelector(in api.SecretKeySelector, out *api.SecretKeySelector, c *conversion.Cloner) error {
+	if err := deepCopy_api_LocalObjectReference(in.LocalObjectReference, &out.LocalObjectReference, c); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
 func deepCopy_api_SecretVolumeSource(in api.SecretVolumeSource, out *api.SecretVolumeSource, c *conversion.Cloner) error {
 	out.SecretName = in.SecretName
 	return nil
@@ -701,6 +943,14 @@ func deepCopy_api_TCPSocketAction(in api.TCPSocketAction, out *api.TCPSocketActi
 	return nil
 }
 
+func deepCopy_api_Toleration(in api.Toleration, out *api.Toleration, c *conversion.Cloner) error {
+	out.Key = in.Key
+	out.Operator = in.Operator
+	out.Value = in.Value
+	out.Effect = in.Effect
+	return nil
+}
+
 func deepCopy_api_Volume(in api.Volume, out *api.Volume, c *conversion.Cloner) error {
 	out.Name = in.Name
 	if err := deepCopy_api_VolumeSource(in.VolumeSource, &out.VolumeSource, c); err != nil {
@@ -845,6 +1095,22 @@ func deepCopy_api_VolumeSource(in api.VolumeSource, out *api.VolumeSource, c *co
 	} else {
 		out.FC = nil
 	}
+	if in.ConfigMap != nil {
+		out.ConfigMap = new(api.ConfigMapVolumeSource)
+		if err := deepCopy_api_ConfigMapVolumeSource(*in.ConfigMap, out.ConfigMap, c); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMap = nil
+	}
+	return nil
+}
+
+func deepCopy_api_WeightedPodAffinityTerm(in api.WeightedPodAffinityTerm, out *api.WeightedPodAffinityTerm, c *conversion.Cloner) error {
+	out.Weight = in.Weight
+	if err := deepCopy_api_PodAffinityTerm(in.PodAffinityTerm, &out.PodAffinityTerm, c); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1022,6 +1288,20 @@ func deepCopy_extensions_Deployment(in Deployment, out *Deployment, c *conversio
 	return nil
 }
 
+func deepCopy_extensions_DeploymentCondition(in DeploymentCondition, out *DeploymentCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if err := deepCopy_unversioned_Time(in.LastUpdateTime, &out.LastUpdateTime, c); err != nil {
+		return err
+	}
+	if err := deepCopy_unversioned_Time(in.LastTransitionTime, &out.LastTransitionTime, c); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_extensions_DeploymentList(in DeploymentList, out *DeploymentList, c *conversion.Cloner) error {
 	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
 		return err
@@ -1042,6 +1322,25 @@ func deepCopy_extensions_DeploymentList(in DeploymentList, out *DeploymentList,
 	return nil
 }
 
+func deepCopy_extensions_DeploymentRollback(in DeploymentRollback, out *DeploymentRollback, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	out.Name = in.Name
+	if in.UpdatedAnnotations != nil {
+		out.UpdatedAnnotations = make(map[string]string)
+		for key, val := range in.UpdatedAnnotations {
+			out.UpdatedAnnotations[key] = val
+		}
+	} else {
+		out.UpdatedAnnotations = nil
+	}
+	if err := deepCopy_extensions_RollbackConfig(in.RollbackTo, &out.RollbackTo, c); err != nil {
+		return err
+	}
+	return nil
+}
+
 func deepCopy_extensions_DeploymentSpec(in DeploymentSpec, out *DeploymentSpec, c *conversion.Cloner) error {
 	out.Replicas = in.Replicas
 	if in.Selector != nil {
@@ -1059,12 +1358,39 @@ func deepCopy_extensions_DeploymentSpec(in DeploymentSpec, out *DeploymentSpec,
 		return err
 	}
 	out.UniqueLabelKey = in.UniqueLabelKey
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	if in.RollbackTo != nil {
+		out.RollbackTo = new(RollbackConfig)
+		if err := deepCopy_extensions_RollbackConfig(*in.RollbackTo, out.RollbackTo, c); err != nil {
+			return err
+		}
+	} else {
+		out.RollbackTo = nil
+	}
 	return nil
 }
 
 func deepCopy_extensions_DeploymentStatus(in DeploymentStatus, out *DeploymentStatus, c *conversion.Cloner) error {
+	out.ObservedGeneration = in.ObservedGeneration
 	out.Replicas = in.Replicas
 	out.UpdatedReplicas = in.UpdatedReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_extensions_DeploymentCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -1396,6 +1722,77 @@ func deepCopy_extensions_NodeUtilization(in NodeUtilization, out *NodeUtilizatio
 	return nil
 }
 
+func deepCopy_extensions_PodDisruptionBudget(in PodDisruptionBudget, out *PodDisruptionBudget, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_ObjectMeta(in.ObjectMeta, &out.ObjectMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_extensions_PodDisruptionBudgetSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_extensions_PodDisruptionBudgetStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_extensions_PodDisruptionBudgetList(in PodDisruptionBudgetList, out *PodDisruptionBudgetList, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_unversioned_ListMeta(in.ListMeta, &out.ListMeta, c); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]PodDisruptionBudget, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_extensions_PodDisruptionBudget(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_extensions_PodDisruptionBudgetSpec(in PodDisruptionBudgetSpec, out *PodDisruptionBudgetSpec, c *conversion.Cloner) error {
+	if err := deepCopy_intstr_IntOrString(in.MinAvailable, &out.MinAvailable, c); err != nil {
+		return err
+	}
+	if in.Selector != nil {
+		out.Selector = new(PodSelector)
+		if err := deepCopy_extensions_PodSelector(*in.Selector, out.Selector, c); err != nil {
+			return err
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
+func deepCopy_extensions_PodDisruptionBudgetStatus(in PodDisruptionBudgetStatus, out *PodDisruptionBudgetStatus, c *conversion.Cloner) error {
+	out.PodDisruptionsAllowed = in.PodDisruptionsAllowed
+	out.CurrentHealthy = in.CurrentHealthy
+	out.DesiredHealthy = in.DesiredHealthy
+	out.ExpectedPods = in.ExpectedPods
+	if in.DisruptedPods != nil {
+		out.DisruptedPods = make(map[string]unversioned.Time)
+		for key, val := range in.DisruptedPods {
+			newVal := new(unversioned.Time)
+			if err := deepCopy_unversioned_Time(val, newVal, c); err != nil {
+				return err
+			}
+			out.DisruptedPods[key] = *newVal
+		}
+	} else {
+		out.DisruptedPods = nil
+	}
+	return nil
+}
+
 func deepCopy_extensions_PodSelector(in PodSelector, out *PodSelector, c *conversion.Cloner) error {
 	if in.MatchLabels != nil {
 		out.MatchLabels = make(map[string]string)
@@ -1439,6 +1836,11 @@ func deepCopy_extensions_ReplicationControllerDummy(in ReplicationControllerDumm
 	return nil
 }
 
+func deepCopy_extensions_RollbackConfig(in RollbackConfig, out *RollbackConfig, c *conversion.Cloner) error {
+	out.Revision = in.Revision
+	return nil
+}
+
 func deepCopy_extensions_RollingUpdateDeployment(in RollingUpdateDeployment, out *RollingUpdateDeployment, c *conversion.Cloner) error {
 	if err := deepCopy_intstr_IntOrString(in.MaxUnavailable, &out.MaxUnavailable, c); err != nil {
 		return err
@@ -1581,9 +1983,12 @@ func deepCopy_intstr_IntOrString(in intstr.IntOrString, out *intstr.IntOrString,
 func init() {
 	err := api.Scheme.AddGeneratedDeepCopyFuncs(
 		deepCopy_api_AWSElasticBlockStoreVolumeSource,
+		deepCopy_api_Affinity,
 		deepCopy_api_Capabilities,
 		deepCopy_api_CephFSVolumeSource,
 		deepCopy_api_CinderVolumeSource,
+		deepCopy_api_ConfigMapKeySelector,
+		deepCopy_api_ConfigMapVolumeSource,
 		deepCopy_api_Container,
 		deepCopy_api_ContainerPort,
 		deepCopy_api_DownwardAPIVolumeFile,
@@ -1601,27 +2006,39 @@ func init() {
 		deepCopy_api_Handler,
 		deepCopy_api_HostPathVolumeSource,
 		deepCopy_api_ISCSIVolumeSource,
+		deepCopy_api_KeyToPath,
 		deepCopy_api_Lifecycle,
 		deepCopy_api_LoadBalancerIngress,
 		deepCopy_api_LoadBalancerStatus,
 		deepCopy_api_LocalObjectReference,
 		deepCopy_api_NFSVolumeSource,
+		deepCopy_api_NodeAffinity,
+		deepCopy_api_NodeSelector,
+		deepCopy_api_NodeSelectorRequirement,
+		deepCopy_api_NodeSelectorTerm,
 		deepCopy_api_ObjectFieldSelector,
 		deepCopy_api_ObjectMeta,
 		deepCopy_api_PersistentVolumeClaimVolumeSource,
+		deepCopy_api_PodAffinity,
+		deepCopy_api_PodAffinityTerm,
+		deepCopy_api_PodAntiAffinity,
 		deepCopy_api_PodSecurityContext,
 		deepCopy_api_PodSpec,
 		deepCopy_api_PodTemplateSpec,
+		deepCopy_api_PreferredSchedulingTerm,
 		deepCopy_api_Probe,
 		deepCopy_api_RBDVolumeSource,
 		deepCopy_api_ResourceRequirements,
 		deepCopy_api_SELinuxOptions,
+		deepCopy_api_SecretKeySelector,
 		deepCopy_api_SecretVolumeSource,
 		deepCopy_api_SecurityContext,
 		deepCopy_api_TCPSocketAction,
+		deepCopy_api_Toleration,
 		deepCopy_api_Volume,
 		deepCopy_api_VolumeMount,
 		deepCopy_api_VolumeSource,
+		deepCopy_api_WeightedPodAffinityTerm,
 		deepCopy_resource_Quantity,
 		deepCopy_unversioned_ListMeta,
 		deepCopy_unversioned_Time,
@@ -1636,7 +2053,9 @@ func init() {
 		deepCopy_extensions_DaemonSetSpec,
 		deepCopy_extensions_DaemonSetStatus,
 		deepCopy_extensions_Deployment,
+		deepCopy_extensions_DeploymentCondition,
 		deepCopy_extensions_DeploymentList,
+		deepCopy_extensions_DeploymentRollback,
 		deepCopy_extensions_DeploymentSpec,
 		deepCopy_extensions_DeploymentStatus,
 		deepCopy_extensions_DeploymentStrategy,
@@ -1659,9 +2078,14 @@ func init() {
 		deepCopy_extensions_JobSpec,
 		deepCopy_extensions_JobStatus,
 		deepCopy_extensions_NodeUtilization,
+		deepCopy_extensions_PodDisruptionBudget,
+		deepCopy_extensions_PodDisruptionBudgetList,
+		deepCopy_extensions_PodDisruptionBudgetSpec,
+		deepCopy_extensions_PodDisruptionBudgetStatus,
 		deepCopy_extensions_PodSelector,
 		deepCopy_extensions_PodSelectorRequirement,
 		deepCopy_extensions_ReplicationControllerDummy,
+		deepCopy_extensions_RollbackConfig,
 		deepCopy_extensions_RollingUpdateDeployment,
 		deepCopy_extensions_Scale,
 		deepCopy_extensions_ScaleSpec,
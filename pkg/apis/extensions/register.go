@@ -37,6 +37,7 @@ func addKnownTypes() {
 		&ClusterAutoscalerList{},
 		&Deployment{},
 		&DeploymentList{},
+		&DeploymentRollback{},
 		&HorizontalPodAutoscaler{},
 		&HorizontalPodAutoscalerList{},
 		&Job{},
@@ -51,6 +52,8 @@ func addKnownTypes() {
 		&ThirdPartyResourceDataList{},
 		&Ingress{},
 		&IngressList{},
+		&PodDisruptionBudget{},
+		&PodDisruptionBudgetList{},
 	)
 }
 
@@ -58,6 +61,7 @@ func (*ClusterAutoscaler) IsAnAPIObject()           {}
 func (*ClusterAutoscalerList) IsAnAPIObject()       {}
 func (*Deployment) IsAnAPIObject()                  {}
 func (*DeploymentList) IsAnAPIObject()              {}
+func (*DeploymentRollback) IsAnAPIObject()          {}
 func (*HorizontalPodAutoscaler) IsAnAPIObject()     {}
 func (*HorizontalPodAutoscalerList) IsAnAPIObject() {}
 func (*Job) IsAnAPIObject()                         {}
@@ -72,3 +76,5 @@ func (*ThirdPartyResourceData) IsAnAPIObject()      {}
 func (*ThirdPartyResourceDataList) IsAnAPIObject()  {}
 func (*Ingress) IsAnAPIObject()                     {}
 func (*IngressList) IsAnAPIObject()                 {}
+func (*PodDisruptionBudget) IsAnAPIObject()         {}
+func (*PodDisruptionBudgetList) IsAnAPIObject()     {}
@@ -220,6 +220,38 @@ type DeploymentSpec struct {
 	// Value of this key is hash of DeploymentSpec.PodTemplateSpec.
 	// No label is added if this is set to empty string.
 	UniqueLabelKey string `json:"uniqueLabelKey,omitempty"`
+
+	// Indicates that the deployment is paused. The deployment controller keeps
+	// scaling a paused deployment and updating its status, but does not roll
+	// out changes to its pod template until it is resumed.
+	Paused bool `json:"paused,omitempty"`
+
+	// The maximum time in seconds for a deployment to make progress before it
+	// is considered to be failed. The deployment controller keeps processing a
+	// failed deployment and surfaces a Progressing condition with a
+	// ProgressDeadlineExceeded reason in the deployment status.
+	// Progress is not estimated while the deployment is paused.
+	// No deadline is enforced if unset.
+	ProgressDeadlineSeconds *int `json:"progressDeadlineSeconds,omitempty"`
+
+	// The config this deployment is rolling back to. Will be cleared after rollback is done.
+	RollbackTo *RollbackConfig `json:"rollbackTo,omitempty"`
+}
+
+// DeploymentRollback stores the information required to rollback a deployment.
+type DeploymentRollback struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Required: This must match the Name of a deployment.
+	Name string `json:"name"`
+	// The annotations to be updated to a deployment
+	UpdatedAnnotations map[string]string `json:"updatedAnnotations,omitempty"`
+	// The config of this deployment rollback.
+	RollbackTo RollbackConfig `json:"rollbackTo"`
+}
+
+type RollbackConfig struct {
+	// The revision to rollback to. If set to 0, rollback to the last revision.
+	Revision int64 `json:"revision,omitempty"`
 }
 
 type DeploymentStrategy struct {
@@ -277,11 +309,53 @@ type RollingUpdateDeployment struct {
 }
 
 type DeploymentStatus struct {
+	// The generation observed by the deployment controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	// Total number of non-terminated pods targeted by this deployment (their labels match the selector).
 	Replicas int `json:"replicas,omitempty"`
 
 	// Total number of non-terminated pods targeted by this deployment that have the desired template spec.
 	UpdatedReplicas int `json:"updatedReplicas,omitempty"`
+
+	// Total number of ready pods targeted by this deployment.
+	AvailableReplicas int `json:"availableReplicas,omitempty"`
+
+	// Represents the latest available observations of a deployment's current state.
+	Conditions []DeploymentCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+type DeploymentConditionType string
+
+// These are valid conditions of a deployment.
+const (
+	// Available means the deployment is available, ie. at least the minimum available
+	// replicas required are up and running.
+	DeploymentAvailable DeploymentConditionType = "Available"
+	// Progressing means the deployment is progressing. Progress for a deployment is
+	// considered when a new replication controller is created or adopted, and when new
+	// pods scale up or old pods scale down. Progress is not estimated for paused
+	// deployments or when progressDeadlineSeconds is not specified.
+	DeploymentProgressing DeploymentConditionType = "Progressing"
+	// ReplicaFailure is added in a deployment when one of its replication controllers
+	// fails to be created or scaled.
+	DeploymentReplicaFailure DeploymentConditionType = "ReplicaFailure"
+)
+
+// DeploymentCondition describes the state of a deployment at a certain point.
+type DeploymentCondition struct {
+	// Type of deployment condition.
+	Type DeploymentConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status api.ConditionStatus `json:"status"`
+	// The last time this condition was updated.
+	LastUpdateTime unversioned.Time `json:"lastUpdateTime,omitempty"`
+	// Last time the condition transitioned from one status to another.
+	LastTransitionTime unversioned.Time `json:"lastTransitionTime,omitempty"`
+	// The reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// A human readable message indicating details about the transition.
+	Message string `json:"message,omitempty"`
 }
 
 type DeploymentList struct {
@@ -683,3 +757,59 @@ const (
 	PodSelectorOpExists       PodSelectorOperator = "Exists"
 	PodSelectorOpDoesNotExist PodSelectorOperator = "DoesNotExist"
 )
+
+// PodDisruptionBudgetSpec is a description of a PodDisruptionBudget.
+type PodDisruptionBudgetSpec struct {
+	// The minimum number of pods selected by Selector that must remain available
+	// when pods are evicted.  Either an absolute number or a percentage of the
+	// desired number of pods, which is computed from the replication controllers
+	// managing the pods.
+	MinAvailable intstr.IntOrString `json:"minAvailable,omitempty"`
+	// Label query over pods whose evictions are managed by the disruption
+	// budget.
+	Selector *PodSelector `json:"selector,omitempty"`
+}
+
+// PodDisruptionBudgetStatus represents information about the status of a
+// PodDisruptionBudget. Status may trail the actual state of a system.
+type PodDisruptionBudgetStatus struct {
+	// Number of pod disruptions that are currently allowed.
+	PodDisruptionsAllowed int `json:"disruptionsAllowed"`
+	// Current number of healthy pods.
+	CurrentHealthy int `json:"currentHealthy"`
+	// Minimum desired number of healthy pods.
+	DesiredHealthy int `json:"desiredHealthy"`
+	// Total number of pods counted by this disruption budget.
+	ExpectedPods int `json:"expectedPods"`
+	// DisruptedPods contains the names of the pods whose eviction was processed
+	// by the API server but whose deletion has not yet been observed by the
+	// disruption controller, mapped to the time of the eviction. Such pods are
+	// not counted as healthy. An entry is dropped once the pod is seen being
+	// deleted, or after a timeout if the deletion never happens.
+	DisruptedPods map[string]unversioned.Time `json:"disruptedPods,omitempty"`
+}
+
+// PodDisruptionBudget is an object to define the max disruption that can be
+// caused to a collection of pods.
+type PodDisruptionBudget struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	api.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired behavior of the PodDisruptionBudget.
+	Spec PodDisruptionBudgetSpec `json:"spec,omitempty"`
+	// Most recently observed status of the PodDisruptionBudget.
+	Status PodDisruptionBudgetStatus `json:"status,omitempty"`
+}
+
+// PodDisruptionBudgetList is a collection of PodDisruptionBudgets.
+type PodDisruptionBudgetList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of PodDisruptionBudgets.
+	Items []PodDisruptionBudget `json:"items"`
+}
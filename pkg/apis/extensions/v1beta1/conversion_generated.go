@@ -23,6 +23,7 @@ import (
 
 	api "k8s.io/kubernetes/pkg/api"
 	resource "k8s.io/kubernetes/pkg/api/resource"
+	unversioned "k8s.io/kubernetes/pkg/api/unversioned"
 	v1 "k8s.io/kubernetes/pkg/api/v1"
 	extensions "k8s.io/kubernetes/pkg/apis/extensions"
 	conversion "k8s.io/kubernetes/pkg/conversion"
@@ -114,6 +115,45 @@ func convert_api_CinderVolumeSource_To_v1_CinderVolumeSource(in *api.CinderVolum
 	return autoconvert_api_CinderVolumeSource_To_v1_CinderVolumeSource(in, out, s)
 }
 
+func autoconvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in *api.ConfigMapKeySelector, out *v1.ConfigMapKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ConfigMapKeySelector))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in *api.ConfigMapKeySelector, out *v1.ConfigMapKeySelector, s conversion.Scope) error {
+	return autoconvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in, out, s)
+}
+
+func autoconvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in *api.ConfigMapVolumeSource, out *v1.ConfigMapVolumeSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ConfigMapVolumeSource))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]v1.KeyToPath, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_KeyToPath_To_v1_KeyToPath(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in *api.ConfigMapVolumeSource, out *v1.ConfigMapVolumeSource, s conversion.Scope) error {
+	return autoconvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in, out, s)
+}
+
 func autoconvert_api_Container_To_v1_Container(in *api.Container, out *v1.Container, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Container))(in)
@@ -311,6 +351,22 @@ func autoconvert_api_EnvVarSource_To_v1_EnvVarSource(in *api.EnvVarSource, out *
 	} else {
 		out.FieldRef = nil
 	}
+	if in.ConfigMapKeyRef != nil {
+		out.ConfigMapKeyRef = new(v1.ConfigMapKeySelector)
+		if err := convert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in.ConfigMapKeyRef, out.ConfigMapKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapKeyRef = nil
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(v1.SecretKeySelector)
+		if err := convert_api_SecretKeySelector_To_v1_SecretKeySelector(in.SecretKeyRef, out.SecretKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
 	return nil
 }
 
@@ -498,6 +554,19 @@ func convert_api_ISCSIVolumeSource_To_v1_ISCSIVolumeSource(in *api.ISCSIVolumeSo
 	return autoconvert_api_ISCSIVolumeSource_To_v1_ISCSIVolumeSource(in, out, s)
 }
 
+func autoconvert_api_KeyToPath_To_v1_KeyToPath(in *api.KeyToPath, out *v1.KeyToPath, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.KeyToPath))(in)
+	}
+	out.Key = in.Key
+	out.Path = in.Path
+	return nil
+}
+
+func convert_api_KeyToPath_To_v1_KeyToPath(in *api.KeyToPath, out *v1.KeyToPath, s conversion.Scope) error {
+	return autoconvert_api_KeyToPath_To_v1_KeyToPath(in, out, s)
+}
+
 func autoconvert_api_Lifecycle_To_v1_Lifecycle(in *api.Lifecycle, out *v1.Lifecycle, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Lifecycle))(in)
@@ -726,6 +795,23 @@ func autoconvert_api_PodSpec_To_v1_PodSpec(in *api.PodSpec, out *v1.PodSpec, s c
 	} else {
 		out.ImagePullSecrets = nil
 	}
+	if in.Affinity != nil {
+		if err := s.Convert(&in.Affinity, &out.Affinity, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	if in.Tolerations != nil {
+		out.Tolerations = make([]v1.Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := s.Convert(&in.Tolerations[i], &out.Tolerations[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
 	return nil
 }
 
@@ -848,6 +934,21 @@ func convert_api_SELinuxOptions_To_v1_SELinuxOptions(in *api.SELinuxOptions, out
 	return autoconvert_api_SELinuxOptions_To_v1_SELinuxOptions(in, out, s)
 }
 
+func autoconvert_api_SecretKeySelector_To_v1_SecretKeySelector(in *api.SecretKeySelector, out *v1.SecretKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.SecretKeySelector))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_api_SecretKeySelector_To_v1_SecretKeySelector(in *api.SecretKeySelector, out *v1.SecretKeySelector, s conversion.Scope) error {
+	return autoconvert_api_SecretKeySelector_To_v1_SecretKeySelector(in, out, s)
+}
+
 func autoconvert_api_SecretVolumeSource_To_v1_SecretVolumeSource(in *api.SecretVolumeSource, out *v1.SecretVolumeSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.SecretVolumeSource))(in)
@@ -1080,6 +1181,14 @@ func autoconvert_api_VolumeSource_To_v1_VolumeSource(in *api.VolumeSource, out *
 	} else {
 		out.FC = nil
 	}
+	if in.ConfigMap != nil {
+		out.ConfigMap = new(v1.ConfigMapVolumeSource)
+		if err := convert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in.ConfigMap, out.ConfigMap, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMap = nil
+	}
 	return nil
 }
 
@@ -1173,6 +1282,45 @@ func convert_v1_CinderVolumeSource_To_api_CinderVolumeSource(in *v1.CinderVolume
 	return autoconvert_v1_CinderVolumeSource_To_api_CinderVolumeSource(in, out, s)
 }
 
+func autoconvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in *v1.ConfigMapKeySelector, out *api.ConfigMapKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ConfigMapKeySelector))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in *v1.ConfigMapKeySelector, out *api.ConfigMapKeySelector, s conversion.Scope) error {
+	return autoconvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in, out, s)
+}
+
+func autoconvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in *v1.ConfigMapVolumeSource, out *api.ConfigMapVolumeSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ConfigMapVolumeSource))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]api.KeyToPath, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_KeyToPath_To_api_KeyToPath(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in *v1.ConfigMapVolumeSource, out *api.ConfigMapVolumeSource, s conversion.Scope) error {
+	return autoconvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in, out, s)
+}
+
 func autoconvert_v1_Container_To_api_Container(in *v1.Container, out *api.Container, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.Container))(in)
@@ -1370,6 +1518,22 @@ func autoconvert_v1_EnvVarSource_To_api_EnvVarSource(in *v1.EnvVarSource, out *a
 	} else {
 		out.FieldRef = nil
 	}
+	if in.ConfigMapKeyRef != nil {
+		out.ConfigMapKeyRef = new(api.ConfigMapKeySelector)
+		if err := convert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in.ConfigMapKeyRef, out.ConfigMapKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapKeyRef = nil
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(api.SecretKeySelector)
+		if err := convert_v1_SecretKeySelector_To_api_SecretKeySelector(in.SecretKeyRef, out.SecretKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
 	return nil
 }
 
@@ -1557,6 +1721,19 @@ func convert_v1_ISCSIVolumeSource_To_api_ISCSIVolumeSource(in *v1.ISCSIVolumeSou
 	return autoconvert_v1_ISCSIVolumeSource_To_api_ISCSIVolumeSource(in, out, s)
 }
 
+func autoconvert_v1_KeyToPath_To_api_KeyToPath(in *v1.KeyToPath, out *api.KeyToPath, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.KeyToPath))(in)
+	}
+	out.Key = in.Key
+	out.Path = in.Path
+	return nil
+}
+
+func convert_v1_KeyToPath_To_api_KeyToPath(in *v1.KeyToPath, out *api.KeyToPath, s conversion.Scope) error {
+	return autoconvert_v1_KeyToPath_To_api_KeyToPath(in, out, s)
+}
+
 func autoconvert_v1_Lifecycle_To_api_Lifecycle(in *v1.Lifecycle, out *api.Lifecycle, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.Lifecycle))(in)
@@ -1789,6 +1966,23 @@ func autoconvert_v1_PodSpec_To_api_PodSpec(in *v1.PodSpec, out *api.PodSpec, s c
 	} else {
 		out.ImagePullSecrets = nil
 	}
+	if in.Affinity != nil {
+		if err := s.Convert(&in.Affinity, &out.Affinity, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	if in.Tolerations != nil {
+		out.Tolerations = make([]api.Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := s.Convert(&in.Tolerations[i], &out.Tolerations[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
 	return nil
 }
 
@@ -1911,6 +2105,21 @@ func convert_v1_SELinuxOptions_To_api_SELinuxOptions(in *v1.SELinuxOptions, out
 	return autoconvert_v1_SELinuxOptions_To_api_SELinuxOptions(in, out, s)
 }
 
+func autoconvert_v1_SecretKeySelector_To_api_SecretKeySelector(in *v1.SecretKeySelector, out *api.SecretKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.SecretKeySelector))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_v1_SecretKeySelector_To_api_SecretKeySelector(in *v1.SecretKeySelector, out *api.SecretKeySelector, s conversion.Scope) error {
+	return autoconvert_v1_SecretKeySelector_To_api_SecretKeySelector(in, out, s)
+}
+
 func autoconvert_v1_SecretVolumeSource_To_api_SecretVolumeSource(in *v1.SecretVolumeSource, out *api.SecretVolumeSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.SecretVolumeSource))(in)
@@ -2143,6 +2352,14 @@ func autoconvert_v1_VolumeSource_To_api_VolumeSource(in *v1.VolumeSource, out *a
 	} else {
 		out.FC = nil
 	}
+	if in.ConfigMap != nil {
+		out.ConfigMap = new(api.ConfigMapVolumeSource)
+		if err := convert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in.ConfigMap, out.ConfigMap, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMap = nil
+	}
 	return nil
 }
 
@@ -2359,6 +2576,27 @@ func convert_extensions_Deployment_To_v1beta1_Deployment(in *extensions.Deployme
 	return autoconvert_extensions_Deployment_To_v1beta1_Deployment(in, out, s)
 }
 
+func autoconvert_extensions_DeploymentCondition_To_v1beta1_DeploymentCondition(in *extensions.DeploymentCondition, out *DeploymentCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*extensions.DeploymentCondition))(in)
+	}
+	out.Type = DeploymentConditionType(in.Type)
+	out.Status = v1.ConditionStatus(in.Status)
+	if err := s.Convert(&in.LastUpdateTime, &out.LastUpdateTime, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_extensions_DeploymentCondition_To_v1beta1_DeploymentCondition(in *extensions.DeploymentCondition, out *DeploymentCondition, s conversion.Scope) error {
+	return autoconvert_extensions_DeploymentCondition_To_v1beta1_DeploymentCondition(in, out, s)
+}
+
 func autoconvert_extensions_DeploymentList_To_v1beta1_DeploymentList(in *extensions.DeploymentList, out *DeploymentList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*extensions.DeploymentList))(in)
@@ -2386,6 +2624,32 @@ func convert_extensions_DeploymentList_To_v1beta1_DeploymentList(in *extensions.
 	return autoconvert_extensions_DeploymentList_To_v1beta1_DeploymentList(in, out, s)
 }
 
+func autoconvert_extensions_DeploymentRollback_To_v1beta1_DeploymentRollback(in *extensions.DeploymentRollback, out *DeploymentRollback, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*extensions.DeploymentRollback))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	out.Name = in.Name
+	if in.UpdatedAnnotations != nil {
+		out.UpdatedAnnotations = make(map[string]string)
+		for key, val := range in.UpdatedAnnotations {
+			out.UpdatedAnnotations[key] = val
+		}
+	} else {
+		out.UpdatedAnnotations = nil
+	}
+	if err := convert_extensions_RollbackConfig_To_v1beta1_RollbackConfig(&in.RollbackTo, &out.RollbackTo, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_extensions_DeploymentRollback_To_v1beta1_DeploymentRollback(in *extensions.DeploymentRollback, out *DeploymentRollback, s conversion.Scope) error {
+	return autoconvert_extensions_DeploymentRollback_To_v1beta1_DeploymentRollback(in, out, s)
+}
+
 func autoconvert_extensions_DeploymentSpec_To_v1beta1_DeploymentSpec(in *extensions.DeploymentSpec, out *DeploymentSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*extensions.DeploymentSpec))(in)
@@ -2410,6 +2674,21 @@ func autoconvert_extensions_DeploymentSpec_To_v1beta1_DeploymentSpec(in *extensi
 	if err := s.Convert(&in.UniqueLabelKey, &out.UniqueLabelKey, 0); err != nil {
 		return err
 	}
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int32)
+		*out.ProgressDeadlineSeconds = int32(*in.ProgressDeadlineSeconds)
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	if in.RollbackTo != nil {
+		out.RollbackTo = new(RollbackConfig)
+		if err := convert_extensions_RollbackConfig_To_v1beta1_RollbackConfig(in.RollbackTo, out.RollbackTo, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollbackTo = nil
+	}
 	return nil
 }
 
@@ -2417,8 +2696,20 @@ func autoconvert_extensions_DeploymentStatus_To_v1beta1_DeploymentStatus(in *ext
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*extensions.DeploymentStatus))(in)
 	}
+	out.ObservedGeneration = in.ObservedGeneration
 	out.Replicas = int32(in.Replicas)
 	out.UpdatedReplicas = int32(in.UpdatedReplicas)
+	out.AvailableReplicas = int32(in.AvailableReplicas)
+	if in.Conditions != nil {
+		out.Conditions = make([]DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_extensions_DeploymentCondition_To_v1beta1_DeploymentCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -2887,6 +3178,105 @@ func convert_extensions_NodeUtilization_To_v1beta1_NodeUtilization(in *extension
 	return autoconvert_extensions_NodeUtilization_To_v1beta1_NodeUtilization(in, out, s)
 }
 
+func autoconvert_extensions_PodDisruptionBudget_To_v1beta1_PodDisruptionBudget(in *extensions.PodDisruptionBudget, out *PodDisruptionBudget, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*extensions.PodDisruptionBudget))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_extensions_PodDisruptionBudgetSpec_To_v1beta1_PodDisruptionBudgetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_extensions_PodDisruptionBudgetStatus_To_v1beta1_PodDisruptionBudgetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_extensions_PodDisruptionBudget_To_v1beta1_PodDisruptionBudget(in *extensions.PodDisruptionBudget, out *PodDisruptionBudget, s conversion.Scope) error {
+	return autoconvert_extensions_PodDisruptionBudget_To_v1beta1_PodDisruptionBudget(in, out, s)
+}
+
+func autoconvert_extensions_PodDisruptionBudgetList_To_v1beta1_PodDisruptionBudgetList(in *extensions.PodDisruptionBudgetList, out *PodDisruptionBudgetList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*extensions.PodDisruptionBudgetList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]PodDisruptionBudget, len(in.Items))
+		for i := range in.Items {
+			if err := convert_extensions_PodDisruptionBudget_To_v1beta1_PodDisruptionBudget(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_extensions_PodDisruptionBudgetList_To_v1beta1_PodDisruptionBudgetList(in *extensions.PodDisruptionBudgetList, out *PodDisruptionBudgetList, s conversion.Scope) error {
+	return autoconvert_extensions_PodDisruptionBudgetList_To_v1beta1_PodDisruptionBudgetList(in, out, s)
+}
+
+func autoconvert_extensions_PodDisruptionBudgetSpec_To_v1beta1_PodDisruptionBudgetSpec(in *extensions.PodDisruptionBudgetSpec, out *PodDisruptionBudgetSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*extensions.PodDisruptionBudgetSpec))(in)
+	}
+	if err := s.Convert(&in.MinAvailable, &out.MinAvailable, 0); err != nil {
+		return err
+	}
+	if in.Selector != nil {
+		out.Selector = new(PodSelector)
+		if err := convert_extensions_PodSelector_To_v1beta1_PodSelector(in.Selector, out.Selector, s); err != nil {
+			return err
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
+func convert_extensions_PodDisruptionBudgetSpec_To_v1beta1_PodDisruptionBudgetSpec(in *extensions.PodDisruptionBudgetSpec, out *PodDisruptionBudgetSpec, s conversion.Scope) error {
+	return autoconvert_extensions_PodDisruptionBudgetSpec_To_v1beta1_PodDisruptionBudgetSpec(in, out, s)
+}
+
+func autoconvert_extensions_PodDisruptionBudgetStatus_To_v1beta1_PodDisruptionBudgetStatus(in *extensions.PodDisruptionBudgetStatus, out *PodDisruptionBudgetStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*extensions.PodDisruptionBudgetStatus))(in)
+	}
+	out.PodDisruptionsAllowed = in.PodDisruptionsAllowed
+	out.CurrentHealthy = in.CurrentHealthy
+	out.DesiredHealthy = in.DesiredHealthy
+	out.ExpectedPods = in.ExpectedPods
+	if in.DisruptedPods != nil {
+		out.DisruptedPods = make(map[string]unversioned.Time)
+		for key, val := range in.DisruptedPods {
+			newVal := unversioned.Time{}
+			if err := s.Convert(&val, &newVal, 0); err != nil {
+				return err
+			}
+			out.DisruptedPods[key] = newVal
+		}
+	} else {
+		out.DisruptedPods = nil
+	}
+	return nil
+}
+
+func convert_extensions_PodDisruptionBudgetStatus_To_v1beta1_PodDisruptionBudgetStatus(in *extensions.PodDisruptionBudgetStatus, out *PodDisruptionBudgetStatus, s conversion.Scope) error {
+	return autoconvert_extensions_PodDisruptionBudgetStatus_To_v1beta1_PodDisruptionBudgetStatus(in, out, s)
+}
+
 func autoconvert_extensions_PodSelector_To_v1beta1_PodSelector(in *extensions.PodSelector, out *PodSelector, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*extensions.PodSelector))(in)
@@ -2951,6 +3341,18 @@ func convert_extensions_ReplicationControllerDummy_To_v1beta1_ReplicationControl
 	return autoconvert_extensions_ReplicationControllerDummy_To_v1beta1_ReplicationControllerDummy(in, out, s)
 }
 
+func autoconvert_extensions_RollbackConfig_To_v1beta1_RollbackConfig(in *extensions.RollbackConfig, out *RollbackConfig, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*extensions.RollbackConfig))(in)
+	}
+	out.Revision = in.Revision
+	return nil
+}
+
+func convert_extensions_RollbackConfig_To_v1beta1_RollbackConfig(in *extensions.RollbackConfig, out *RollbackConfig, s conversion.Scope) error {
+	return autoconvert_extensions_RollbackConfig_To_v1beta1_RollbackConfig(in, out, s)
+}
+
 func autoconvert_extensions_RollingUpdateDeployment_To_v1beta1_RollingUpdateDeployment(in *extensions.RollingUpdateDeployment, out *RollingUpdateDeployment, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*extensions.RollingUpdateDeployment))(in)
@@ -3346,6 +3748,27 @@ func convert_v1beta1_Deployment_To_extensions_Deployment(in *Deployment, out *ex
 	return autoconvert_v1beta1_Deployment_To_extensions_Deployment(in, out, s)
 }
 
+func autoconvert_v1beta1_DeploymentCondition_To_extensions_DeploymentCondition(in *DeploymentCondition, out *extensions.DeploymentCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*DeploymentCondition))(in)
+	}
+	out.Type = extensions.DeploymentConditionType(in.Type)
+	out.Status = api.ConditionStatus(in.Status)
+	if err := s.Convert(&in.LastUpdateTime, &out.LastUpdateTime, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_v1beta1_DeploymentCondition_To_extensions_DeploymentCondition(in *DeploymentCondition, out *extensions.DeploymentCondition, s conversion.Scope) error {
+	return autoconvert_v1beta1_DeploymentCondition_To_extensions_DeploymentCondition(in, out, s)
+}
+
 func autoconvert_v1beta1_DeploymentList_To_extensions_DeploymentList(in *DeploymentList, out *extensions.DeploymentList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*DeploymentList))(in)
@@ -3373,6 +3796,32 @@ func convert_v1beta1_DeploymentList_To_extensions_DeploymentList(in *DeploymentL
 	return autoconvert_v1beta1_DeploymentList_To_extensions_DeploymentList(in, out, s)
 }
 
+func autoconvert_v1beta1_DeploymentRollback_To_extensions_DeploymentRollback(in *DeploymentRollback, out *extensions.DeploymentRollback, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*DeploymentRollback))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	out.Name = in.Name
+	if in.UpdatedAnnotations != nil {
+		out.UpdatedAnnotations = make(map[string]string)
+		for key, val := range in.UpdatedAnnotations {
+			out.UpdatedAnnotations[key] = val
+		}
+	} else {
+		out.UpdatedAnnotations = nil
+	}
+	if err := convert_v1beta1_RollbackConfig_To_extensions_RollbackConfig(&in.RollbackTo, &out.RollbackTo, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta1_DeploymentRollback_To_extensions_DeploymentRollback(in *DeploymentRollback, out *extensions.DeploymentRollback, s conversion.Scope) error {
+	return autoconvert_v1beta1_DeploymentRollback_To_extensions_DeploymentRollback(in, out, s)
+}
+
 func autoconvert_v1beta1_DeploymentSpec_To_extensions_DeploymentSpec(in *DeploymentSpec, out *extensions.DeploymentSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*DeploymentSpec))(in)
@@ -3393,6 +3842,21 @@ func autoconvert_v1beta1_DeploymentSpec_To_extensions_DeploymentSpec(in *Deploym
 		return err
 	}
 	// in.UniqueLabelKey has no peer in out
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int)
+		*out.ProgressDeadlineSeconds = int(*in.ProgressDeadlineSeconds)
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	if in.RollbackTo != nil {
+		out.RollbackTo = new(extensions.RollbackConfig)
+		if err := convert_v1beta1_RollbackConfig_To_extensions_RollbackConfig(in.RollbackTo, out.RollbackTo, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollbackTo = nil
+	}
 	return nil
 }
 
@@ -3400,8 +3864,20 @@ func autoconvert_v1beta1_DeploymentStatus_To_extensions_DeploymentStatus(in *Dep
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*DeploymentStatus))(in)
 	}
+	out.ObservedGeneration = in.ObservedGeneration
 	out.Replicas = int(in.Replicas)
 	out.UpdatedReplicas = int(in.UpdatedReplicas)
+	out.AvailableReplicas = int(in.AvailableReplicas)
+	if in.Conditions != nil {
+		out.Conditions = make([]extensions.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_v1beta1_DeploymentCondition_To_extensions_DeploymentCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -3854,6 +4330,105 @@ func convert_v1beta1_NodeUtilization_To_extensions_NodeUtilization(in *NodeUtili
 	return autoconvert_v1beta1_NodeUtilization_To_extensions_NodeUtilization(in, out, s)
 }
 
+func autoconvert_v1beta1_PodDisruptionBudget_To_extensions_PodDisruptionBudget(in *PodDisruptionBudget, out *extensions.PodDisruptionBudget, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*PodDisruptionBudget))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta1_PodDisruptionBudgetSpec_To_extensions_PodDisruptionBudgetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta1_PodDisruptionBudgetStatus_To_extensions_PodDisruptionBudgetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta1_PodDisruptionBudget_To_extensions_PodDisruptionBudget(in *PodDisruptionBudget, out *extensions.PodDisruptionBudget, s conversion.Scope) error {
+	return autoconvert_v1beta1_PodDisruptionBudget_To_extensions_PodDisruptionBudget(in, out, s)
+}
+
+func autoconvert_v1beta1_PodDisruptionBudgetList_To_extensions_PodDisruptionBudgetList(in *PodDisruptionBudgetList, out *extensions.PodDisruptionBudgetList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*PodDisruptionBudgetList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]extensions.PodDisruptionBudget, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1beta1_PodDisruptionBudget_To_extensions_PodDisruptionBudget(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1beta1_PodDisruptionBudgetList_To_extensions_PodDisruptionBudgetList(in *PodDisruptionBudgetList, out *extensions.PodDisruptionBudgetList, s conversion.Scope) error {
+	return autoconvert_v1beta1_PodDisruptionBudgetList_To_extensions_PodDisruptionBudgetList(in, out, s)
+}
+
+func autoconvert_v1beta1_PodDisruptionBudgetSpec_To_extensions_PodDisruptionBudgetSpec(in *PodDisruptionBudgetSpec, out *extensions.PodDisruptionBudgetSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*PodDisruptionBudgetSpec))(in)
+	}
+	if err := s.Convert(&in.MinAvailable, &out.MinAvailable, 0); err != nil {
+		return err
+	}
+	if in.Selector != nil {
+		out.Selector = new(extensions.PodSelector)
+		if err := convert_v1beta1_PodSelector_To_extensions_PodSelector(in.Selector, out.Selector, s); err != nil {
+			return err
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
+func convert_v1beta1_PodDisruptionBudgetSpec_To_extensions_PodDisruptionBudgetSpec(in *PodDisruptionBudgetSpec, out *extensions.PodDisruptionBudgetSpec, s conversion.Scope) error {
+	return autoconvert_v1beta1_PodDisruptionBudgetSpec_To_extensions_PodDisruptionBudgetSpec(in, out, s)
+}
+
+func autoconvert_v1beta1_PodDisruptionBudgetStatus_To_extensions_PodDisruptionBudgetStatus(in *PodDisruptionBudgetStatus, out *extensions.PodDisruptionBudgetStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*PodDisruptionBudgetStatus))(in)
+	}
+	out.PodDisruptionsAllowed = in.PodDisruptionsAllowed
+	out.CurrentHealthy = in.CurrentHealthy
+	out.DesiredHealthy = in.DesiredHealthy
+	out.ExpectedPods = in.ExpectedPods
+	if in.DisruptedPods != nil {
+		out.DisruptedPods = make(map[string]unversioned.Time)
+		for key, val := range in.DisruptedPods {
+			newVal := unversioned.Time{}
+			if err := s.Convert(&val, &newVal, 0); err != nil {
+				return err
+			}
+			out.DisruptedPods[key] = newVal
+		}
+	} else {
+		out.DisruptedPods = nil
+	}
+	return nil
+}
+
+func convert_v1beta1_PodDisruptionBudgetStatus_To_extensions_PodDisruptionBudgetStatus(in *PodDisruptionBudgetStatus, out *extensions.PodDisruptionBudgetStatus, s conversion.Scope) error {
+	return autoconvert_v1beta1_PodDisruptionBudgetStatus_To_extensions_PodDisruptionBudgetStatus(in, out, s)
+}
+
 func autoconvert_v1beta1_PodSelector_To_extensions_PodSelector(in *PodSelector, out *extensions.PodSelector, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*PodSelector))(in)
@@ -3918,6 +4493,18 @@ func convert_v1beta1_ReplicationControllerDummy_To_extensions_ReplicationControl
 	return autoconvert_v1beta1_ReplicationControllerDummy_To_extensions_ReplicationControllerDummy(in, out, s)
 }
 
+func autoconvert_v1beta1_RollbackConfig_To_extensions_RollbackConfig(in *RollbackConfig, out *extensions.RollbackConfig, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*RollbackConfig))(in)
+	}
+	out.Revision = in.Revision
+	return nil
+}
+
+func convert_v1beta1_RollbackConfig_To_extensions_RollbackConfig(in *RollbackConfig, out *extensions.RollbackConfig, s conversion.Scope) error {
+	return autoconvert_v1beta1_RollbackConfig_To_extensions_RollbackConfig(in, out, s)
+}
+
 func autoconvert_v1beta1_RollingUpdateDeployment_To_extensions_RollingUpdateDeployment(in *RollingUpdateDeployment, out *extensions.RollingUpdateDeployment, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*RollingUpdateDeployment))(in)
@@ -4106,6 +4693,8 @@ func init() {
 		autoconvert_api_Capabilities_To_v1_Capabilities,
 		autoconvert_api_CephFSVolumeSource_To_v1_CephFSVolumeSource,
 		autoconvert_api_CinderVolumeSource_To_v1_CinderVolumeSource,
+		autoconvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector,
+		autoconvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource,
 		autoconvert_api_ContainerPort_To_v1_ContainerPort,
 		autoconvert_api_Container_To_v1_Container,
 		autoconvert_api_DownwardAPIVolumeFile_To_v1_DownwardAPIVolumeFile,
@@ -4123,6 +4712,7 @@ func init() {
 		autoconvert_api_Handler_To_v1_Handler,
 		autoconvert_api_HostPathVolumeSource_To_v1_HostPathVolumeSource,
 		autoconvert_api_ISCSIVolumeSource_To_v1_ISCSIVolumeSource,
+		autoconvert_api_KeyToPath_To_v1_KeyToPath,
 		autoconvert_api_Lifecycle_To_v1_Lifecycle,
 		autoconvert_api_LoadBalancerIngress_To_v1_LoadBalancerIngress,
 		autoconvert_api_LoadBalancerStatus_To_v1_LoadBalancerStatus,
@@ -4137,6 +4727,7 @@ func init() {
 		autoconvert_api_RBDVolumeSource_To_v1_RBDVolumeSource,
 		autoconvert_api_ResourceRequirements_To_v1_ResourceRequirements,
 		autoconvert_api_SELinuxOptions_To_v1_SELinuxOptions,
+		autoconvert_api_SecretKeySelector_To_v1_SecretKeySelector,
 		autoconvert_api_SecretVolumeSource_To_v1_SecretVolumeSource,
 		autoconvert_api_SecurityContext_To_v1_SecurityContext,
 		autoconvert_api_TCPSocketAction_To_v1_TCPSocketAction,
@@ -4152,7 +4743,9 @@ func init() {
 		autoconvert_extensions_DaemonSetSpec_To_v1beta1_DaemonSetSpec,
 		autoconvert_extensions_DaemonSetStatus_To_v1beta1_DaemonSetStatus,
 		autoconvert_extensions_DaemonSet_To_v1beta1_DaemonSet,
+		autoconvert_extensions_DeploymentCondition_To_v1beta1_DeploymentCondition,
 		autoconvert_extensions_DeploymentList_To_v1beta1_DeploymentList,
+		autoconvert_extensions_DeploymentRollback_To_v1beta1_DeploymentRollback,
 		autoconvert_extensions_DeploymentSpec_To_v1beta1_DeploymentSpec,
 		autoconvert_extensions_DeploymentStatus_To_v1beta1_DeploymentStatus,
 		autoconvert_extensions_DeploymentStrategy_To_v1beta1_DeploymentStrategy,
@@ -4176,9 +4769,14 @@ func init() {
 		autoconvert_extensions_JobStatus_To_v1beta1_JobStatus,
 		autoconvert_extensions_Job_To_v1beta1_Job,
 		autoconvert_extensions_NodeUtilization_To_v1beta1_NodeUtilization,
+		autoconvert_extensions_PodDisruptionBudgetList_To_v1beta1_PodDisruptionBudgetList,
+		autoconvert_extensions_PodDisruptionBudgetSpec_To_v1beta1_PodDisruptionBudgetSpec,
+		autoconvert_extensions_PodDisruptionBudgetStatus_To_v1beta1_PodDisruptionBudgetStatus,
+		autoconvert_extensions_PodDisruptionBudget_To_v1beta1_PodDisruptionBudget,
 		autoconvert_extensions_PodSelectorRequirement_To_v1beta1_PodSelectorRequirement,
 		autoconvert_extensions_PodSelector_To_v1beta1_PodSelector,
 		autoconvert_extensions_ReplicationControllerDummy_To_v1beta1_ReplicationControllerDummy,
+		autoconvert_extensions_RollbackConfig_To_v1beta1_RollbackConfig,
 		autoconvert_extensions_RollingUpdateDeployment_To_v1beta1_RollingUpdateDeployment,
 		autoconvert_extensions_ScaleSpec_To_v1beta1_ScaleSpec,
 		autoconvert_extensions_ScaleStatus_To_v1beta1_ScaleStatus,
@@ -4192,6 +4790,8 @@ func init() {
 		autoconvert_v1_Capabilities_To_api_Capabilities,
 		autoconvert_v1_CephFSVolumeSource_To_api_CephFSVolumeSource,
 		autoconvert_v1_CinderVolumeSource_To_api_CinderVolumeSource,
+		autoconvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector,
+		autoconvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource,
 		autoconvert_v1_ContainerPort_To_api_ContainerPort,
 		autoconvert_v1_Container_To_api_Container,
 		autoconvert_v1_DownwardAPIVolumeFile_To_api_DownwardAPIVolumeFile,
@@ -4209,6 +4809,7 @@ func init() {
 		autoconvert_v1_Handler_To_api_Handler,
 		autoconvert_v1_HostPathVolumeSource_To_api_HostPathVolumeSource,
 		autoconvert_v1_ISCSIVolumeSource_To_api_ISCSIVolumeSource,
+		autoconvert_v1_KeyToPath_To_api_KeyToPath,
 		autoconvert_v1_Lifecycle_To_api_Lifecycle,
 		autoconvert_v1_LoadBalancerIngress_To_api_LoadBalancerIngress,
 		autoconvert_v1_LoadBalancerStatus_To_api_LoadBalancerStatus,
@@ -4223,6 +4824,7 @@ func init() {
 		autoconvert_v1_RBDVolumeSource_To_api_RBDVolumeSource,
 		autoconvert_v1_ResourceRequirements_To_api_ResourceRequirements,
 		autoconvert_v1_SELinuxOptions_To_api_SELinuxOptions,
+		autoconvert_v1_SecretKeySelector_To_api_SecretKeySelector,
 		autoconvert_v1_SecretVolumeSource_To_api_SecretVolumeSource,
 		autoconvert_v1_SecurityContext_To_api_SecurityContext,
 		autoconvert_v1_TCPSocketAction_To_api_TCPSocketAction,
@@ -4238,7 +4840,9 @@ func init() {
 		autoconvert_v1beta1_DaemonSetSpec_To_extensions_DaemonSetSpec,
 		autoconvert_v1beta1_DaemonSetStatus_To_extensions_DaemonSetStatus,
 		autoconvert_v1beta1_DaemonSet_To_extensions_DaemonSet,
+		autoconvert_v1beta1_DeploymentCondition_To_extensions_DeploymentCondition,
 		autoconvert_v1beta1_DeploymentList_To_extensions_DeploymentList,
+		autoconvert_v1beta1_DeploymentRollback_To_extensions_DeploymentRollback,
 		autoconvert_v1beta1_DeploymentSpec_To_extensions_DeploymentSpec,
 		autoconvert_v1beta1_DeploymentStatus_To_extensions_DeploymentStatus,
 		autoconvert_v1beta1_Deployment_To_extensions_Deployment,
@@ -4261,9 +4865,14 @@ func init() {
 		autoconvert_v1beta1_JobStatus_To_extensions_JobStatus,
 		autoconvert_v1beta1_Job_To_extensions_Job,
 		autoconvert_v1beta1_NodeUtilization_To_extensions_NodeUtilization,
+		autoconvert_v1beta1_PodDisruptionBudgetList_To_extensions_PodDisruptionBudgetList,
+		autoconvert_v1beta1_PodDisruptionBudgetSpec_To_extensions_PodDisruptionBudgetSpec,
+		autoconvert_v1beta1_PodDisruptionBudgetStatus_To_extensions_PodDisruptionBudgetStatus,
+		autoconvert_v1beta1_PodDisruptionBudget_To_extensions_PodDisruptionBudget,
 		autoconvert_v1beta1_PodSelectorRequirement_To_extensions_PodSelectorRequirement,
 		autoconvert_v1beta1_PodSelector_To_extensions_PodSelector,
 		autoconvert_v1beta1_ReplicationControllerDummy_To_extensions_ReplicationControllerDummy,
+		autoconvert_v1beta1_RollbackConfig_To_extensions_RollbackConfig,
 		autoconvert_v1beta1_RollingUpdateDeployment_To_extensions_RollingUpdateDeployment,
 		autoconvert_v1beta1_ScaleSpec_To_extensions_ScaleSpec,
 		autoconvert_v1beta1_ScaleStatus_To_extensions_ScaleStatus,
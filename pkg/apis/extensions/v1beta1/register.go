@@ -40,6 +40,7 @@ func addKnownTypes() {
 		&ClusterAutoscalerList{},
 		&Deployment{},
 		&DeploymentList{},
+		&DeploymentRollback{},
 		&HorizontalPodAutoscaler{},
 		&HorizontalPodAutoscalerList{},
 		&Job{},
@@ -54,6 +55,8 @@ func addKnownTypes() {
 		&ThirdPartyResourceDataList{},
 		&Ingress{},
 		&IngressList{},
+		&PodDisruptionBudget{},
+		&PodDisruptionBudgetList{},
 	)
 }
 
@@ -61,6 +64,7 @@ func (*ClusterAutoscaler) IsAnAPIObject()           {}
 func (*ClusterAutoscalerList) IsAnAPIObject()       {}
 func (*Deployment) IsAnAPIObject()                  {}
 func (*DeploymentList) IsAnAPIObject()              {}
+func (*DeploymentRollback) IsAnAPIObject()          {}
 func (*HorizontalPodAutoscaler) IsAnAPIObject()     {}
 func (*HorizontalPodAutoscalerList) IsAnAPIObject() {}
 func (*Job) IsAnAPIObject()                         {}
@@ -75,3 +79,5 @@ func (*ThirdPartyResourceData) IsAnAPIObject()      {}
 func (*ThirdPartyResourceDataList) IsAnAPIObject()  {}
 func (*Ingress) IsAnAPIObject()                     {}
 func (*IngressList) IsAnAPIObject()                 {}
+func (*PodDisruptionBudget) IsAnAPIObject()         {}
+func (*PodDisruptionBudgetList) IsAnAPIObject()     {}
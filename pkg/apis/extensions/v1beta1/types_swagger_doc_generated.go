@@ -127,6 +127,20 @@ func (Deployment) SwaggerDoc() map[string]string {
 	return map_Deployment
 }
 
+var map_DeploymentCondition = map[string]string{
+	"":                   "DeploymentCondition describes the state of a deployment at a certain point.",
+	"type":               "Type of deployment condition.",
+	"status":             "Status of the condition, one of True, False, Unknown.",
+	"lastUpdateTime":     "The last time this condition was updated.",
+	"lastTransitionTime": "Last time the condition transitioned from one status to another.",
+	"reason":             "The reason for the condition's last transition.",
+	"message":            "A human readable message indicating details about the transition.",
+}
+
+func (DeploymentCondition) SwaggerDoc() map[string]string {
+	return map_DeploymentCondition
+}
+
 var map_DeploymentList = map[string]string{
 	"":         "DeploymentList is a list of Deployments.",
 	"metadata": "Standard list metadata.",
@@ -137,6 +151,17 @@ func (DeploymentList) SwaggerDoc() map[string]string {
 	return map_DeploymentList
 }
 
+var map_DeploymentRollback = map[string]string{
+	"":                   "DeploymentRollback stores the information required to rollback a deployment.",
+	"name":               "Required: This must match the Name of a deployment.",
+	"updatedAnnotations": "The annotations to be updated to a deployment",
+	"rollbackTo":         "The config of this deployment rollback.",
+}
+
+func (DeploymentRollback) SwaggerDoc() map[string]string {
+	return map_DeploymentRollback
+}
+
 var map_DeploymentSpec = map[string]string{
 	"":               "DeploymentSpec is the specification of the desired behavior of the Deployment.",
 	"replicas":       "Number of desired pods. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.",
@@ -144,6 +169,9 @@ var map_DeploymentSpec = map[string]string{
 	"template":       "Template describes the pods that will be created.",
 	"strategy":       "The deployment strategy to use to replace existing pods with new ones.",
 	"uniqueLabelKey": "Key of the selector that is added to existing RCs (and label key that is added to its pods) to prevent the existing RCs to select new pods (and old pods being selected by new RC). Users can set this to an empty string to indicate that the system should not add any selector and label. If unspecified, system uses \"deployment.kubernetes.io/podTemplateHash\". Value of this key is hash of DeploymentSpec.PodTemplateSpec. No label is added if this is set to empty string.",
+	"paused":         "Indicates that the deployment is paused. The deployment controller keeps scaling a paused deployment and updating its status, but does not roll out changes to its pod template until it is resumed.",
+	"progressDeadlineSeconds": "The maximum time in seconds for a deployment to make progress before it is considered to be failed. The deployment controller keeps processing a failed deployment and surfaces a Progressing condition with a ProgressDeadlineExceeded reason in the deployment status. Progress is not estimated while the deployment is paused. No deadline is enforced if unset.",
+	"rollbackTo":     "The config this deployment is rolling back to. Will be cleared after rollback is done.",
 }
 
 func (DeploymentSpec) SwaggerDoc() map[string]string {
@@ -152,8 +180,11 @@ func (DeploymentSpec) SwaggerDoc() map[string]string {
 
 var map_DeploymentStatus = map[string]string{
 	"":                "DeploymentStatus is the most recently observed status of the Deployment.",
+	"observedGeneration": "The generation observed by the deployment controller.",
 	"replicas":        "Total number of non-terminated pods targeted by this deployment (their labels match the selector).",
 	"updatedReplicas": "Total number of non-terminated pods targeted by this deployment that have the desired template spec.",
+	"availableReplicas":  "Total number of ready pods targeted by this deployment.",
+	"conditions":         "Represents the latest available observations of a deployment's current state.",
 }
 
 func (DeploymentStatus) SwaggerDoc() map[string]string {
@@ -372,6 +403,50 @@ func (NodeUtilization) SwaggerDoc() map[string]string {
 	return map_NodeUtilization
 }
 
+var map_PodDisruptionBudget = map[string]string{
+	"":         "PodDisruptionBudget is an object to define the max disruption that can be caused to a collection of pods.",
+	"metadata": "Standard object's metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"spec":     "Specification of the desired behavior of the PodDisruptionBudget.",
+	"status":   "Most recently observed status of the PodDisruptionBudget.",
+}
+
+func (PodDisruptionBudget) SwaggerDoc() map[string]string {
+	return map_PodDisruptionBudget
+}
+
+var map_PodDisruptionBudgetList = map[string]string{
+	"":         "PodDisruptionBudgetList is a collection of PodDisruptionBudgets.",
+	"metadata": "Standard list metadata More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"items":    "Items is the list of PodDisruptionBudgets.",
+}
+
+func (PodDisruptionBudgetList) SwaggerDoc() map[string]string {
+	return map_PodDisruptionBudgetList
+}
+
+var map_PodDisruptionBudgetSpec = map[string]string{
+	"":             "PodDisruptionBudgetSpec is a description of a PodDisruptionBudget.",
+	"minAvailable": "The minimum number of pods selected by Selector that must remain available when pods are evicted.  Either an absolute number or a percentage of the desired number of pods, which is computed from the replication controllers managing the pods.",
+	"selector":     "Label query over pods whose evictions are managed by the disruption budget.",
+}
+
+func (PodDisruptionBudgetSpec) SwaggerDoc() map[string]string {
+	return map_PodDisruptionBudgetSpec
+}
+
+var map_PodDisruptionBudgetStatus = map[string]string{
+	"":                   "PodDisruptionBudgetStatus represents information about the status of a PodDisruptionBudget. Status may trail the actual state of a system.",
+	"disruptionsAllowed": "Number of pod disruptions that are currently allowed.",
+	"currentHealthy":     "Current number of healthy pods.",
+	"desiredHealthy":     "Minimum desired number of healthy pods.",
+	"expectedPods":       "Total number of pods counted by this disruption budget.",
+	"disruptedPods":      "DisruptedPods contains the names of the pods whose eviction was processed by the API server but whose deletion has not yet been observed by the disruption controller, mapped to the time of the eviction. Such pods are not counted as healthy. An entry is dropped once the pod is seen being deleted, or after a timeout if the deletion never happens.",
+}
+
+func (PodDisruptionBudgetStatus) SwaggerDoc() map[string]string {
+	return map_PodDisruptionBudgetStatus
+}
+
 var map_PodSelector = map[string]string{
 	"":                 "A pod selector is a label query over a set of pods. The result of matchLabels and matchExpressions are ANDed. An empty pod selector matches all objects. A null pod selector matches no objects.",
 	"matchLabels":      "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
@@ -401,6 +476,15 @@ func (ReplicationControllerDummy) SwaggerDoc() map[string]string {
 	return map_ReplicationControllerDummy
 }
 
+var map_RollbackConfig = map[string]string{
+	"":         "RollbackConfig describes the revision a deployment rolls back to.",
+	"revision": "The revision to rollback to. If set to 0, rollback to the last revision.",
+}
+
+func (RollbackConfig) SwaggerDoc() map[string]string {
+	return map_RollbackConfig
+}
+
 var map_RollingUpdateDeployment = map[string]string{
 	"":                "Spec to control the desired behavior of rolling update.",
 	"maxUnavailable":  "The maximum number of pods that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). Absolute number is calculated from percentage by rounding up. This can not be 0 if MaxSurge is 0. By default, a fixed value of 1 is used. Example: when this is set to 30%, the old RC can be scaled down to 70% of desired pods immediately when the rolling update starts. Once new pods are ready, old RC can be scaled down further, followed by scaling up the new RC, ensuring that the total number of pods available at all times during the update is at least 70% of desired pods.",
@@ -301,6 +301,12 @@ func ValidateDeploymentSpec(spec *extensions.DeploymentSpec) validation.ErrorLis
 	if len(spec.UniqueLabelKey) > 0 {
 		allErrs = append(allErrs, apivalidation.ValidateLabelName(spec.UniqueLabelKey, "uniqueLabel")...)
 	}
+	if spec.ProgressDeadlineSeconds != nil {
+		allErrs = append(allErrs, apivalidation.ValidatePositiveField(int64(*spec.ProgressDeadlineSeconds), "progressDeadlineSeconds")...)
+	}
+	if spec.RollbackTo != nil {
+		allErrs = append(allErrs, ValidateRollback(spec.RollbackTo).Prefix("rollbackTo")...)
+	}
 	return allErrs
 }
 
@@ -318,6 +324,22 @@ func ValidateDeployment(obj *extensions.Deployment) validation.ErrorList {
 	return allErrs
 }
 
+func ValidateDeploymentRollback(obj *extensions.DeploymentRollback) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	if len(obj.Name) == 0 {
+		allErrs = append(allErrs, validation.NewRequiredError("name"))
+	}
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(obj.UpdatedAnnotations, "updatedAnnotations")...)
+	allErrs = append(allErrs, ValidateRollback(&obj.RollbackTo).Prefix("rollbackTo")...)
+	return allErrs
+}
+
+func ValidateRollback(rollback *extensions.RollbackConfig) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidatePositiveField(rollback.Revision, "revision")...)
+	return allErrs
+}
+
 func ValidateThirdPartyResourceDataUpdate(update, old *extensions.ThirdPartyResourceData) validation.ErrorList {
 	return ValidateThirdPartyResourceData(update)
 }
@@ -608,3 +630,54 @@ func ValidateScale(scale *extensions.Scale) validation.ErrorList {
 
 	return allErrs
 }
+
+// ValidatePodDisruptionBudget tests if required fields in the PodDisruptionBudget are set.
+func ValidatePodDisruptionBudget(pdb *extensions.PodDisruptionBudget) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&pdb.ObjectMeta, true, ValidatePodDisruptionBudgetName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidatePodDisruptionBudgetSpec(&pdb.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidatePodDisruptionBudgetName validates that the given name can be used as a PodDisruptionBudget name.
+func ValidatePodDisruptionBudgetName(name string, prefix bool) (bool, string) {
+	return apivalidation.NameIsDNSSubdomain(name, prefix)
+}
+
+func ValidatePodDisruptionBudgetSpec(spec *extensions.PodDisruptionBudgetSpec) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, ValidatePositiveIntOrPercent(spec.MinAvailable, "minAvailable")...)
+	allErrs = append(allErrs, IsNotMoreThan100Percent(spec.MinAvailable, "minAvailable")...)
+	if spec.Selector == nil {
+		allErrs = append(allErrs, validation.NewRequiredError("selector"))
+	} else {
+		allErrs = append(allErrs, ValidatePodSelector(spec.Selector).Prefix("selector")...)
+	}
+	return allErrs
+}
+
+// ValidatePodDisruptionBudgetUpdate tests that the spec of a PodDisruptionBudget is not changed.
+func ValidatePodDisruptionBudgetUpdate(pdb, oldPdb *extensions.PodDisruptionBudget) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&pdb.ObjectMeta, &oldPdb.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidatePodDisruptionBudgetSpec(&pdb.Spec).Prefix("spec")...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(pdb.Spec, oldPdb.Spec, "spec")...)
+	return allErrs
+}
+
+func ValidatePodDisruptionBudgetStatus(status *extensions.PodDisruptionBudgetStatus) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidatePositiveField(int64(status.PodDisruptionsAllowed), "disruptionsAllowed")...)
+	allErrs = append(allErrs, apivalidation.ValidatePositiveField(int64(status.CurrentHealthy), "currentHealthy")...)
+	allErrs = append(allErrs, apivalidation.ValidatePositiveField(int64(status.DesiredHealthy), "desiredHealthy")...)
+	allErrs = append(allErrs, apivalidation.ValidatePositiveField(int64(status.ExpectedPods), "expectedPods")...)
+	return allErrs
+}
+
+// ValidatePodDisruptionBudgetStatusUpdate tests if the status of a PodDisruptionBudget is valid.
+func ValidatePodDisruptionBudgetStatusUpdate(pdb, oldPdb *extensions.PodDisruptionBudget) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&pdb.ObjectMeta, &oldPdb.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidatePodDisruptionBudgetStatus(&pdb.Status).Prefix("status")...)
+	return allErrs
+}
@@ -797,6 +797,19 @@ func TestValidateDeployment(t *testing.T) {
 	}
 	errorCases["should not be more than 100%"] = invalidMaxUnavailableDeployment
 
+	// Rollback.Revision must be non-negative
+	invalidRollbackRevisionDeployment := validDeployment()
+	invalidRollbackRevisionDeployment.Spec.RollbackTo = &extensions.RollbackConfig{
+		Revision: -3,
+	}
+	errorCases["must be non-negative"] = invalidRollbackRevisionDeployment
+
+	// ProgressDeadlineSeconds must be non-negative
+	invalidProgressDeadlineDeployment := validDeployment()
+	progressDeadlineSeconds := -1
+	invalidProgressDeadlineDeployment.Spec.ProgressDeadlineSeconds = &progressDeadlineSeconds
+	errorCases["spec.progressDeadlineSeconds: invalid value"] = invalidProgressDeadlineDeployment
+
 	for k, v := range errorCases {
 		errs := ValidateDeployment(v)
 		if len(errs) == 0 {
@@ -807,6 +820,50 @@ func TestValidateDeployment(t *testing.T) {
 	}
 }
 
+func validDeploymentRollback() *extensions.DeploymentRollback {
+	return &extensions.DeploymentRollback{
+		Name: "abc",
+		UpdatedAnnotations: map[string]string{
+			"created-by": "abc",
+		},
+		RollbackTo: extensions.RollbackConfig{
+			Revision: 1,
+		},
+	}
+}
+
+func TestValidateDeploymentRollback(t *testing.T) {
+	noAnnotation := validDeploymentRollback()
+	noAnnotation.UpdatedAnnotations = nil
+	successCases := []*extensions.DeploymentRollback{
+		validDeploymentRollback(),
+		noAnnotation,
+	}
+	for _, successCase := range successCases {
+		if errs := ValidateDeploymentRollback(successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	errorCases := map[string]*extensions.DeploymentRollback{}
+	invalidNoName := validDeploymentRollback()
+	invalidNoName.Name = ""
+	errorCases["name: required value"] = invalidNoName
+
+	invalidRollbackRevision := validDeploymentRollback()
+	invalidRollbackRevision.RollbackTo.Revision = -1
+	errorCases["rollbackTo.revision: invalid value"] = invalidRollbackRevision
+
+	for k, v := range errorCases {
+		errs := ValidateDeploymentRollback(v)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+		} else if !strings.Contains(errs[0].Error(), k) {
+			t.Errorf("unexpected error: %v, expected: %s", errs[0], k)
+		}
+	}
+}
+
 func TestValidateJob(t *testing.T) {
 	validSelector := &extensions.PodSelector{
 		MatchLabels: map[string]string{"a": "b"},
@@ -1301,3 +1358,101 @@ func newInt(val int) *int {
 	*p = val
 	return p
 }
+
+func TestValidatePodDisruptionBudget(t *testing.T) {
+	validMeta := api.ObjectMeta{Name: "quorum", Namespace: api.NamespaceDefault}
+	validSelector := &extensions.PodSelector{MatchLabels: map[string]string{"app": "zk"}}
+
+	successCases := []extensions.PodDisruptionBudget{
+		{
+			ObjectMeta: validMeta,
+			Spec: extensions.PodDisruptionBudgetSpec{
+				MinAvailable: intstr.FromInt(2),
+				Selector:     validSelector,
+			},
+		},
+		{
+			ObjectMeta: validMeta,
+			Spec: extensions.PodDisruptionBudgetSpec{
+				MinAvailable: intstr.FromString("50%"),
+				Selector:     validSelector,
+			},
+		},
+	}
+	for _, successCase := range successCases {
+		if errs := ValidatePodDisruptionBudget(&successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	errorCases := map[string]extensions.PodDisruptionBudget{
+		"spec.minAvailable": {
+			ObjectMeta: validMeta,
+			Spec: extensions.PodDisruptionBudgetSpec{
+				MinAvailable: intstr.FromInt(-1),
+				Selector:     validSelector,
+			},
+		},
+		"spec.minAvailable: invalid value": {
+			ObjectMeta: validMeta,
+			Spec: extensions.PodDisruptionBudgetSpec{
+				MinAvailable: intstr.FromString("150%"),
+				Selector:     validSelector,
+			},
+		},
+		"spec.selector: required value": {
+			ObjectMeta: validMeta,
+			Spec: extensions.PodDisruptionBudgetSpec{
+				MinAvailable: intstr.FromInt(1),
+			},
+		},
+		"metadata.name: required value": {
+			ObjectMeta: api.ObjectMeta{Namespace: api.NamespaceDefault},
+			Spec: extensions.PodDisruptionBudgetSpec{
+				MinAvailable: intstr.FromInt(1),
+				Selector:     validSelector,
+			},
+		},
+	}
+	for k, v := range errorCases {
+		errs := ValidatePodDisruptionBudget(&v)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+		} else if !strings.HasPrefix(errs[0].Error(), k) {
+			t.Errorf("unexpected error: %v, expected: %s", errs[0], k)
+		}
+	}
+}
+
+func TestValidatePodDisruptionBudgetUpdate(t *testing.T) {
+	old := extensions.PodDisruptionBudget{
+		ObjectMeta: api.ObjectMeta{Name: "quorum", Namespace: api.NamespaceDefault, ResourceVersion: "1"},
+		Spec: extensions.PodDisruptionBudgetSpec{
+			MinAvailable: intstr.FromInt(2),
+			Selector:     &extensions.PodSelector{MatchLabels: map[string]string{"app": "zk"}},
+		},
+	}
+
+	statusUpdate := old
+	statusUpdate.Status = extensions.PodDisruptionBudgetStatus{
+		PodDisruptionsAllowed: 1,
+		CurrentHealthy:        3,
+		DesiredHealthy:        2,
+		ExpectedPods:          3,
+	}
+	if errs := ValidatePodDisruptionBudgetStatusUpdate(&statusUpdate, &old); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	invalidStatus := old
+	invalidStatus.Status.PodDisruptionsAllowed = -1
+	if errs := ValidatePodDisruptionBudgetStatusUpdate(&invalidStatus, &old); len(errs) == 0 {
+		t.Errorf("expected failure for negative disruptionsAllowed")
+	}
+
+	specUpdate := old
+	specUpdate.Spec.MinAvailable = intstr.FromInt(3)
+	if errs := ValidatePodDisruptionBudgetUpdate(&specUpdate, &old); len(errs) == 0 {
+		t.Errorf("expected failure for spec update")
+	}
+}
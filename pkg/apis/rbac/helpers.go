@@ -0,0 +1,85 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"strings"
+)
+
+// VerbMatches returns true if the rule allows the given verb.
+func VerbMatches(rule PolicyRule, requestedVerb string) bool {
+	for _, verb := range rule.Verbs {
+		if verb == VerbAll || verb == requestedVerb {
+			return true
+		}
+	}
+	return false
+}
+
+// APIGroupMatches returns true if the rule applies to the given API group.
+func APIGroupMatches(rule PolicyRule, requestedGroup string) bool {
+	for _, group := range rule.APIGroups {
+		if group == APIGroupAll || group == requestedGroup {
+			return true
+		}
+	}
+	return false
+}
+
+// ResourceMatches returns true if the rule applies to the given resource.  Subresources
+// are matched as "resource/subresource", so a rule for "pods" does not grant "pods/log".
+func ResourceMatches(rule PolicyRule, requestedResource string) bool {
+	for _, resource := range rule.Resources {
+		if resource == ResourceAll || resource == requestedResource {
+			return true
+		}
+	}
+	return false
+}
+
+// ResourceNameMatches returns true if the rule applies to the named object.  A rule without
+// resource names applies to every object; a rule with resource names never applies to
+// requests that do not name an object, such as list or create.
+func ResourceNameMatches(rule PolicyRule, requestedName string) bool {
+	if len(rule.ResourceNames) == 0 {
+		return true
+	}
+	for _, name := range rule.ResourceNames {
+		if name == requestedName {
+			return true
+		}
+	}
+	return false
+}
+
+// NonResourceURLMatches returns true if the rule allows the given non-resource path.  A
+// trailing "*" in a rule URL matches any path with that prefix.
+func NonResourceURLMatches(rule PolicyRule, requestedURL string) bool {
+	for _, url := range rule.NonResourceURLs {
+		if nonResourceURLCovers(url, requestedURL) {
+			return true
+		}
+	}
+	return false
+}
+
+func nonResourceURLCovers(ownerURL, requestedURL string) bool {
+	if ownerURL == NonResourceAll || ownerURL == requestedURL {
+		return true
+	}
+	return strings.HasSuffix(ownerURL, "*") && strings.HasPrefix(requestedURL, strings.TrimRight(ownerURL, "*"))
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+func init() {
+	// Register the API.
+	addKnownTypes()
+}
+
+// SchemeGroupVersion is group version used to register these objects
+var SchemeGroupVersion = unversioned.GroupVersion{Group: "rbac", Version: ""}
+
+// Adds the list of known types to api.Scheme.
+func addKnownTypes() {
+	api.Scheme.AddKnownTypes(SchemeGroupVersion,
+		&Role{},
+		&RoleBinding{},
+		&RoleBindingList{},
+		&RoleList{},
+
+		&ClusterRole{},
+		&ClusterRoleBinding{},
+		&ClusterRoleBindingList{},
+		&ClusterRoleList{},
+	)
+}
+
+func (*Role) IsAnAPIObject()                   {}
+func (*RoleBinding) IsAnAPIObject()            {}
+func (*RoleBindingList) IsAnAPIObject()        {}
+func (*RoleList) IsAnAPIObject()               {}
+func (*ClusterRole) IsAnAPIObject()            {}
+func (*ClusterRoleBinding) IsAnAPIObject()     {}
+func (*ClusterRoleBindingList) IsAnAPIObject() {}
+func (*ClusterRoleList) IsAnAPIObject()        {}
@@ -0,0 +1,4402 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// ************************************************************
+// DO NOT EDIT.
+// THIS FILE IS AUTO-GENERATED BY codecgen.
+// ************************************************************
+
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	codec1978 "github.com/ugorji/go/codec"
+	pkg2_api "k8s.io/kubernetes/pkg/api"
+	pkg1_unversioned "k8s.io/kubernetes/pkg/api/unversioned"
+	pkg3_types "k8s.io/kubernetes/pkg/types"
+	"reflect"
+	"runtime"
+	time "time"
+)
+
+const (
+	// ----- content types ----
+	codecSelferC_UTF81234 = 1
+	codecSelferC_RAW1234  = 0
+	// ----- value types used ----
+	codecSelferValueTypeArray1234 = 10
+	codecSelferValueTypeMap1234   = 9
+	// ----- containerStateValues ----
+	codecSelfer_containerMapKey1234    = 2
+	codecSelfer_containerMapValue1234  = 3
+	codecSelfer_containerMapEnd1234    = 4
+	codecSelfer_containerArrayElem1234 = 6
+	codecSelfer_containerArrayEnd1234  = 7
+)
+
+var (
+	codecSelferBitsize1234                         = uint8(reflect.TypeOf(uint(0)).Bits())
+	codecSelferOnlyMapOrArrayEncodeToStructErr1234 = errors.New(`only encoded map or array can be decoded into a struct`)
+)
+
+type codecSelfer1234 struct{}
+
+func init() {
+	if codec1978.GenVersion != 5 {
+		_, file, _, _ := runtime.Caller(0)
+		err := fmt.Errorf("codecgen version mismatch: current: %v, need %v. Re-generate file: %v",
+			5, codec1978.GenVersion, file)
+		panic(err)
+	}
+	if false { // reference the types, but skip this branch at build/run time
+		var v0 pkg2_api.ObjectMeta
+		var v1 pkg1_unversioned.TypeMeta
+		var v2 pkg3_types.UID
+		var v3 time.Time
+		_, _, _, _ = v0, v1, v2, v3
+	}
+}
+
+func (x *PolicyRule) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yym1 := z.EncBinary()
+		_ = yym1
+		if false {
+		} else if z.HasExtensions() && z.EncExt(x) {
+		} else {
+			yysep2 := !z.EncBinary()
+			yy2arr2 := z.EncBasicHandle().StructToArray
+			var yyq2 [5]bool
+			_, _, _ = yysep2, yyq2, yy2arr2
+			const yyr2 bool = false
+			yyq2[1] = len(x.APIGroups) != 0
+			yyq2[2] = len(x.Resources) != 0
+			yyq2[3] = len(x.ResourceNames) != 0
+			yyq2[4] = len(x.NonResourceURLs) != 0
+			var yynn2 int
+			if yyr2 || yy2arr2 {
+				r.EncodeArrayStart(5)
+			} else {
+				yynn2 = 1
+				for _, b := range yyq2 {
+					if b {
+						yynn2++
+					}
+				}
+				r.EncodeMapStart(yynn2)
+				yynn2 = 0
+			}
+			if yyr2 || yy2arr2 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if x.Verbs == nil {
+					r.EncodeNil()
+				} else {
+					yym4 := z.EncBinary()
+					_ = yym4
+					if false {
+					} else {
+						z.F.EncSliceStringV(x.Verbs, false, e)
+					}
+				}
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("verbs"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				if x.Verbs == nil {
+					r.EncodeNil()
+				} else {
+					yym5 := z.EncBinary()
+					_ = yym5
+					if false {
+					} else {
+						z.F.EncSliceStringV(x.Verbs, false, e)
+					}
+				}
+			}
+			if yyr2 || yy2arr2 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq2[1] {
+					if x.APIGroups == nil {
+						r.EncodeNil()
+					} else {
+						yym7 := z.EncBinary()
+						_ = yym7
+						if false {
+						} else {
+							z.F.EncSliceStringV(x.APIGroups, false, e)
+						}
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq2[1] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("apiGroups"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					if x.APIGroups == nil {
+						r.EncodeNil()
+					} else {
+						yym8 := z.EncBinary()
+						_ = yym8
+						if false {
+						} else {
+							z.F.EncSliceStringV(x.APIGroups, false, e)
+						}
+					}
+				}
+			}
+			if yyr2 || yy2arr2 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq2[2] {
+					if x.Resources == nil {
+						r.EncodeNil()
+					} else {
+						yym10 := z.EncBinary()
+						_ = yym10
+						if false {
+						} else {
+							z.F.EncSliceStringV(x.Resources, false, e)
+						}
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq2[2] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("resources"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					if x.Resources == nil {
+						r.EncodeNil()
+					} else {
+						yym11 := z.EncBinary()
+						_ = yym11
+						if false {
+						} else {
+							z.F.EncSliceStringV(x.Resources, false, e)
+						}
+					}
+				}
+			}
+			if yyr2 || yy2arr2 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq2[3] {
+					if x.ResourceNames == nil {
+						r.EncodeNil()
+					} else {
+						yym13 := z.EncBinary()
+						_ = yym13
+						if false {
+						} else {
+							z.F.EncSliceStringV(x.ResourceNames, false, e)
+						}
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq2[3] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("resourceNames"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					if x.ResourceNames == nil {
+						r.EncodeNil()
+					} else {
+						yym14 := z.EncBinary()
+						_ = yym14
+						if false {
+						} else {
+							z.F.EncSliceStringV(x.ResourceNames, false, e)
+						}
+					}
+				}
+			}
+			if yyr2 || yy2arr2 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq2[4] {
+					if x.NonResourceURLs == nil {
+						r.EncodeNil()
+					} else {
+						yym16 := z.EncBinary()
+						_ = yym16
+						if false {
+						} else {
+							z.F.EncSliceStringV(x.NonResourceURLs, false, e)
+						}
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq2[4] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("nonResourceURLs"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					if x.NonResourceURLs == nil {
+						r.EncodeNil()
+					} else {
+						yym17 := z.EncBinary()
+						_ = yym17
+						if false {
+						} else {
+							z.F.EncSliceStringV(x.NonResourceURLs, false, e)
+						}
+					}
+				}
+			}
+			if yyr2 || yy2arr2 {
+				z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapEnd1234)
+			}
+		}
+	}
+}
+
+func (x *PolicyRule) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym18 := z.DecBinary()
+	_ = yym18
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		yyct19 := r.ContainerType()
+		if yyct19 == codecSelferValueTypeMap1234 {
+			yyl19 := r.ReadMapStart()
+			if yyl19 == 0 {
+				z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+			} else {
+				x.codecDecodeSelfFromMap(yyl19, d)
+			}
+		} else if yyct19 == codecSelferValueTypeArray1234 {
+			yyl19 := r.ReadArrayStart()
+			if yyl19 == 0 {
+				z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				x.codecDecodeSelfFromArray(yyl19, d)
+			}
+		} else {
+			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
+		}
+	}
+}
+
+func (x *PolicyRule) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yys20Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys20Slc
+	var yyhl20 bool = l >= 0
+	for yyj20 := 0; ; yyj20++ {
+		if yyhl20 {
+			if yyj20 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		z.DecSendContainerState(codecSelfer_containerMapKey1234)
+		yys20Slc = r.DecodeBytes(yys20Slc, true, true)
+		yys20 := string(yys20Slc)
+		z.DecSendContainerState(codecSelfer_containerMapValue1234)
+		switch yys20 {
+		case "verbs":
+			if r.TryDecodeAsNil() {
+				x.Verbs = nil
+			} else {
+				yyv21 := &x.Verbs
+				yym22 := z.DecBinary()
+				_ = yym22
+				if false {
+				} else {
+					z.F.DecSliceStringX(yyv21, false, d)
+				}
+			}
+		case "apiGroups":
+			if r.TryDecodeAsNil() {
+				x.APIGroups = nil
+			} else {
+				yyv23 := &x.APIGroups
+				yym24 := z.DecBinary()
+				_ = yym24
+				if false {
+				} else {
+					z.F.DecSliceStringX(yyv23, false, d)
+				}
+			}
+		case "resources":
+			if r.TryDecodeAsNil() {
+				x.Resources = nil
+			} else {
+				yyv25 := &x.Resources
+				yym26 := z.DecBinary()
+				_ = yym26
+				if false {
+				} else {
+					z.F.DecSliceStringX(yyv25, false, d)
+				}
+			}
+		case "resourceNames":
+			if r.TryDecodeAsNil() {
+				x.ResourceNames = nil
+			} else {
+				yyv27 := &x.ResourceNames
+				yym28 := z.DecBinary()
+				_ = yym28
+				if false {
+				} else {
+					z.F.DecSliceStringX(yyv27, false, d)
+				}
+			}
+		case "nonResourceURLs":
+			if r.TryDecodeAsNil() {
+				x.NonResourceURLs = nil
+			} else {
+				yyv29 := &x.NonResourceURLs
+				yym30 := z.DecBinary()
+				_ = yym30
+				if false {
+				} else {
+					z.F.DecSliceStringX(yyv29, false, d)
+				}
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys20)
+		} // end switch yys20
+	} // end for yyj20
+	z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+}
+
+func (x *PolicyRule) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj31 int
+	var yyb31 bool
+	var yyhl31 bool = l >= 0
+	yyj31++
+	if yyhl31 {
+		yyb31 = yyj31 > l
+	} else {
+		yyb31 = r.CheckBreak()
+	}
+	if yyb31 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Verbs = nil
+	} else {
+		yyv32 := &x.Verbs
+		yym33 := z.DecBinary()
+		_ = yym33
+		if false {
+		} else {
+			z.F.DecSliceStringX(yyv32, false, d)
+		}
+	}
+	yyj31++
+	if yyhl31 {
+		yyb31 = yyj31 > l
+	} else {
+		yyb31 = r.CheckBreak()
+	}
+	if yyb31 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.APIGroups = nil
+	} else {
+		yyv34 := &x.APIGroups
+		yym35 := z.DecBinary()
+		_ = yym35
+		if false {
+		} else {
+			z.F.DecSliceStringX(yyv34, false, d)
+		}
+	}
+	yyj31++
+	if yyhl31 {
+		yyb31 = yyj31 > l
+	} else {
+		yyb31 = r.CheckBreak()
+	}
+	if yyb31 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Resources = nil
+	} else {
+		yyv36 := &x.Resources
+		yym37 := z.DecBinary()
+		_ = yym37
+		if false {
+		} else {
+			z.F.DecSliceStringX(yyv36, false, d)
+		}
+	}
+	yyj31++
+	if yyhl31 {
+		yyb31 = yyj31 > l
+	} else {
+		yyb31 = r.CheckBreak()
+	}
+	if yyb31 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.ResourceNames = nil
+	} else {
+		yyv38 := &x.ResourceNames
+		yym39 := z.DecBinary()
+		_ = yym39
+		if false {
+		} else {
+			z.F.DecSliceStringX(yyv38, false, d)
+		}
+	}
+	yyj31++
+	if yyhl31 {
+		yyb31 = yyj31 > l
+	} else {
+		yyb31 = r.CheckBreak()
+	}
+	if yyb31 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.NonResourceURLs = nil
+	} else {
+		yyv40 := &x.NonResourceURLs
+		yym41 := z.DecBinary()
+		_ = yym41
+		if false {
+		} else {
+			z.F.DecSliceStringX(yyv40, false, d)
+		}
+	}
+	for {
+		yyj31++
+		if yyhl31 {
+			yyb31 = yyj31 > l
+		} else {
+			yyb31 = r.CheckBreak()
+		}
+		if yyb31 {
+			break
+		}
+		z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+		z.DecStructFieldNotFound(yyj31-1, "")
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x *Subject) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yym42 := z.EncBinary()
+		_ = yym42
+		if false {
+		} else if z.HasExtensions() && z.EncExt(x) {
+		} else {
+			yysep43 := !z.EncBinary()
+			yy2arr43 := z.EncBasicHandle().StructToArray
+			var yyq43 [3]bool
+			_, _, _ = yysep43, yyq43, yy2arr43
+			const yyr43 bool = false
+			yyq43[2] = x.Namespace != ""
+			var yynn43 int
+			if yyr43 || yy2arr43 {
+				r.EncodeArrayStart(3)
+			} else {
+				yynn43 = 2
+				for _, b := range yyq43 {
+					if b {
+						yynn43++
+					}
+				}
+				r.EncodeMapStart(yynn43)
+				yynn43 = 0
+			}
+			if yyr43 || yy2arr43 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				yym45 := z.EncBinary()
+				_ = yym45
+				if false {
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+				}
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("kind"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				yym46 := z.EncBinary()
+				_ = yym46
+				if false {
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+				}
+			}
+			if yyr43 || yy2arr43 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				yym48 := z.EncBinary()
+				_ = yym48
+				if false {
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, string(x.Name))
+				}
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("name"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				yym49 := z.EncBinary()
+				_ = yym49
+				if false {
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, string(x.Name))
+				}
+			}
+			if yyr43 || yy2arr43 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq43[2] {
+					yym51 := z.EncBinary()
+					_ = yym51
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Namespace))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq43[2] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("namespace"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym52 := z.EncBinary()
+					_ = yym52
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Namespace))
+					}
+				}
+			}
+			if yyr43 || yy2arr43 {
+				z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapEnd1234)
+			}
+		}
+	}
+}
+
+func (x *Subject) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym53 := z.DecBinary()
+	_ = yym53
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		yyct54 := r.ContainerType()
+		if yyct54 == codecSelferValueTypeMap1234 {
+			yyl54 := r.ReadMapStart()
+			if yyl54 == 0 {
+				z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+			} else {
+				x.codecDecodeSelfFromMap(yyl54, d)
+			}
+		} else if yyct54 == codecSelferValueTypeArray1234 {
+			yyl54 := r.ReadArrayStart()
+			if yyl54 == 0 {
+				z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				x.codecDecodeSelfFromArray(yyl54, d)
+			}
+		} else {
+			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
+		}
+	}
+}
+
+func (x *Subject) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yys55Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys55Slc
+	var yyhl55 bool = l >= 0
+	for yyj55 := 0; ; yyj55++ {
+		if yyhl55 {
+			if yyj55 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		z.DecSendContainerState(codecSelfer_containerMapKey1234)
+		yys55Slc = r.DecodeBytes(yys55Slc, true, true)
+		yys55 := string(yys55Slc)
+		z.DecSendContainerState(codecSelfer_containerMapValue1234)
+		switch yys55 {
+		case "kind":
+			if r.TryDecodeAsNil() {
+				x.Kind = ""
+			} else {
+				x.Kind = string(r.DecodeString())
+			}
+		case "name":
+			if r.TryDecodeAsNil() {
+				x.Name = ""
+			} else {
+				x.Name = string(r.DecodeString())
+			}
+		case "namespace":
+			if r.TryDecodeAsNil() {
+				x.Namespace = ""
+			} else {
+				x.Namespace = string(r.DecodeString())
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys55)
+		} // end switch yys55
+	} // end for yyj55
+	z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+}
+
+func (x *Subject) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj59 int
+	var yyb59 bool
+	var yyhl59 bool = l >= 0
+	yyj59++
+	if yyhl59 {
+		yyb59 = yyj59 > l
+	} else {
+		yyb59 = r.CheckBreak()
+	}
+	if yyb59 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Kind = ""
+	} else {
+		x.Kind = string(r.DecodeString())
+	}
+	yyj59++
+	if yyhl59 {
+		yyb59 = yyj59 > l
+	} else {
+		yyb59 = r.CheckBreak()
+	}
+	if yyb59 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Name = ""
+	} else {
+		x.Name = string(r.DecodeString())
+	}
+	yyj59++
+	if yyhl59 {
+		yyb59 = yyj59 > l
+	} else {
+		yyb59 = r.CheckBreak()
+	}
+	if yyb59 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Namespace = ""
+	} else {
+		x.Namespace = string(r.DecodeString())
+	}
+	for {
+		yyj59++
+		if yyhl59 {
+			yyb59 = yyj59 > l
+		} else {
+			yyb59 = r.CheckBreak()
+		}
+		if yyb59 {
+			break
+		}
+		z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+		z.DecStructFieldNotFound(yyj59-1, "")
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x *RoleRef) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yym63 := z.EncBinary()
+		_ = yym63
+		if false {
+		} else if z.HasExtensions() && z.EncExt(x) {
+		} else {
+			yysep64 := !z.EncBinary()
+			yy2arr64 := z.EncBasicHandle().StructToArray
+			var yyq64 [2]bool
+			_, _, _ = yysep64, yyq64, yy2arr64
+			const yyr64 bool = false
+			var yynn64 int
+			if yyr64 || yy2arr64 {
+				r.EncodeArrayStart(2)
+			} else {
+				yynn64 = 2
+				for _, b := range yyq64 {
+					if b {
+						yynn64++
+					}
+				}
+				r.EncodeMapStart(yynn64)
+				yynn64 = 0
+			}
+			if yyr64 || yy2arr64 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				yym66 := z.EncBinary()
+				_ = yym66
+				if false {
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+				}
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("kind"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				yym67 := z.EncBinary()
+				_ = yym67
+				if false {
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+				}
+			}
+			if yyr64 || yy2arr64 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				yym69 := z.EncBinary()
+				_ = yym69
+				if false {
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, string(x.Name))
+				}
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("name"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				yym70 := z.EncBinary()
+				_ = yym70
+				if false {
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, string(x.Name))
+				}
+			}
+			if yyr64 || yy2arr64 {
+				z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapEnd1234)
+			}
+		}
+	}
+}
+
+func (x *RoleRef) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym71 := z.DecBinary()
+	_ = yym71
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		yyct72 := r.ContainerType()
+		if yyct72 == codecSelferValueTypeMap1234 {
+			yyl72 := r.ReadMapStart()
+			if yyl72 == 0 {
+				z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+			} else {
+				x.codecDecodeSelfFromMap(yyl72, d)
+			}
+		} else if yyct72 == codecSelferValueTypeArray1234 {
+			yyl72 := r.ReadArrayStart()
+			if yyl72 == 0 {
+				z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				x.codecDecodeSelfFromArray(yyl72, d)
+			}
+		} else {
+			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
+		}
+	}
+}
+
+func (x *RoleRef) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yys73Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys73Slc
+	var yyhl73 bool = l >= 0
+	for yyj73 := 0; ; yyj73++ {
+		if yyhl73 {
+			if yyj73 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		z.DecSendContainerState(codecSelfer_containerMapKey1234)
+		yys73Slc = r.DecodeBytes(yys73Slc, true, true)
+		yys73 := string(yys73Slc)
+		z.DecSendContainerState(codecSelfer_containerMapValue1234)
+		switch yys73 {
+		case "kind":
+			if r.TryDecodeAsNil() {
+				x.Kind = ""
+			} else {
+				x.Kind = string(r.DecodeString())
+			}
+		case "name":
+			if r.TryDecodeAsNil() {
+				x.Name = ""
+			} else {
+				x.Name = string(r.DecodeString())
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys73)
+		} // end switch yys73
+	} // end for yyj73
+	z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+}
+
+func (x *RoleRef) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj76 int
+	var yyb76 bool
+	var yyhl76 bool = l >= 0
+	yyj76++
+	if yyhl76 {
+		yyb76 = yyj76 > l
+	} else {
+		yyb76 = r.CheckBreak()
+	}
+	if yyb76 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Kind = ""
+	} else {
+		x.Kind = string(r.DecodeString())
+	}
+	yyj76++
+	if yyhl76 {
+		yyb76 = yyj76 > l
+	} else {
+		yyb76 = r.CheckBreak()
+	}
+	if yyb76 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Name = ""
+	} else {
+		x.Name = string(r.DecodeString())
+	}
+	for {
+		yyj76++
+		if yyhl76 {
+			yyb76 = yyj76 > l
+		} else {
+			yyb76 = r.CheckBreak()
+		}
+		if yyb76 {
+			break
+		}
+		z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+		z.DecStructFieldNotFound(yyj76-1, "")
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x *Role) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yym79 := z.EncBinary()
+		_ = yym79
+		if false {
+		} else if z.HasExtensions() && z.EncExt(x) {
+		} else {
+			yysep80 := !z.EncBinary()
+			yy2arr80 := z.EncBasicHandle().StructToArray
+			var yyq80 [4]bool
+			_, _, _ = yysep80, yyq80, yy2arr80
+			const yyr80 bool = false
+			yyq80[0] = x.Kind != ""
+			yyq80[1] = x.APIVersion != ""
+			yyq80[2] = true
+			var yynn80 int
+			if yyr80 || yy2arr80 {
+				r.EncodeArrayStart(4)
+			} else {
+				yynn80 = 1
+				for _, b := range yyq80 {
+					if b {
+						yynn80++
+					}
+				}
+				r.EncodeMapStart(yynn80)
+				yynn80 = 0
+			}
+			if yyr80 || yy2arr80 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq80[0] {
+					yym82 := z.EncBinary()
+					_ = yym82
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq80[0] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("kind"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym83 := z.EncBinary()
+					_ = yym83
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				}
+			}
+			if yyr80 || yy2arr80 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq80[1] {
+					yym85 := z.EncBinary()
+					_ = yym85
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq80[1] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym86 := z.EncBinary()
+					_ = yym86
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				}
+			}
+			if yyr80 || yy2arr80 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq80[2] {
+					yy88 := &x.ObjectMeta
+					yy88.CodecEncodeSelf(e)
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq80[2] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yy89 := &x.ObjectMeta
+					yy89.CodecEncodeSelf(e)
+				}
+			}
+			if yyr80 || yy2arr80 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if x.Rules == nil {
+					r.EncodeNil()
+				} else {
+					yym91 := z.EncBinary()
+					_ = yym91
+					if false {
+					} else {
+						h.encSlicePolicyRule(([]PolicyRule)(x.Rules), e)
+					}
+				}
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("rules"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				if x.Rules == nil {
+					r.EncodeNil()
+				} else {
+					yym92 := z.EncBinary()
+					_ = yym92
+					if false {
+					} else {
+						h.encSlicePolicyRule(([]PolicyRule)(x.Rules), e)
+					}
+				}
+			}
+			if yyr80 || yy2arr80 {
+				z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapEnd1234)
+			}
+		}
+	}
+}
+
+func (x *Role) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym93 := z.DecBinary()
+	_ = yym93
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		yyct94 := r.ContainerType()
+		if yyct94 == codecSelferValueTypeMap1234 {
+			yyl94 := r.ReadMapStart()
+			if yyl94 == 0 {
+				z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+			} else {
+				x.codecDecodeSelfFromMap(yyl94, d)
+			}
+		} else if yyct94 == codecSelferValueTypeArray1234 {
+			yyl94 := r.ReadArrayStart()
+			if yyl94 == 0 {
+				z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				x.codecDecodeSelfFromArray(yyl94, d)
+			}
+		} else {
+			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
+		}
+	}
+}
+
+func (x *Role) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yys95Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys95Slc
+	var yyhl95 bool = l >= 0
+	for yyj95 := 0; ; yyj95++ {
+		if yyhl95 {
+			if yyj95 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		z.DecSendContainerState(codecSelfer_containerMapKey1234)
+		yys95Slc = r.DecodeBytes(yys95Slc, true, true)
+		yys95 := string(yys95Slc)
+		z.DecSendContainerState(codecSelfer_containerMapValue1234)
+		switch yys95 {
+		case "kind":
+			if r.TryDecodeAsNil() {
+				x.Kind = ""
+			} else {
+				x.Kind = string(r.DecodeString())
+			}
+		case "apiVersion":
+			if r.TryDecodeAsNil() {
+				x.APIVersion = ""
+			} else {
+				x.APIVersion = string(r.DecodeString())
+			}
+		case "metadata":
+			if r.TryDecodeAsNil() {
+				x.ObjectMeta = pkg2_api.ObjectMeta{}
+			} else {
+				yyv98 := &x.ObjectMeta
+				yyv98.CodecDecodeSelf(d)
+			}
+		case "rules":
+			if r.TryDecodeAsNil() {
+				x.Rules = nil
+			} else {
+				yyv99 := &x.Rules
+				yym100 := z.DecBinary()
+				_ = yym100
+				if false {
+				} else {
+					h.decSlicePolicyRule((*[]PolicyRule)(yyv99), d)
+				}
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys95)
+		} // end switch yys95
+	} // end for yyj95
+	z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+}
+
+func (x *Role) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj101 int
+	var yyb101 bool
+	var yyhl101 bool = l >= 0
+	yyj101++
+	if yyhl101 {
+		yyb101 = yyj101 > l
+	} else {
+		yyb101 = r.CheckBreak()
+	}
+	if yyb101 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Kind = ""
+	} else {
+		x.Kind = string(r.DecodeString())
+	}
+	yyj101++
+	if yyhl101 {
+		yyb101 = yyj101 > l
+	} else {
+		yyb101 = r.CheckBreak()
+	}
+	if yyb101 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.APIVersion = ""
+	} else {
+		x.APIVersion = string(r.DecodeString())
+	}
+	yyj101++
+	if yyhl101 {
+		yyb101 = yyj101 > l
+	} else {
+		yyb101 = r.CheckBreak()
+	}
+	if yyb101 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.ObjectMeta = pkg2_api.ObjectMeta{}
+	} else {
+		yyv104 := &x.ObjectMeta
+		yyv104.CodecDecodeSelf(d)
+	}
+	yyj101++
+	if yyhl101 {
+		yyb101 = yyj101 > l
+	} else {
+		yyb101 = r.CheckBreak()
+	}
+	if yyb101 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Rules = nil
+	} else {
+		yyv105 := &x.Rules
+		yym106 := z.DecBinary()
+		_ = yym106
+		if false {
+		} else {
+			h.decSlicePolicyRule((*[]PolicyRule)(yyv105), d)
+		}
+	}
+	for {
+		yyj101++
+		if yyhl101 {
+			yyb101 = yyj101 > l
+		} else {
+			yyb101 = r.CheckBreak()
+		}
+		if yyb101 {
+			break
+		}
+		z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+		z.DecStructFieldNotFound(yyj101-1, "")
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x *RoleBinding) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yym107 := z.EncBinary()
+		_ = yym107
+		if false {
+		} else if z.HasExtensions() && z.EncExt(x) {
+		} else {
+			yysep108 := !z.EncBinary()
+			yy2arr108 := z.EncBasicHandle().StructToArray
+			var yyq108 [5]bool
+			_, _, _ = yysep108, yyq108, yy2arr108
+			const yyr108 bool = false
+			yyq108[0] = x.Kind != ""
+			yyq108[1] = x.APIVersion != ""
+			yyq108[2] = true
+			var yynn108 int
+			if yyr108 || yy2arr108 {
+				r.EncodeArrayStart(5)
+			} else {
+				yynn108 = 2
+				for _, b := range yyq108 {
+					if b {
+						yynn108++
+					}
+				}
+				r.EncodeMapStart(yynn108)
+				yynn108 = 0
+			}
+			if yyr108 || yy2arr108 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq108[0] {
+					yym110 := z.EncBinary()
+					_ = yym110
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq108[0] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("kind"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym111 := z.EncBinary()
+					_ = yym111
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				}
+			}
+			if yyr108 || yy2arr108 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq108[1] {
+					yym113 := z.EncBinary()
+					_ = yym113
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq108[1] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym114 := z.EncBinary()
+					_ = yym114
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				}
+			}
+			if yyr108 || yy2arr108 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq108[2] {
+					yy116 := &x.ObjectMeta
+					yy116.CodecEncodeSelf(e)
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq108[2] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yy117 := &x.ObjectMeta
+					yy117.CodecEncodeSelf(e)
+				}
+			}
+			if yyr108 || yy2arr108 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if x.Subjects == nil {
+					r.EncodeNil()
+				} else {
+					yym119 := z.EncBinary()
+					_ = yym119
+					if false {
+					} else {
+						h.encSliceSubject(([]Subject)(x.Subjects), e)
+					}
+				}
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("subjects"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				if x.Subjects == nil {
+					r.EncodeNil()
+				} else {
+					yym120 := z.EncBinary()
+					_ = yym120
+					if false {
+					} else {
+						h.encSliceSubject(([]Subject)(x.Subjects), e)
+					}
+				}
+			}
+			if yyr108 || yy2arr108 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				yy122 := &x.RoleRef
+				yy122.CodecEncodeSelf(e)
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("roleRef"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				yy123 := &x.RoleRef
+				yy123.CodecEncodeSelf(e)
+			}
+			if yyr108 || yy2arr108 {
+				z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapEnd1234)
+			}
+		}
+	}
+}
+
+func (x *RoleBinding) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym124 := z.DecBinary()
+	_ = yym124
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		yyct125 := r.ContainerType()
+		if yyct125 == codecSelferValueTypeMap1234 {
+			yyl125 := r.ReadMapStart()
+			if yyl125 == 0 {
+				z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+			} else {
+				x.codecDecodeSelfFromMap(yyl125, d)
+			}
+		} else if yyct125 == codecSelferValueTypeArray1234 {
+			yyl125 := r.ReadArrayStart()
+			if yyl125 == 0 {
+				z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				x.codecDecodeSelfFromArray(yyl125, d)
+			}
+		} else {
+			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
+		}
+	}
+}
+
+func (x *RoleBinding) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yys126Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys126Slc
+	var yyhl126 bool = l >= 0
+	for yyj126 := 0; ; yyj126++ {
+		if yyhl126 {
+			if yyj126 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		z.DecSendContainerState(codecSelfer_containerMapKey1234)
+		yys126Slc = r.DecodeBytes(yys126Slc, true, true)
+		yys126 := string(yys126Slc)
+		z.DecSendContainerState(codecSelfer_containerMapValue1234)
+		switch yys126 {
+		case "kind":
+			if r.TryDecodeAsNil() {
+				x.Kind = ""
+			} else {
+				x.Kind = string(r.DecodeString())
+			}
+		case "apiVersion":
+			if r.TryDecodeAsNil() {
+				x.APIVersion = ""
+			} else {
+				x.APIVersion = string(r.DecodeString())
+			}
+		case "metadata":
+			if r.TryDecodeAsNil() {
+				x.ObjectMeta = pkg2_api.ObjectMeta{}
+			} else {
+				yyv129 := &x.ObjectMeta
+				yyv129.CodecDecodeSelf(d)
+			}
+		case "subjects":
+			if r.TryDecodeAsNil() {
+				x.Subjects = nil
+			} else {
+				yyv130 := &x.Subjects
+				yym131 := z.DecBinary()
+				_ = yym131
+				if false {
+				} else {
+					h.decSliceSubject((*[]Subject)(yyv130), d)
+				}
+			}
+		case "roleRef":
+			if r.TryDecodeAsNil() {
+				x.RoleRef = RoleRef{}
+			} else {
+				yyv132 := &x.RoleRef
+				yyv132.CodecDecodeSelf(d)
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys126)
+		} // end switch yys126
+	} // end for yyj126
+	z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+}
+
+func (x *RoleBinding) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj133 int
+	var yyb133 bool
+	var yyhl133 bool = l >= 0
+	yyj133++
+	if yyhl133 {
+		yyb133 = yyj133 > l
+	} else {
+		yyb133 = r.CheckBreak()
+	}
+	if yyb133 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Kind = ""
+	} else {
+		x.Kind = string(r.DecodeString())
+	}
+	yyj133++
+	if yyhl133 {
+		yyb133 = yyj133 > l
+	} else {
+		yyb133 = r.CheckBreak()
+	}
+	if yyb133 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.APIVersion = ""
+	} else {
+		x.APIVersion = string(r.DecodeString())
+	}
+	yyj133++
+	if yyhl133 {
+		yyb133 = yyj133 > l
+	} else {
+		yyb133 = r.CheckBreak()
+	}
+	if yyb133 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.ObjectMeta = pkg2_api.ObjectMeta{}
+	} else {
+		yyv136 := &x.ObjectMeta
+		yyv136.CodecDecodeSelf(d)
+	}
+	yyj133++
+	if yyhl133 {
+		yyb133 = yyj133 > l
+	} else {
+		yyb133 = r.CheckBreak()
+	}
+	if yyb133 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Subjects = nil
+	} else {
+		yyv137 := &x.Subjects
+		yym138 := z.DecBinary()
+		_ = yym138
+		if false {
+		} else {
+			h.decSliceSubject((*[]Subject)(yyv137), d)
+		}
+	}
+	yyj133++
+	if yyhl133 {
+		yyb133 = yyj133 > l
+	} else {
+		yyb133 = r.CheckBreak()
+	}
+	if yyb133 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.RoleRef = RoleRef{}
+	} else {
+		yyv139 := &x.RoleRef
+		yyv139.CodecDecodeSelf(d)
+	}
+	for {
+		yyj133++
+		if yyhl133 {
+			yyb133 = yyj133 > l
+		} else {
+			yyb133 = r.CheckBreak()
+		}
+		if yyb133 {
+			break
+		}
+		z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+		z.DecStructFieldNotFound(yyj133-1, "")
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x *RoleBindingList) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yym140 := z.EncBinary()
+		_ = yym140
+		if false {
+		} else if z.HasExtensions() && z.EncExt(x) {
+		} else {
+			yysep141 := !z.EncBinary()
+			yy2arr141 := z.EncBasicHandle().StructToArray
+			var yyq141 [4]bool
+			_, _, _ = yysep141, yyq141, yy2arr141
+			const yyr141 bool = false
+			yyq141[0] = x.Kind != ""
+			yyq141[1] = x.APIVersion != ""
+			yyq141[2] = true
+			var yynn141 int
+			if yyr141 || yy2arr141 {
+				r.EncodeArrayStart(4)
+			} else {
+				yynn141 = 1
+				for _, b := range yyq141 {
+					if b {
+						yynn141++
+					}
+				}
+				r.EncodeMapStart(yynn141)
+				yynn141 = 0
+			}
+			if yyr141 || yy2arr141 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq141[0] {
+					yym143 := z.EncBinary()
+					_ = yym143
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq141[0] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("kind"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym144 := z.EncBinary()
+					_ = yym144
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				}
+			}
+			if yyr141 || yy2arr141 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq141[1] {
+					yym146 := z.EncBinary()
+					_ = yym146
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq141[1] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym147 := z.EncBinary()
+					_ = yym147
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				}
+			}
+			if yyr141 || yy2arr141 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq141[2] {
+					yy149 := &x.ListMeta
+					yym150 := z.EncBinary()
+					_ = yym150
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy149) {
+					} else {
+						z.EncFallback(yy149)
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq141[2] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yy151 := &x.ListMeta
+					yym152 := z.EncBinary()
+					_ = yym152
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy151) {
+					} else {
+						z.EncFallback(yy151)
+					}
+				}
+			}
+			if yyr141 || yy2arr141 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if x.Items == nil {
+					r.EncodeNil()
+				} else {
+					yym154 := z.EncBinary()
+					_ = yym154
+					if false {
+					} else {
+						h.encSliceRoleBinding(([]RoleBinding)(x.Items), e)
+					}
+				}
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("items"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				if x.Items == nil {
+					r.EncodeNil()
+				} else {
+					yym155 := z.EncBinary()
+					_ = yym155
+					if false {
+					} else {
+						h.encSliceRoleBinding(([]RoleBinding)(x.Items), e)
+					}
+				}
+			}
+			if yyr141 || yy2arr141 {
+				z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapEnd1234)
+			}
+		}
+	}
+}
+
+func (x *RoleBindingList) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym156 := z.DecBinary()
+	_ = yym156
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		yyct157 := r.ContainerType()
+		if yyct157 == codecSelferValueTypeMap1234 {
+			yyl157 := r.ReadMapStart()
+			if yyl157 == 0 {
+				z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+			} else {
+				x.codecDecodeSelfFromMap(yyl157, d)
+			}
+		} else if yyct157 == codecSelferValueTypeArray1234 {
+			yyl157 := r.ReadArrayStart()
+			if yyl157 == 0 {
+				z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				x.codecDecodeSelfFromArray(yyl157, d)
+			}
+		} else {
+			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
+		}
+	}
+}
+
+func (x *RoleBindingList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yys158Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys158Slc
+	var yyhl158 bool = l >= 0
+	for yyj158 := 0; ; yyj158++ {
+		if yyhl158 {
+			if yyj158 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		z.DecSendContainerState(codecSelfer_containerMapKey1234)
+		yys158Slc = r.DecodeBytes(yys158Slc, true, true)
+		yys158 := string(yys158Slc)
+		z.DecSendContainerState(codecSelfer_containerMapValue1234)
+		switch yys158 {
+		case "kind":
+			if r.TryDecodeAsNil() {
+				x.Kind = ""
+			} else {
+				x.Kind = string(r.DecodeString())
+			}
+		case "apiVersion":
+			if r.TryDecodeAsNil() {
+				x.APIVersion = ""
+			} else {
+				x.APIVersion = string(r.DecodeString())
+			}
+		case "metadata":
+			if r.TryDecodeAsNil() {
+				x.ListMeta = pkg1_unversioned.ListMeta{}
+			} else {
+				yyv161 := &x.ListMeta
+				yym162 := z.DecBinary()
+				_ = yym162
+				if false {
+				} else if z.HasExtensions() && z.DecExt(yyv161) {
+				} else {
+					z.DecFallback(yyv161, false)
+				}
+			}
+		case "items":
+			if r.TryDecodeAsNil() {
+				x.Items = nil
+			} else {
+				yyv163 := &x.Items
+				yym164 := z.DecBinary()
+				_ = yym164
+				if false {
+				} else {
+					h.decSliceRoleBinding((*[]RoleBinding)(yyv163), d)
+				}
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys158)
+		} // end switch yys158
+	} // end for yyj158
+	z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+}
+
+func (x *RoleBindingList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj165 int
+	var yyb165 bool
+	var yyhl165 bool = l >= 0
+	yyj165++
+	if yyhl165 {
+		yyb165 = yyj165 > l
+	} else {
+		yyb165 = r.CheckBreak()
+	}
+	if yyb165 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Kind = ""
+	} else {
+		x.Kind = string(r.DecodeString())
+	}
+	yyj165++
+	if yyhl165 {
+		yyb165 = yyj165 > l
+	} else {
+		yyb165 = r.CheckBreak()
+	}
+	if yyb165 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.APIVersion = ""
+	} else {
+		x.APIVersion = string(r.DecodeString())
+	}
+	yyj165++
+	if yyhl165 {
+		yyb165 = yyj165 > l
+	} else {
+		yyb165 = r.CheckBreak()
+	}
+	if yyb165 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.ListMeta = pkg1_unversioned.ListMeta{}
+	} else {
+		yyv168 := &x.ListMeta
+		yym169 := z.DecBinary()
+		_ = yym169
+		if false {
+		} else if z.HasExtensions() && z.DecExt(yyv168) {
+		} else {
+			z.DecFallback(yyv168, false)
+		}
+	}
+	yyj165++
+	if yyhl165 {
+		yyb165 = yyj165 > l
+	} else {
+		yyb165 = r.CheckBreak()
+	}
+	if yyb165 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Items = nil
+	} else {
+		yyv170 := &x.Items
+		yym171 := z.DecBinary()
+		_ = yym171
+		if false {
+		} else {
+			h.decSliceRoleBinding((*[]RoleBinding)(yyv170), d)
+		}
+	}
+	for {
+		yyj165++
+		if yyhl165 {
+			yyb165 = yyj165 > l
+		} else {
+			yyb165 = r.CheckBreak()
+		}
+		if yyb165 {
+			break
+		}
+		z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+		z.DecStructFieldNotFound(yyj165-1, "")
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x *RoleList) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yym172 := z.EncBinary()
+		_ = yym172
+		if false {
+		} else if z.HasExtensions() && z.EncExt(x) {
+		} else {
+			yysep173 := !z.EncBinary()
+			yy2arr173 := z.EncBasicHandle().StructToArray
+			var yyq173 [4]bool
+			_, _, _ = yysep173, yyq173, yy2arr173
+			const yyr173 bool = false
+			yyq173[0] = x.Kind != ""
+			yyq173[1] = x.APIVersion != ""
+			yyq173[2] = true
+			var yynn173 int
+			if yyr173 || yy2arr173 {
+				r.EncodeArrayStart(4)
+			} else {
+				yynn173 = 1
+				for _, b := range yyq173 {
+					if b {
+						yynn173++
+					}
+				}
+				r.EncodeMapStart(yynn173)
+				yynn173 = 0
+			}
+			if yyr173 || yy2arr173 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq173[0] {
+					yym175 := z.EncBinary()
+					_ = yym175
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq173[0] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("kind"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym176 := z.EncBinary()
+					_ = yym176
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				}
+			}
+			if yyr173 || yy2arr173 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq173[1] {
+					yym178 := z.EncBinary()
+					_ = yym178
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq173[1] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym179 := z.EncBinary()
+					_ = yym179
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				}
+			}
+			if yyr173 || yy2arr173 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq173[2] {
+					yy181 := &x.ListMeta
+					yym182 := z.EncBinary()
+					_ = yym182
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy181) {
+					} else {
+						z.EncFallback(yy181)
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq173[2] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yy183 := &x.ListMeta
+					yym184 := z.EncBinary()
+					_ = yym184
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy183) {
+					} else {
+						z.EncFallback(yy183)
+					}
+				}
+			}
+			if yyr173 || yy2arr173 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if x.Items == nil {
+					r.EncodeNil()
+				} else {
+					yym186 := z.EncBinary()
+					_ = yym186
+					if false {
+					} else {
+						h.encSliceRole(([]Role)(x.Items), e)
+					}
+				}
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("items"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				if x.Items == nil {
+					r.EncodeNil()
+				} else {
+					yym187 := z.EncBinary()
+					_ = yym187
+					if false {
+					} else {
+						h.encSliceRole(([]Role)(x.Items), e)
+					}
+				}
+			}
+			if yyr173 || yy2arr173 {
+				z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapEnd1234)
+			}
+		}
+	}
+}
+
+func (x *RoleList) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym188 := z.DecBinary()
+	_ = yym188
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		yyct189 := r.ContainerType()
+		if yyct189 == codecSelferValueTypeMap1234 {
+			yyl189 := r.ReadMapStart()
+			if yyl189 == 0 {
+				z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+			} else {
+				x.codecDecodeSelfFromMap(yyl189, d)
+			}
+		} else if yyct189 == codecSelferValueTypeArray1234 {
+			yyl189 := r.ReadArrayStart()
+			if yyl189 == 0 {
+				z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				x.codecDecodeSelfFromArray(yyl189, d)
+			}
+		} else {
+			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
+		}
+	}
+}
+
+func (x *RoleList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yys190Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys190Slc
+	var yyhl190 bool = l >= 0
+	for yyj190 := 0; ; yyj190++ {
+		if yyhl190 {
+			if yyj190 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		z.DecSendContainerState(codecSelfer_containerMapKey1234)
+		yys190Slc = r.DecodeBytes(yys190Slc, true, true)
+		yys190 := string(yys190Slc)
+		z.DecSendContainerState(codecSelfer_containerMapValue1234)
+		switch yys190 {
+		case "kind":
+			if r.TryDecodeAsNil() {
+				x.Kind = ""
+			} else {
+				x.Kind = string(r.DecodeString())
+			}
+		case "apiVersion":
+			if r.TryDecodeAsNil() {
+				x.APIVersion = ""
+			} else {
+				x.APIVersion = string(r.DecodeString())
+			}
+		case "metadata":
+			if r.TryDecodeAsNil() {
+				x.ListMeta = pkg1_unversioned.ListMeta{}
+			} else {
+				yyv193 := &x.ListMeta
+				yym194 := z.DecBinary()
+				_ = yym194
+				if false {
+				} else if z.HasExtensions() && z.DecExt(yyv193) {
+				} else {
+					z.DecFallback(yyv193, false)
+				}
+			}
+		case "items":
+			if r.TryDecodeAsNil() {
+				x.Items = nil
+			} else {
+				yyv195 := &x.Items
+				yym196 := z.DecBinary()
+				_ = yym196
+				if false {
+				} else {
+					h.decSliceRole((*[]Role)(yyv195), d)
+				}
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys190)
+		} // end switch yys190
+	} // end for yyj190
+	z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+}
+
+func (x *RoleList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj197 int
+	var yyb197 bool
+	var yyhl197 bool = l >= 0
+	yyj197++
+	if yyhl197 {
+		yyb197 = yyj197 > l
+	} else {
+		yyb197 = r.CheckBreak()
+	}
+	if yyb197 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Kind = ""
+	} else {
+		x.Kind = string(r.DecodeString())
+	}
+	yyj197++
+	if yyhl197 {
+		yyb197 = yyj197 > l
+	} else {
+		yyb197 = r.CheckBreak()
+	}
+	if yyb197 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.APIVersion = ""
+	} else {
+		x.APIVersion = string(r.DecodeString())
+	}
+	yyj197++
+	if yyhl197 {
+		yyb197 = yyj197 > l
+	} else {
+		yyb197 = r.CheckBreak()
+	}
+	if yyb197 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.ListMeta = pkg1_unversioned.ListMeta{}
+	} else {
+		yyv200 := &x.ListMeta
+		yym201 := z.DecBinary()
+		_ = yym201
+		if false {
+		} else if z.HasExtensions() && z.DecExt(yyv200) {
+		} else {
+			z.DecFallback(yyv200, false)
+		}
+	}
+	yyj197++
+	if yyhl197 {
+		yyb197 = yyj197 > l
+	} else {
+		yyb197 = r.CheckBreak()
+	}
+	if yyb197 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Items = nil
+	} else {
+		yyv202 := &x.Items
+		yym203 := z.DecBinary()
+		_ = yym203
+		if false {
+		} else {
+			h.decSliceRole((*[]Role)(yyv202), d)
+		}
+	}
+	for {
+		yyj197++
+		if yyhl197 {
+			yyb197 = yyj197 > l
+		} else {
+			yyb197 = r.CheckBreak()
+		}
+		if yyb197 {
+			break
+		}
+		z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+		z.DecStructFieldNotFound(yyj197-1, "")
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x *ClusterRole) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yym204 := z.EncBinary()
+		_ = yym204
+		if false {
+		} else if z.HasExtensions() && z.EncExt(x) {
+		} else {
+			yysep205 := !z.EncBinary()
+			yy2arr205 := z.EncBasicHandle().StructToArray
+			var yyq205 [4]bool
+			_, _, _ = yysep205, yyq205, yy2arr205
+			const yyr205 bool = false
+			yyq205[0] = x.Kind != ""
+			yyq205[1] = x.APIVersion != ""
+			yyq205[2] = true
+			var yynn205 int
+			if yyr205 || yy2arr205 {
+				r.EncodeArrayStart(4)
+			} else {
+				yynn205 = 1
+				for _, b := range yyq205 {
+					if b {
+						yynn205++
+					}
+				}
+				r.EncodeMapStart(yynn205)
+				yynn205 = 0
+			}
+			if yyr205 || yy2arr205 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq205[0] {
+					yym207 := z.EncBinary()
+					_ = yym207
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq205[0] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("kind"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym208 := z.EncBinary()
+					_ = yym208
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				}
+			}
+			if yyr205 || yy2arr205 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq205[1] {
+					yym210 := z.EncBinary()
+					_ = yym210
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq205[1] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym211 := z.EncBinary()
+					_ = yym211
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				}
+			}
+			if yyr205 || yy2arr205 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq205[2] {
+					yy213 := &x.ObjectMeta
+					yy213.CodecEncodeSelf(e)
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq205[2] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yy214 := &x.ObjectMeta
+					yy214.CodecEncodeSelf(e)
+				}
+			}
+			if yyr205 || yy2arr205 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if x.Rules == nil {
+					r.EncodeNil()
+				} else {
+					yym216 := z.EncBinary()
+					_ = yym216
+					if false {
+					} else {
+						h.encSlicePolicyRule(([]PolicyRule)(x.Rules), e)
+					}
+				}
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("rules"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				if x.Rules == nil {
+					r.EncodeNil()
+				} else {
+					yym217 := z.EncBinary()
+					_ = yym217
+					if false {
+					} else {
+						h.encSlicePolicyRule(([]PolicyRule)(x.Rules), e)
+					}
+				}
+			}
+			if yyr205 || yy2arr205 {
+				z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapEnd1234)
+			}
+		}
+	}
+}
+
+func (x *ClusterRole) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym218 := z.DecBinary()
+	_ = yym218
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		yyct219 := r.ContainerType()
+		if yyct219 == codecSelferValueTypeMap1234 {
+			yyl219 := r.ReadMapStart()
+			if yyl219 == 0 {
+				z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+			} else {
+				x.codecDecodeSelfFromMap(yyl219, d)
+			}
+		} else if yyct219 == codecSelferValueTypeArray1234 {
+			yyl219 := r.ReadArrayStart()
+			if yyl219 == 0 {
+				z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				x.codecDecodeSelfFromArray(yyl219, d)
+			}
+		} else {
+			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
+		}
+	}
+}
+
+func (x *ClusterRole) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yys220Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys220Slc
+	var yyhl220 bool = l >= 0
+	for yyj220 := 0; ; yyj220++ {
+		if yyhl220 {
+			if yyj220 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		z.DecSendContainerState(codecSelfer_containerMapKey1234)
+		yys220Slc = r.DecodeBytes(yys220Slc, true, true)
+		yys220 := string(yys220Slc)
+		z.DecSendContainerState(codecSelfer_containerMapValue1234)
+		switch yys220 {
+		case "kind":
+			if r.TryDecodeAsNil() {
+				x.Kind = ""
+			} else {
+				x.Kind = string(r.DecodeString())
+			}
+		case "apiVersion":
+			if r.TryDecodeAsNil() {
+				x.APIVersion = ""
+			} else {
+				x.APIVersion = string(r.DecodeString())
+			}
+		case "metadata":
+			if r.TryDecodeAsNil() {
+				x.ObjectMeta = pkg2_api.ObjectMeta{}
+			} else {
+				yyv223 := &x.ObjectMeta
+				yyv223.CodecDecodeSelf(d)
+			}
+		case "rules":
+			if r.TryDecodeAsNil() {
+				x.Rules = nil
+			} else {
+				yyv224 := &x.Rules
+				yym225 := z.DecBinary()
+				_ = yym225
+				if false {
+				} else {
+					h.decSlicePolicyRule((*[]PolicyRule)(yyv224), d)
+				}
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys220)
+		} // end switch yys220
+	} // end for yyj220
+	z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+}
+
+func (x *ClusterRole) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj226 int
+	var yyb226 bool
+	var yyhl226 bool = l >= 0
+	yyj226++
+	if yyhl226 {
+		yyb226 = yyj226 > l
+	} else {
+		yyb226 = r.CheckBreak()
+	}
+	if yyb226 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Kind = ""
+	} else {
+		x.Kind = string(r.DecodeString())
+	}
+	yyj226++
+	if yyhl226 {
+		yyb226 = yyj226 > l
+	} else {
+		yyb226 = r.CheckBreak()
+	}
+	if yyb226 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.APIVersion = ""
+	} else {
+		x.APIVersion = string(r.DecodeString())
+	}
+	yyj226++
+	if yyhl226 {
+		yyb226 = yyj226 > l
+	} else {
+		yyb226 = r.CheckBreak()
+	}
+	if yyb226 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.ObjectMeta = pkg2_api.ObjectMeta{}
+	} else {
+		yyv229 := &x.ObjectMeta
+		yyv229.CodecDecodeSelf(d)
+	}
+	yyj226++
+	if yyhl226 {
+		yyb226 = yyj226 > l
+	} else {
+		yyb226 = r.CheckBreak()
+	}
+	if yyb226 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Rules = nil
+	} else {
+		yyv230 := &x.Rules
+		yym231 := z.DecBinary()
+		_ = yym231
+		if false {
+		} else {
+			h.decSlicePolicyRule((*[]PolicyRule)(yyv230), d)
+		}
+	}
+	for {
+		yyj226++
+		if yyhl226 {
+			yyb226 = yyj226 > l
+		} else {
+			yyb226 = r.CheckBreak()
+		}
+		if yyb226 {
+			break
+		}
+		z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+		z.DecStructFieldNotFound(yyj226-1, "")
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x *ClusterRoleBinding) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yym232 := z.EncBinary()
+		_ = yym232
+		if false {
+		} else if z.HasExtensions() && z.EncExt(x) {
+		} else {
+			yysep233 := !z.EncBinary()
+			yy2arr233 := z.EncBasicHandle().StructToArray
+			var yyq233 [5]bool
+			_, _, _ = yysep233, yyq233, yy2arr233
+			const yyr233 bool = false
+			yyq233[0] = x.Kind != ""
+			yyq233[1] = x.APIVersion != ""
+			yyq233[2] = true
+			var yynn233 int
+			if yyr233 || yy2arr233 {
+				r.EncodeArrayStart(5)
+			} else {
+				yynn233 = 2
+				for _, b := range yyq233 {
+					if b {
+						yynn233++
+					}
+				}
+				r.EncodeMapStart(yynn233)
+				yynn233 = 0
+			}
+			if yyr233 || yy2arr233 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq233[0] {
+					yym235 := z.EncBinary()
+					_ = yym235
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq233[0] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("kind"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym236 := z.EncBinary()
+					_ = yym236
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				}
+			}
+			if yyr233 || yy2arr233 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq233[1] {
+					yym238 := z.EncBinary()
+					_ = yym238
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq233[1] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym239 := z.EncBinary()
+					_ = yym239
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				}
+			}
+			if yyr233 || yy2arr233 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq233[2] {
+					yy241 := &x.ObjectMeta
+					yy241.CodecEncodeSelf(e)
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq233[2] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yy242 := &x.ObjectMeta
+					yy242.CodecEncodeSelf(e)
+				}
+			}
+			if yyr233 || yy2arr233 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if x.Subjects == nil {
+					r.EncodeNil()
+				} else {
+					yym244 := z.EncBinary()
+					_ = yym244
+					if false {
+					} else {
+						h.encSliceSubject(([]Subject)(x.Subjects), e)
+					}
+				}
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("subjects"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				if x.Subjects == nil {
+					r.EncodeNil()
+				} else {
+					yym245 := z.EncBinary()
+					_ = yym245
+					if false {
+					} else {
+						h.encSliceSubject(([]Subject)(x.Subjects), e)
+					}
+				}
+			}
+			if yyr233 || yy2arr233 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				yy247 := &x.RoleRef
+				yy247.CodecEncodeSelf(e)
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("roleRef"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				yy248 := &x.RoleRef
+				yy248.CodecEncodeSelf(e)
+			}
+			if yyr233 || yy2arr233 {
+				z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapEnd1234)
+			}
+		}
+	}
+}
+
+func (x *ClusterRoleBinding) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym249 := z.DecBinary()
+	_ = yym249
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		yyct250 := r.ContainerType()
+		if yyct250 == codecSelferValueTypeMap1234 {
+			yyl250 := r.ReadMapStart()
+			if yyl250 == 0 {
+				z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+			} else {
+				x.codecDecodeSelfFromMap(yyl250, d)
+			}
+		} else if yyct250 == codecSelferValueTypeArray1234 {
+			yyl250 := r.ReadArrayStart()
+			if yyl250 == 0 {
+				z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				x.codecDecodeSelfFromArray(yyl250, d)
+			}
+		} else {
+			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
+		}
+	}
+}
+
+func (x *ClusterRoleBinding) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yys251Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys251Slc
+	var yyhl251 bool = l >= 0
+	for yyj251 := 0; ; yyj251++ {
+		if yyhl251 {
+			if yyj251 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		z.DecSendContainerState(codecSelfer_containerMapKey1234)
+		yys251Slc = r.DecodeBytes(yys251Slc, true, true)
+		yys251 := string(yys251Slc)
+		z.DecSendContainerState(codecSelfer_containerMapValue1234)
+		switch yys251 {
+		case "kind":
+			if r.TryDecodeAsNil() {
+				x.Kind = ""
+			} else {
+				x.Kind = string(r.DecodeString())
+			}
+		case "apiVersion":
+			if r.TryDecodeAsNil() {
+				x.APIVersion = ""
+			} else {
+				x.APIVersion = string(r.DecodeString())
+			}
+		case "metadata":
+			if r.TryDecodeAsNil() {
+				x.ObjectMeta = pkg2_api.ObjectMeta{}
+			} else {
+				yyv254 := &x.ObjectMeta
+				yyv254.CodecDecodeSelf(d)
+			}
+		case "subjects":
+			if r.TryDecodeAsNil() {
+				x.Subjects = nil
+			} else {
+				yyv255 := &x.Subjects
+				yym256 := z.DecBinary()
+				_ = yym256
+				if false {
+				} else {
+					h.decSliceSubject((*[]Subject)(yyv255), d)
+				}
+			}
+		case "roleRef":
+			if r.TryDecodeAsNil() {
+				x.RoleRef = RoleRef{}
+			} else {
+				yyv257 := &x.RoleRef
+				yyv257.CodecDecodeSelf(d)
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys251)
+		} // end switch yys251
+	} // end for yyj251
+	z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+}
+
+func (x *ClusterRoleBinding) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj258 int
+	var yyb258 bool
+	var yyhl258 bool = l >= 0
+	yyj258++
+	if yyhl258 {
+		yyb258 = yyj258 > l
+	} else {
+		yyb258 = r.CheckBreak()
+	}
+	if yyb258 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Kind = ""
+	} else {
+		x.Kind = string(r.DecodeString())
+	}
+	yyj258++
+	if yyhl258 {
+		yyb258 = yyj258 > l
+	} else {
+		yyb258 = r.CheckBreak()
+	}
+	if yyb258 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.APIVersion = ""
+	} else {
+		x.APIVersion = string(r.DecodeString())
+	}
+	yyj258++
+	if yyhl258 {
+		yyb258 = yyj258 > l
+	} else {
+		yyb258 = r.CheckBreak()
+	}
+	if yyb258 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.ObjectMeta = pkg2_api.ObjectMeta{}
+	} else {
+		yyv261 := &x.ObjectMeta
+		yyv261.CodecDecodeSelf(d)
+	}
+	yyj258++
+	if yyhl258 {
+		yyb258 = yyj258 > l
+	} else {
+		yyb258 = r.CheckBreak()
+	}
+	if yyb258 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Subjects = nil
+	} else {
+		yyv262 := &x.Subjects
+		yym263 := z.DecBinary()
+		_ = yym263
+		if false {
+		} else {
+			h.decSliceSubject((*[]Subject)(yyv262), d)
+		}
+	}
+	yyj258++
+	if yyhl258 {
+		yyb258 = yyj258 > l
+	} else {
+		yyb258 = r.CheckBreak()
+	}
+	if yyb258 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.RoleRef = RoleRef{}
+	} else {
+		yyv264 := &x.RoleRef
+		yyv264.CodecDecodeSelf(d)
+	}
+	for {
+		yyj258++
+		if yyhl258 {
+			yyb258 = yyj258 > l
+		} else {
+			yyb258 = r.CheckBreak()
+		}
+		if yyb258 {
+			break
+		}
+		z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+		z.DecStructFieldNotFound(yyj258-1, "")
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x *ClusterRoleBindingList) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yym265 := z.EncBinary()
+		_ = yym265
+		if false {
+		} else if z.HasExtensions() && z.EncExt(x) {
+		} else {
+			yysep266 := !z.EncBinary()
+			yy2arr266 := z.EncBasicHandle().StructToArray
+			var yyq266 [4]bool
+			_, _, _ = yysep266, yyq266, yy2arr266
+			const yyr266 bool = false
+			yyq266[0] = x.Kind != ""
+			yyq266[1] = x.APIVersion != ""
+			yyq266[2] = true
+			var yynn266 int
+			if yyr266 || yy2arr266 {
+				r.EncodeArrayStart(4)
+			} else {
+				yynn266 = 1
+				for _, b := range yyq266 {
+					if b {
+						yynn266++
+					}
+				}
+				r.EncodeMapStart(yynn266)
+				yynn266 = 0
+			}
+			if yyr266 || yy2arr266 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq266[0] {
+					yym268 := z.EncBinary()
+					_ = yym268
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq266[0] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("kind"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym269 := z.EncBinary()
+					_ = yym269
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				}
+			}
+			if yyr266 || yy2arr266 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq266[1] {
+					yym271 := z.EncBinary()
+					_ = yym271
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq266[1] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym272 := z.EncBinary()
+					_ = yym272
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				}
+			}
+			if yyr266 || yy2arr266 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq266[2] {
+					yy274 := &x.ListMeta
+					yym275 := z.EncBinary()
+					_ = yym275
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy274) {
+					} else {
+						z.EncFallback(yy274)
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq266[2] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yy276 := &x.ListMeta
+					yym277 := z.EncBinary()
+					_ = yym277
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy276) {
+					} else {
+						z.EncFallback(yy276)
+					}
+				}
+			}
+			if yyr266 || yy2arr266 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if x.Items == nil {
+					r.EncodeNil()
+				} else {
+					yym279 := z.EncBinary()
+					_ = yym279
+					if false {
+					} else {
+						h.encSliceClusterRoleBinding(([]ClusterRoleBinding)(x.Items), e)
+					}
+				}
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("items"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				if x.Items == nil {
+					r.EncodeNil()
+				} else {
+					yym280 := z.EncBinary()
+					_ = yym280
+					if false {
+					} else {
+						h.encSliceClusterRoleBinding(([]ClusterRoleBinding)(x.Items), e)
+					}
+				}
+			}
+			if yyr266 || yy2arr266 {
+				z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapEnd1234)
+			}
+		}
+	}
+}
+
+func (x *ClusterRoleBindingList) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym281 := z.DecBinary()
+	_ = yym281
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		yyct282 := r.ContainerType()
+		if yyct282 == codecSelferValueTypeMap1234 {
+			yyl282 := r.ReadMapStart()
+			if yyl282 == 0 {
+				z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+			} else {
+				x.codecDecodeSelfFromMap(yyl282, d)
+			}
+		} else if yyct282 == codecSelferValueTypeArray1234 {
+			yyl282 := r.ReadArrayStart()
+			if yyl282 == 0 {
+				z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				x.codecDecodeSelfFromArray(yyl282, d)
+			}
+		} else {
+			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
+		}
+	}
+}
+
+func (x *ClusterRoleBindingList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yys283Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys283Slc
+	var yyhl283 bool = l >= 0
+	for yyj283 := 0; ; yyj283++ {
+		if yyhl283 {
+			if yyj283 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		z.DecSendContainerState(codecSelfer_containerMapKey1234)
+		yys283Slc = r.DecodeBytes(yys283Slc, true, true)
+		yys283 := string(yys283Slc)
+		z.DecSendContainerState(codecSelfer_containerMapValue1234)
+		switch yys283 {
+		case "kind":
+			if r.TryDecodeAsNil() {
+				x.Kind = ""
+			} else {
+				x.Kind = string(r.DecodeString())
+			}
+		case "apiVersion":
+			if r.TryDecodeAsNil() {
+				x.APIVersion = ""
+			} else {
+				x.APIVersion = string(r.DecodeString())
+			}
+		case "metadata":
+			if r.TryDecodeAsNil() {
+				x.ListMeta = pkg1_unversioned.ListMeta{}
+			} else {
+				yyv286 := &x.ListMeta
+				yym287 := z.DecBinary()
+				_ = yym287
+				if false {
+				} else if z.HasExtensions() && z.DecExt(yyv286) {
+				} else {
+					z.DecFallback(yyv286, false)
+				}
+			}
+		case "items":
+			if r.TryDecodeAsNil() {
+				x.Items = nil
+			} else {
+				yyv288 := &x.Items
+				yym289 := z.DecBinary()
+				_ = yym289
+				if false {
+				} else {
+					h.decSliceClusterRoleBinding((*[]ClusterRoleBinding)(yyv288), d)
+				}
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys283)
+		} // end switch yys283
+	} // end for yyj283
+	z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+}
+
+func (x *ClusterRoleBindingList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj290 int
+	var yyb290 bool
+	var yyhl290 bool = l >= 0
+	yyj290++
+	if yyhl290 {
+		yyb290 = yyj290 > l
+	} else {
+		yyb290 = r.CheckBreak()
+	}
+	if yyb290 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Kind = ""
+	} else {
+		x.Kind = string(r.DecodeString())
+	}
+	yyj290++
+	if yyhl290 {
+		yyb290 = yyj290 > l
+	} else {
+		yyb290 = r.CheckBreak()
+	}
+	if yyb290 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.APIVersion = ""
+	} else {
+		x.APIVersion = string(r.DecodeString())
+	}
+	yyj290++
+	if yyhl290 {
+		yyb290 = yyj290 > l
+	} else {
+		yyb290 = r.CheckBreak()
+	}
+	if yyb290 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.ListMeta = pkg1_unversioned.ListMeta{}
+	} else {
+		yyv293 := &x.ListMeta
+		yym294 := z.DecBinary()
+		_ = yym294
+		if false {
+		} else if z.HasExtensions() && z.DecExt(yyv293) {
+		} else {
+			z.DecFallback(yyv293, false)
+		}
+	}
+	yyj290++
+	if yyhl290 {
+		yyb290 = yyj290 > l
+	} else {
+		yyb290 = r.CheckBreak()
+	}
+	if yyb290 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Items = nil
+	} else {
+		yyv295 := &x.Items
+		yym296 := z.DecBinary()
+		_ = yym296
+		if false {
+		} else {
+			h.decSliceClusterRoleBinding((*[]ClusterRoleBinding)(yyv295), d)
+		}
+	}
+	for {
+		yyj290++
+		if yyhl290 {
+			yyb290 = yyj290 > l
+		} else {
+			yyb290 = r.CheckBreak()
+		}
+		if yyb290 {
+			break
+		}
+		z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+		z.DecStructFieldNotFound(yyj290-1, "")
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x *ClusterRoleList) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yym297 := z.EncBinary()
+		_ = yym297
+		if false {
+		} else if z.HasExtensions() && z.EncExt(x) {
+		} else {
+			yysep298 := !z.EncBinary()
+			yy2arr298 := z.EncBasicHandle().StructToArray
+			var yyq298 [4]bool
+			_, _, _ = yysep298, yyq298, yy2arr298
+			const yyr298 bool = false
+			yyq298[0] = x.Kind != ""
+			yyq298[1] = x.APIVersion != ""
+			yyq298[2] = true
+			var yynn298 int
+			if yyr298 || yy2arr298 {
+				r.EncodeArrayStart(4)
+			} else {
+				yynn298 = 1
+				for _, b := range yyq298 {
+					if b {
+						yynn298++
+					}
+				}
+				r.EncodeMapStart(yynn298)
+				yynn298 = 0
+			}
+			if yyr298 || yy2arr298 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq298[0] {
+					yym300 := z.EncBinary()
+					_ = yym300
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq298[0] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("kind"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym301 := z.EncBinary()
+					_ = yym301
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.Kind))
+					}
+				}
+			}
+			if yyr298 || yy2arr298 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq298[1] {
+					yym303 := z.EncBinary()
+					_ = yym303
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				} else {
+					r.EncodeString(codecSelferC_UTF81234, "")
+				}
+			} else {
+				if yyq298[1] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("apiVersion"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yym304 := z.EncBinary()
+					_ = yym304
+					if false {
+					} else {
+						r.EncodeString(codecSelferC_UTF81234, string(x.APIVersion))
+					}
+				}
+			}
+			if yyr298 || yy2arr298 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq298[2] {
+					yy306 := &x.ListMeta
+					yym307 := z.EncBinary()
+					_ = yym307
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy306) {
+					} else {
+						z.EncFallback(yy306)
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq298[2] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("metadata"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					yy308 := &x.ListMeta
+					yym309 := z.EncBinary()
+					_ = yym309
+					if false {
+					} else if z.HasExtensions() && z.EncExt(yy308) {
+					} else {
+						z.EncFallback(yy308)
+					}
+				}
+			}
+			if yyr298 || yy2arr298 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if x.Items == nil {
+					r.EncodeNil()
+				} else {
+					yym311 := z.EncBinary()
+					_ = yym311
+					if false {
+					} else {
+						h.encSliceClusterRole(([]ClusterRole)(x.Items), e)
+					}
+				}
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapKey1234)
+				r.EncodeString(codecSelferC_UTF81234, string("items"))
+				z.EncSendContainerState(codecSelfer_containerMapValue1234)
+				if x.Items == nil {
+					r.EncodeNil()
+				} else {
+					yym312 := z.EncBinary()
+					_ = yym312
+					if false {
+					} else {
+						h.encSliceClusterRole(([]ClusterRole)(x.Items), e)
+					}
+				}
+			}
+			if yyr298 || yy2arr298 {
+				z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				z.EncSendContainerState(codecSelfer_containerMapEnd1234)
+			}
+		}
+	}
+}
+
+func (x *ClusterRoleList) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	yym313 := z.DecBinary()
+	_ = yym313
+	if false {
+	} else if z.HasExtensions() && z.DecExt(x) {
+	} else {
+		yyct314 := r.ContainerType()
+		if yyct314 == codecSelferValueTypeMap1234 {
+			yyl314 := r.ReadMapStart()
+			if yyl314 == 0 {
+				z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+			} else {
+				x.codecDecodeSelfFromMap(yyl314, d)
+			}
+		} else if yyct314 == codecSelferValueTypeArray1234 {
+			yyl314 := r.ReadArrayStart()
+			if yyl314 == 0 {
+				z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+			} else {
+				x.codecDecodeSelfFromArray(yyl314, d)
+			}
+		} else {
+			panic(codecSelferOnlyMapOrArrayEncodeToStructErr1234)
+		}
+	}
+}
+
+func (x *ClusterRoleList) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yys315Slc = z.DecScratchBuffer() // default slice to decode into
+	_ = yys315Slc
+	var yyhl315 bool = l >= 0
+	for yyj315 := 0; ; yyj315++ {
+		if yyhl315 {
+			if yyj315 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		z.DecSendContainerState(codecSelfer_containerMapKey1234)
+		yys315Slc = r.DecodeBytes(yys315Slc, true, true)
+		yys315 := string(yys315Slc)
+		z.DecSendContainerState(codecSelfer_containerMapValue1234)
+		switch yys315 {
+		case "kind":
+			if r.TryDecodeAsNil() {
+				x.Kind = ""
+			} else {
+				x.Kind = string(r.DecodeString())
+			}
+		case "apiVersion":
+			if r.TryDecodeAsNil() {
+				x.APIVersion = ""
+			} else {
+				x.APIVersion = string(r.DecodeString())
+			}
+		case "metadata":
+			if r.TryDecodeAsNil() {
+				x.ListMeta = pkg1_unversioned.ListMeta{}
+			} else {
+				yyv318 := &x.ListMeta
+				yym319 := z.DecBinary()
+				_ = yym319
+				if false {
+				} else if z.HasExtensions() && z.DecExt(yyv318) {
+				} else {
+					z.DecFallback(yyv318, false)
+				}
+			}
+		case "items":
+			if r.TryDecodeAsNil() {
+				x.Items = nil
+			} else {
+				yyv320 := &x.Items
+				yym321 := z.DecBinary()
+				_ = yym321
+				if false {
+				} else {
+					h.decSliceClusterRole((*[]ClusterRole)(yyv320), d)
+				}
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys315)
+		} // end switch yys315
+	} // end for yyj315
+	z.DecSendContainerState(codecSelfer_containerMapEnd1234)
+}
+
+func (x *ClusterRoleList) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj322 int
+	var yyb322 bool
+	var yyhl322 bool = l >= 0
+	yyj322++
+	if yyhl322 {
+		yyb322 = yyj322 > l
+	} else {
+		yyb322 = r.CheckBreak()
+	}
+	if yyb322 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Kind = ""
+	} else {
+		x.Kind = string(r.DecodeString())
+	}
+	yyj322++
+	if yyhl322 {
+		yyb322 = yyj322 > l
+	} else {
+		yyb322 = r.CheckBreak()
+	}
+	if yyb322 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.APIVersion = ""
+	} else {
+		x.APIVersion = string(r.DecodeString())
+	}
+	yyj322++
+	if yyhl322 {
+		yyb322 = yyj322 > l
+	} else {
+		yyb322 = r.CheckBreak()
+	}
+	if yyb322 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.ListMeta = pkg1_unversioned.ListMeta{}
+	} else {
+		yyv325 := &x.ListMeta
+		yym326 := z.DecBinary()
+		_ = yym326
+		if false {
+		} else if z.HasExtensions() && z.DecExt(yyv325) {
+		} else {
+			z.DecFallback(yyv325, false)
+		}
+	}
+	yyj322++
+	if yyhl322 {
+		yyb322 = yyj322 > l
+	} else {
+		yyb322 = r.CheckBreak()
+	}
+	if yyb322 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Items = nil
+	} else {
+		yyv327 := &x.Items
+		yym328 := z.DecBinary()
+		_ = yym328
+		if false {
+		} else {
+			h.decSliceClusterRole((*[]ClusterRole)(yyv327), d)
+		}
+	}
+	for {
+		yyj322++
+		if yyhl322 {
+			yyb322 = yyj322 > l
+		} else {
+			yyb322 = r.CheckBreak()
+		}
+		if yyb322 {
+			break
+		}
+		z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+		z.DecStructFieldNotFound(yyj322-1, "")
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x codecSelfer1234) encSlicePolicyRule(v []PolicyRule, e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.EncodeArrayStart(len(v))
+	for _, yyv329 := range v {
+		z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+		yy330 := &yyv329
+		yy330.CodecEncodeSelf(e)
+	}
+	z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x codecSelfer1234) decSlicePolicyRule(v *[]PolicyRule, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv331 := *v
+	yyh331, yyl331 := z.DecSliceHelperStart()
+	var yyc331 bool
+	if yyl331 == 0 {
+		if yyv331 == nil {
+			yyv331 = []PolicyRule{}
+			yyc331 = true
+		} else if len(yyv331) != 0 {
+			yyv331 = yyv331[:0]
+			yyc331 = true
+		}
+	} else if yyl331 > 0 {
+		var yyrr331, yyrl331 int
+		var yyrt331 bool
+		if yyl331 > cap(yyv331) {
+
+			yyrg331 := len(yyv331) > 0
+			yyv2331 := yyv331
+			yyrl331, yyrt331 = z.DecInferLen(yyl331, z.DecBasicHandle().MaxInitLen, 120)
+			if yyrt331 {
+				if yyrl331 <= cap(yyv331) {
+					yyv331 = yyv331[:yyrl331]
+				} else {
+					yyv331 = make([]PolicyRule, yyrl331)
+				}
+			} else {
+				yyv331 = make([]PolicyRule, yyrl331)
+			}
+			yyc331 = true
+			yyrr331 = len(yyv331)
+			if yyrg331 {
+				copy(yyv331, yyv2331)
+			}
+		} else if yyl331 != len(yyv331) {
+			yyv331 = yyv331[:yyl331]
+			yyc331 = true
+		}
+		yyj331 := 0
+		for ; yyj331 < yyrr331; yyj331++ {
+			yyh331.ElemContainerState(yyj331)
+			if r.TryDecodeAsNil() {
+				yyv331[yyj331] = PolicyRule{}
+			} else {
+				yyv332 := &yyv331[yyj331]
+				yyv332.CodecDecodeSelf(d)
+			}
+
+		}
+		if yyrt331 {
+			for ; yyj331 < yyl331; yyj331++ {
+				yyv331 = append(yyv331, PolicyRule{})
+				yyh331.ElemContainerState(yyj331)
+				if r.TryDecodeAsNil() {
+					yyv331[yyj331] = PolicyRule{}
+				} else {
+					yyv333 := &yyv331[yyj331]
+					yyv333.CodecDecodeSelf(d)
+				}
+
+			}
+		}
+
+	} else {
+		yyj331 := 0
+		for ; !r.CheckBreak(); yyj331++ {
+
+			if yyj331 >= len(yyv331) {
+				yyv331 = append(yyv331, PolicyRule{}) // var yyz331 PolicyRule
+				yyc331 = true
+			}
+			yyh331.ElemContainerState(yyj331)
+			if yyj331 < len(yyv331) {
+				if r.TryDecodeAsNil() {
+					yyv331[yyj331] = PolicyRule{}
+				} else {
+					yyv334 := &yyv331[yyj331]
+					yyv334.CodecDecodeSelf(d)
+				}
+
+			} else {
+				z.DecSwallow()
+			}
+
+		}
+		if yyj331 < len(yyv331) {
+			yyv331 = yyv331[:yyj331]
+			yyc331 = true
+		} else if yyj331 == 0 && yyv331 == nil {
+			yyv331 = []PolicyRule{}
+			yyc331 = true
+		}
+	}
+	yyh331.End()
+	if yyc331 {
+		*v = yyv331
+	}
+}
+
+func (x codecSelfer1234) encSliceSubject(v []Subject, e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.EncodeArrayStart(len(v))
+	for _, yyv335 := range v {
+		z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+		yy336 := &yyv335
+		yy336.CodecEncodeSelf(e)
+	}
+	z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x codecSelfer1234) decSliceSubject(v *[]Subject, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv337 := *v
+	yyh337, yyl337 := z.DecSliceHelperStart()
+	var yyc337 bool
+	if yyl337 == 0 {
+		if yyv337 == nil {
+			yyv337 = []Subject{}
+			yyc337 = true
+		} else if len(yyv337) != 0 {
+			yyv337 = yyv337[:0]
+			yyc337 = true
+		}
+	} else if yyl337 > 0 {
+		var yyrr337, yyrl337 int
+		var yyrt337 bool
+		if yyl337 > cap(yyv337) {
+
+			yyrg337 := len(yyv337) > 0
+			yyv2337 := yyv337
+			yyrl337, yyrt337 = z.DecInferLen(yyl337, z.DecBasicHandle().MaxInitLen, 48)
+			if yyrt337 {
+				if yyrl337 <= cap(yyv337) {
+					yyv337 = yyv337[:yyrl337]
+				} else {
+					yyv337 = make([]Subject, yyrl337)
+				}
+			} else {
+				yyv337 = make([]Subject, yyrl337)
+			}
+			yyc337 = true
+			yyrr337 = len(yyv337)
+			if yyrg337 {
+				copy(yyv337, yyv2337)
+			}
+		} else if yyl337 != len(yyv337) {
+			yyv337 = yyv337[:yyl337]
+			yyc337 = true
+		}
+		yyj337 := 0
+		for ; yyj337 < yyrr337; yyj337++ {
+			yyh337.ElemContainerState(yyj337)
+			if r.TryDecodeAsNil() {
+				yyv337[yyj337] = Subject{}
+			} else {
+				yyv338 := &yyv337[yyj337]
+				yyv338.CodecDecodeSelf(d)
+			}
+
+		}
+		if yyrt337 {
+			for ; yyj337 < yyl337; yyj337++ {
+				yyv337 = append(yyv337, Subject{})
+				yyh337.ElemContainerState(yyj337)
+				if r.TryDecodeAsNil() {
+					yyv337[yyj337] = Subject{}
+				} else {
+					yyv339 := &yyv337[yyj337]
+					yyv339.CodecDecodeSelf(d)
+				}
+
+			}
+		}
+
+	} else {
+		yyj337 := 0
+		for ; !r.CheckBreak(); yyj337++ {
+
+			if yyj337 >= len(yyv337) {
+				yyv337 = append(yyv337, Subject{}) // var yyz337 Subject
+				yyc337 = true
+			}
+			yyh337.ElemContainerState(yyj337)
+			if yyj337 < len(yyv337) {
+				if r.TryDecodeAsNil() {
+					yyv337[yyj337] = Subject{}
+				} else {
+					yyv340 := &yyv337[yyj337]
+					yyv340.CodecDecodeSelf(d)
+				}
+
+			} else {
+				z.DecSwallow()
+			}
+
+		}
+		if yyj337 < len(yyv337) {
+			yyv337 = yyv337[:yyj337]
+			yyc337 = true
+		} else if yyj337 == 0 && yyv337 == nil {
+			yyv337 = []Subject{}
+			yyc337 = true
+		}
+	}
+	yyh337.End()
+	if yyc337 {
+		*v = yyv337
+	}
+}
+
+func (x codecSelfer1234) encSliceRoleBinding(v []RoleBinding, e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.EncodeArrayStart(len(v))
+	for _, yyv341 := range v {
+		z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+		yy342 := &yyv341
+		yy342.CodecEncodeSelf(e)
+	}
+	z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x codecSelfer1234) decSliceRoleBinding(v *[]RoleBinding, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv343 := *v
+	yyh343, yyl343 := z.DecSliceHelperStart()
+	var yyc343 bool
+	if yyl343 == 0 {
+		if yyv343 == nil {
+			yyv343 = []RoleBinding{}
+			yyc343 = true
+		} else if len(yyv343) != 0 {
+			yyv343 = yyv343[:0]
+			yyc343 = true
+		}
+	} else if yyl343 > 0 {
+		var yyrr343, yyrl343 int
+		var yyrt343 bool
+		if yyl343 > cap(yyv343) {
+
+			yyrg343 := len(yyv343) > 0
+			yyv2343 := yyv343
+			yyrl343, yyrt343 = z.DecInferLen(yyl343, z.DecBasicHandle().MaxInitLen, 248)
+			if yyrt343 {
+				if yyrl343 <= cap(yyv343) {
+					yyv343 = yyv343[:yyrl343]
+				} else {
+					yyv343 = make([]RoleBinding, yyrl343)
+				}
+			} else {
+				yyv343 = make([]RoleBinding, yyrl343)
+			}
+			yyc343 = true
+			yyrr343 = len(yyv343)
+			if yyrg343 {
+				copy(yyv343, yyv2343)
+			}
+		} else if yyl343 != len(yyv343) {
+			yyv343 = yyv343[:yyl343]
+			yyc343 = true
+		}
+		yyj343 := 0
+		for ; yyj343 < yyrr343; yyj343++ {
+			yyh343.ElemContainerState(yyj343)
+			if r.TryDecodeAsNil() {
+				yyv343[yyj343] = RoleBinding{}
+			} else {
+				yyv344 := &yyv343[yyj343]
+				yyv344.CodecDecodeSelf(d)
+			}
+
+		}
+		if yyrt343 {
+			for ; yyj343 < yyl343; yyj343++ {
+				yyv343 = append(yyv343, RoleBinding{})
+				yyh343.ElemContainerState(yyj343)
+				if r.TryDecodeAsNil() {
+					yyv343[yyj343] = RoleBinding{}
+				} else {
+					yyv345 := &yyv343[yyj343]
+					yyv345.CodecDecodeSelf(d)
+				}
+
+			}
+		}
+
+	} else {
+		yyj343 := 0
+		for ; !r.CheckBreak(); yyj343++ {
+
+			if yyj343 >= len(yyv343) {
+				yyv343 = append(yyv343, RoleBinding{}) // var yyz343 RoleBinding
+				yyc343 = true
+			}
+			yyh343.ElemContainerState(yyj343)
+			if yyj343 < len(yyv343) {
+				if r.TryDecodeAsNil() {
+					yyv343[yyj343] = RoleBinding{}
+				} else {
+					yyv346 := &yyv343[yyj343]
+					yyv346.CodecDecodeSelf(d)
+				}
+
+			} else {
+				z.DecSwallow()
+			}
+
+		}
+		if yyj343 < len(yyv343) {
+			yyv343 = yyv343[:yyj343]
+			yyc343 = true
+		} else if yyj343 == 0 && yyv343 == nil {
+			yyv343 = []RoleBinding{}
+			yyc343 = true
+		}
+	}
+	yyh343.End()
+	if yyc343 {
+		*v = yyv343
+	}
+}
+
+func (x codecSelfer1234) encSliceRole(v []Role, e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.EncodeArrayStart(len(v))
+	for _, yyv347 := range v {
+		z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+		yy348 := &yyv347
+		yy348.CodecEncodeSelf(e)
+	}
+	z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x codecSelfer1234) decSliceRole(v *[]Role, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv349 := *v
+	yyh349, yyl349 := z.DecSliceHelperStart()
+	var yyc349 bool
+	if yyl349 == 0 {
+		if yyv349 == nil {
+			yyv349 = []Role{}
+			yyc349 = true
+		} else if len(yyv349) != 0 {
+			yyv349 = yyv349[:0]
+			yyc349 = true
+		}
+	} else if yyl349 > 0 {
+		var yyrr349, yyrl349 int
+		var yyrt349 bool
+		if yyl349 > cap(yyv349) {
+
+			yyrg349 := len(yyv349) > 0
+			yyv2349 := yyv349
+			yyrl349, yyrt349 = z.DecInferLen(yyl349, z.DecBasicHandle().MaxInitLen, 216)
+			if yyrt349 {
+				if yyrl349 <= cap(yyv349) {
+					yyv349 = yyv349[:yyrl349]
+				} else {
+					yyv349 = make([]Role, yyrl349)
+				}
+			} else {
+				yyv349 = make([]Role, yyrl349)
+			}
+			yyc349 = true
+			yyrr349 = len(yyv349)
+			if yyrg349 {
+				copy(yyv349, yyv2349)
+			}
+		} else if yyl349 != len(yyv349) {
+			yyv349 = yyv349[:yyl349]
+			yyc349 = true
+		}
+		yyj349 := 0
+		for ; yyj349 < yyrr349; yyj349++ {
+			yyh349.ElemContainerState(yyj349)
+			if r.TryDecodeAsNil() {
+				yyv349[yyj349] = Role{}
+			} else {
+				yyv350 := &yyv349[yyj349]
+				yyv350.CodecDecodeSelf(d)
+			}
+
+		}
+		if yyrt349 {
+			for ; yyj349 < yyl349; yyj349++ {
+				yyv349 = append(yyv349, Role{})
+				yyh349.ElemContainerState(yyj349)
+				if r.TryDecodeAsNil() {
+					yyv349[yyj349] = Role{}
+				} else {
+					yyv351 := &yyv349[yyj349]
+					yyv351.CodecDecodeSelf(d)
+				}
+
+			}
+		}
+
+	} else {
+		yyj349 := 0
+		for ; !r.CheckBreak(); yyj349++ {
+
+			if yyj349 >= len(yyv349) {
+				yyv349 = append(yyv349, Role{}) // var yyz349 Role
+				yyc349 = true
+			}
+			yyh349.ElemContainerState(yyj349)
+			if yyj349 < len(yyv349) {
+				if r.TryDecodeAsNil() {
+					yyv349[yyj349] = Role{}
+				} else {
+					yyv352 := &yyv349[yyj349]
+					yyv352.CodecDecodeSelf(d)
+				}
+
+			} else {
+				z.DecSwallow()
+			}
+
+		}
+		if yyj349 < len(yyv349) {
+			yyv349 = yyv349[:yyj349]
+			yyc349 = true
+		} else if yyj349 == 0 && yyv349 == nil {
+			yyv349 = []Role{}
+			yyc349 = true
+		}
+	}
+	yyh349.End()
+	if yyc349 {
+		*v = yyv349
+	}
+}
+
+func (x codecSelfer1234) encSliceClusterRoleBinding(v []ClusterRoleBinding, e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.EncodeArrayStart(len(v))
+	for _, yyv353 := range v {
+		z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+		yy354 := &yyv353
+		yy354.CodecEncodeSelf(e)
+	}
+	z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x codecSelfer1234) decSliceClusterRoleBinding(v *[]ClusterRoleBinding, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv355 := *v
+	yyh355, yyl355 := z.DecSliceHelperStart()
+	var yyc355 bool
+	if yyl355 == 0 {
+		if yyv355 == nil {
+			yyv355 = []ClusterRoleBinding{}
+			yyc355 = true
+		} else if len(yyv355) != 0 {
+			yyv355 = yyv355[:0]
+			yyc355 = true
+		}
+	} else if yyl355 > 0 {
+		var yyrr355, yyrl355 int
+		var yyrt355 bool
+		if yyl355 > cap(yyv355) {
+
+			yyrg355 := len(yyv355) > 0
+			yyv2355 := yyv355
+			yyrl355, yyrt355 = z.DecInferLen(yyl355, z.DecBasicHandle().MaxInitLen, 248)
+			if yyrt355 {
+				if yyrl355 <= cap(yyv355) {
+					yyv355 = yyv355[:yyrl355]
+				} else {
+					yyv355 = make([]ClusterRoleBinding, yyrl355)
+				}
+			} else {
+				yyv355 = make([]ClusterRoleBinding, yyrl355)
+			}
+			yyc355 = true
+			yyrr355 = len(yyv355)
+			if yyrg355 {
+				copy(yyv355, yyv2355)
+			}
+		} else if yyl355 != len(yyv355) {
+			yyv355 = yyv355[:yyl355]
+			yyc355 = true
+		}
+		yyj355 := 0
+		for ; yyj355 < yyrr355; yyj355++ {
+			yyh355.ElemContainerState(yyj355)
+			if r.TryDecodeAsNil() {
+				yyv355[yyj355] = ClusterRoleBinding{}
+			} else {
+				yyv356 := &yyv355[yyj355]
+				yyv356.CodecDecodeSelf(d)
+			}
+
+		}
+		if yyrt355 {
+			for ; yyj355 < yyl355; yyj355++ {
+				yyv355 = append(yyv355, ClusterRoleBinding{})
+				yyh355.ElemContainerState(yyj355)
+				if r.TryDecodeAsNil() {
+					yyv355[yyj355] = ClusterRoleBinding{}
+				} else {
+					yyv357 := &yyv355[yyj355]
+					yyv357.CodecDecodeSelf(d)
+				}
+
+			}
+		}
+
+	} else {
+		yyj355 := 0
+		for ; !r.CheckBreak(); yyj355++ {
+
+			if yyj355 >= len(yyv355) {
+				yyv355 = append(yyv355, ClusterRoleBinding{}) // var yyz355 ClusterRoleBinding
+				yyc355 = true
+			}
+			yyh355.ElemContainerState(yyj355)
+			if yyj355 < len(yyv355) {
+				if r.TryDecodeAsNil() {
+					yyv355[yyj355] = ClusterRoleBinding{}
+				} else {
+					yyv358 := &yyv355[yyj355]
+					yyv358.CodecDecodeSelf(d)
+				}
+
+			} else {
+				z.DecSwallow()
+			}
+
+		}
+		if yyj355 < len(yyv355) {
+			yyv355 = yyv355[:yyj355]
+			yyc355 = true
+		} else if yyj355 == 0 && yyv355 == nil {
+			yyv355 = []ClusterRoleBinding{}
+			yyc355 = true
+		}
+	}
+	yyh355.End()
+	if yyc355 {
+		*v = yyv355
+	}
+}
+
+func (x codecSelfer1234) encSliceClusterRole(v []ClusterRole, e *codec1978.Encoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.EncodeArrayStart(len(v))
+	for _, yyv359 := range v {
+		z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+		yy360 := &yyv359
+		yy360.CodecEncodeSelf(e)
+	}
+	z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
+}
+
+func (x codecSelfer1234) decSliceClusterRole(v *[]ClusterRole, d *codec1978.Decoder) {
+	var h codecSelfer1234
+	z, r := codec1978.GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv361 := *v
+	yyh361, yyl361 := z.DecSliceHelperStart()
+	var yyc361 bool
+	if yyl361 == 0 {
+		if yyv361 == nil {
+			yyv361 = []ClusterRole{}
+			yyc361 = true
+		} else if len(yyv361) != 0 {
+			yyv361 = yyv361[:0]
+			yyc361 = true
+		}
+	} else if yyl361 > 0 {
+		var yyrr361, yyrl361 int
+		var yyrt361 bool
+		if yyl361 > cap(yyv361) {
+
+			yyrg361 := len(yyv361) > 0
+			yyv2361 := yyv361
+			yyrl361, yyrt361 = z.DecInferLen(yyl361, z.DecBasicHandle().MaxInitLen, 216)
+			if yyrt361 {
+				if yyrl361 <= cap(yyv361) {
+					yyv361 = yyv361[:yyrl361]
+				} else {
+					yyv361 = make([]ClusterRole, yyrl361)
+				}
+			} else {
+				yyv361 = make([]ClusterRole, yyrl361)
+			}
+			yyc361 = true
+			yyrr361 = len(yyv361)
+			if yyrg361 {
+				copy(yyv361, yyv2361)
+			}
+		} else if yyl361 != len(yyv361) {
+			yyv361 = yyv361[:yyl361]
+			yyc361 = true
+		}
+		yyj361 := 0
+		for ; yyj361 < yyrr361; yyj361++ {
+			yyh361.ElemContainerState(yyj361)
+			if r.TryDecodeAsNil() {
+				yyv361[yyj361] = ClusterRole{}
+			} else {
+				yyv362 := &yyv361[yyj361]
+				yyv362.CodecDecodeSelf(d)
+			}
+
+		}
+		if yyrt361 {
+			for ; yyj361 < yyl361; yyj361++ {
+				yyv361 = append(yyv361, ClusterRole{})
+				yyh361.ElemContainerState(yyj361)
+				if r.TryDecodeAsNil() {
+					yyv361[yyj361] = ClusterRole{}
+				} else {
+					yyv363 := &yyv361[yyj361]
+					yyv363.CodecDecodeSelf(d)
+				}
+
+			}
+		}
+
+	} else {
+		yyj361 := 0
+		for ; !r.CheckBreak(); yyj361++ {
+
+			if yyj361 >= len(yyv361) {
+				yyv361 = append(yyv361, ClusterRole{}) // var yyz361 ClusterRole
+				yyc361 = true
+			}
+			yyh361.ElemContainerState(yyj361)
+			if yyj361 < len(yyv361) {
+				if r.TryDecodeAsNil() {
+					yyv361[yyj361] = ClusterRole{}
+				} else {
+					yyv364 := &yyv361[yyj361]
+					yyv364.CodecDecodeSelf(d)
+				}
+
+			} else {
+				z.DecSwallow()
+			}
+
+		}
+		if yyj361 < len(yyv361) {
+			yyv361 = yyv361[:yyj361]
+			yyc361 = true
+		} else if yyj361 == 0 && yyv361 == nil {
+			yyv361 = []ClusterRole{}
+			yyc361 = true
+		}
+	}
+	yyh361.End()
+	if yyc361 {
+		*v = yyv361
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/api/v1"
+)
+
+// Authorization is calculated against
+// 1. evaluation of ClusterRoleBindings - short circuit on match
+// 2. evaluation of RoleBindings in the namespace requested - short circuit on match
+// 3. deny by default
+
+const (
+	APIGroupAll    = "*"
+	ResourceAll    = "*"
+	VerbAll        = "*"
+	NonResourceAll = "*"
+
+	GroupKind          = "Group"
+	ServiceAccountKind = "ServiceAccount"
+	UserKind           = "User"
+
+	RoleKind        = "Role"
+	ClusterRoleKind = "ClusterRole"
+)
+
+// PolicyRule holds information that describes a policy rule, but does not contain information
+// about who the rule applies to or which namespace the rule applies to.
+type PolicyRule struct {
+	// Verbs is a list of Verbs that apply to ALL the Resources contained in this rule.  VerbAll represents all verbs.
+	Verbs []string `json:"verbs"`
+	// APIGroups is the name of the APIGroup that contains the resources.  If multiple API groups are specified, any action
+	// requested against one of the enumerated resources in any API group will be allowed.
+	APIGroups []string `json:"apiGroups,omitempty"`
+	// Resources is a list of resources this rule applies to.  ResourceAll represents all resources.
+	// Subresources are matched with "resource/subresource", for instance "pods/log".
+	Resources []string `json:"resources,omitempty"`
+	// ResourceNames is an optional white list of names that the rule applies to.  An empty set means that everything is allowed.
+	ResourceNames []string `json:"resourceNames,omitempty"`
+	// NonResourceURLs is a set of partial urls that a user should have access to.  *s are allowed, but only as the full,
+	// final step in the path.  Rules can either apply to API resources or to non-resource URLs, but not both.
+	NonResourceURLs []string `json:"nonResourceURLs,omitempty"`
+}
+
+// Subject contains a reference to the object or user identities a role binding applies to.  This can either hold a direct API object reference,
+// or a value for non-objects such as user and group names.
+type Subject struct {
+	// Kind of object being referenced. Values defined by this API group are "User", "Group", and "ServiceAccount".
+	Kind string `json:"kind"`
+	// Name of the object being referenced.
+	Name string `json:"name"`
+	// Namespace of the referenced object.  Only used for "ServiceAccount" subjects.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// RoleRef contains information that points to the role being used.
+type RoleRef struct {
+	// Kind is the type of role being referenced, either "Role" or "ClusterRole".
+	Kind string `json:"kind"`
+	// Name is the name of the role being referenced.
+	Name string `json:"name"`
+}
+
+// Role is a namespaced, logical grouping of PolicyRules that can be referenced as a unit by a RoleBinding.
+type Role struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	v1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Rules holds all the PolicyRules for this Role
+	Rules []PolicyRule `json:"rules"`
+}
+
+// RoleBinding references a role, but does not contain it.  It can reference a Role in the same namespace or a ClusterRole.
+// It adds who information via Subjects and namespace information by which namespace it exists in.  RoleBindings in a given
+// namespace only have effect in that namespace.
+type RoleBinding struct {
+	unversioned.TypeMeta `json:",inline"`
+	v1.ObjectMeta        `json:"metadata,omitempty"`
+
+	// Subjects holds references to the objects the role applies to.
+	Subjects []Subject `json:"subjects"`
+
+	// RoleRef can reference a Role in the current namespace or a ClusterRole.
+	RoleRef RoleRef `json:"roleRef"`
+}
+
+// RoleBindingList is a collection of RoleBindings
+type RoleBindingList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of roleBindings
+	Items []RoleBinding `json:"items"`
+}
+
+// RoleList is a collection of Roles
+type RoleList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of roles
+	Items []Role `json:"items"`
+}
+
+// ClusterRole is a cluster level, logical grouping of PolicyRules that can be referenced as a unit by a RoleBinding or ClusterRoleBinding.
+type ClusterRole struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	v1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Rules holds all the PolicyRules for this ClusterRole
+	Rules []PolicyRule `json:"rules"`
+}
+
+// ClusterRoleBinding references a ClusterRole, but does not contain it.  It adds who information via Subjects.
+// ClusterRoleBindings grant their permissions in every namespace and on cluster scoped resources.
+type ClusterRoleBinding struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	v1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Subjects holds references to the objects the role applies to.
+	Subjects []Subject `json:"subjects"`
+
+	// RoleRef can only reference a ClusterRole.
+	RoleRef RoleRef `json:"roleRef"`
+}
+
+// ClusterRoleBindingList is a collection of ClusterRoleBindings
+type ClusterRoleBindingList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of ClusterRoleBindings
+	Items []ClusterRoleBinding `json:"items"`
+}
+
+// ClusterRoleList is a collection of ClusterRoles
+type ClusterRoleList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of ClusterRoles
+	Items []ClusterRole `json:"items"`
+}
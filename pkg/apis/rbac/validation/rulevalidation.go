@@ -0,0 +1,244 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/rbac"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+)
+
+// AuthorizationRuleResolver resolves the set of policy rules that apply to the user carried in a context.
+type AuthorizationRuleResolver interface {
+	// GetRoleReferenceRules attempts to resolve the role reference of a RoleBinding or ClusterRoleBinding.  The passed namespace should be the namespace
+	// of the role binding, or the empty string for a cluster role binding.
+	GetRoleReferenceRules(ctx api.Context, roleRef rbac.RoleRef, namespace string) ([]rbac.PolicyRule, error)
+
+	// GetEffectivePolicyRules returns the list of rules that apply to the user in the context, within the namespace of the context.
+	// If an error is returned, the slice of PolicyRules may not be complete, but it contains all retrievable rules.  This is done because
+	// policy rules are purely additive and policy determinations can be made on the basis of those rules that are found.
+	GetEffectivePolicyRules(ctx api.Context) ([]rbac.PolicyRule, error)
+}
+
+// ConfirmNoEscalation determines if the rules held by the user in the context, in the namespace of the context, encompass the provided rules.
+func ConfirmNoEscalation(ctx api.Context, ruleResolver AuthorizationRuleResolver, rules []rbac.PolicyRule) error {
+	ownerRules, err := ruleResolver.GetEffectivePolicyRules(ctx)
+	if err != nil {
+		// As per the AuthorizationRuleResolver contract, this may return a non fatal error with an incomplete list of rules.
+		glog.V(1).Infof("non-fatal error getting rules while checking for escalation: %v", err)
+	}
+	if covers, missingRules := Covers(ownerRules, rules); !covers {
+		return fmt.Errorf("attempt to grant extra privileges: %v", missingRules)
+	}
+	return nil
+}
+
+type RoleGetter interface {
+	GetRole(ctx api.Context, name string) (*rbac.Role, error)
+}
+
+type RoleBindingLister interface {
+	ListRoleBindings(ctx api.Context, options *unversioned.ListOptions) (*rbac.RoleBindingList, error)
+}
+
+type ClusterRoleGetter interface {
+	GetClusterRole(ctx api.Context, name string) (*rbac.ClusterRole, error)
+}
+
+type ClusterRoleBindingLister interface {
+	ListClusterRoleBindings(ctx api.Context, options *unversioned.ListOptions) (*rbac.ClusterRoleBindingList, error)
+}
+
+// DefaultRuleResolver resolves rules by walking the bindings that apply to a user and the roles they reference.
+type DefaultRuleResolver struct {
+	roleGetter               RoleGetter
+	roleBindingLister        RoleBindingLister
+	clusterRoleGetter        ClusterRoleGetter
+	clusterRoleBindingLister ClusterRoleBindingLister
+}
+
+func NewDefaultRuleResolver(roleGetter RoleGetter, roleBindingLister RoleBindingLister, clusterRoleGetter ClusterRoleGetter, clusterRoleBindingLister ClusterRoleBindingLister) *DefaultRuleResolver {
+	return &DefaultRuleResolver{roleGetter, roleBindingLister, clusterRoleGetter, clusterRoleBindingLister}
+}
+
+func (r *DefaultRuleResolver) GetRoleReferenceRules(ctx api.Context, roleRef rbac.RoleRef, bindingNamespace string) ([]rbac.PolicyRule, error) {
+	switch roleRef.Kind {
+	case rbac.RoleKind:
+		role, err := r.roleGetter.GetRole(api.WithNamespace(ctx, bindingNamespace), roleRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		return role.Rules, nil
+	case rbac.ClusterRoleKind:
+		clusterRole, err := r.clusterRoleGetter.GetClusterRole(api.WithNamespace(ctx, ""), roleRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		return clusterRole.Rules, nil
+	default:
+		return nil, fmt.Errorf("unsupported role reference kind: %q", roleRef.Kind)
+	}
+}
+
+func (r *DefaultRuleResolver) GetEffectivePolicyRules(ctx api.Context) ([]rbac.PolicyRule, error) {
+	user, ok := api.UserFrom(ctx)
+	if !ok {
+		return nil, errors.New("no user on context")
+	}
+
+	policyRules := []rbac.PolicyRule{}
+	errorlist := []error{}
+
+	clusterRoleBindings, err := r.clusterRoleBindingLister.ListClusterRoleBindings(api.WithNamespace(ctx, ""), &unversioned.ListOptions{})
+	if err != nil {
+		errorlist = append(errorlist, err)
+	} else {
+		for _, clusterRoleBinding := range clusterRoleBindings.Items {
+			if !appliesTo(user, clusterRoleBinding.Subjects, "") {
+				continue
+			}
+			rules, err := r.GetRoleReferenceRules(ctx, clusterRoleBinding.RoleRef, "")
+			if err != nil {
+				errorlist = append(errorlist, err)
+				continue
+			}
+			policyRules = append(policyRules, rules...)
+		}
+	}
+
+	if namespace, ok := api.NamespaceFrom(ctx); ok && len(namespace) > 0 {
+		roleBindings, err := r.roleBindingLister.ListRoleBindings(ctx, &unversioned.ListOptions{})
+		if err != nil {
+			errorlist = append(errorlist, err)
+		} else {
+			for _, roleBinding := range roleBindings.Items {
+				if !appliesTo(user, roleBinding.Subjects, namespace) {
+					continue
+				}
+				rules, err := r.GetRoleReferenceRules(ctx, roleBinding.RoleRef, namespace)
+				if err != nil {
+					errorlist = append(errorlist, err)
+					continue
+				}
+				policyRules = append(policyRules, rules...)
+			}
+		}
+	}
+
+	return policyRules, utilerrors.NewAggregate(errorlist)
+}
+
+// appliesTo returns whether any of the subjects of a binding in the given namespace refer to the user.
+func appliesTo(user user.Info, bindingSubjects []rbac.Subject, bindingNamespace string) bool {
+	for _, subject := range bindingSubjects {
+		if appliesToUser(user, subject, bindingNamespace) {
+			return true
+		}
+	}
+	return false
+}
+
+func appliesToUser(user user.Info, subject rbac.Subject, bindingNamespace string) bool {
+	switch subject.Kind {
+	case rbac.UserKind:
+		return subject.Name == user.GetName()
+	case rbac.GroupKind:
+		for _, group := range user.GetGroups() {
+			if group == subject.Name {
+				return true
+			}
+		}
+		return false
+	case rbac.ServiceAccountKind:
+		namespace := subject.Namespace
+		if len(namespace) == 0 {
+			namespace = bindingNamespace
+		}
+		return serviceaccount.MakeUsername(namespace, subject.Name) == user.GetName()
+	default:
+		return false
+	}
+}
+
+// Covers determines whether or not the ownerRules cover the servantRules in terms of allowed actions.
+// It returns whether or not the ownerRules cover and a list of the rules that the ownerRules do not cover.
+func Covers(ownerRules, servantRules []rbac.PolicyRule) (bool, []rbac.PolicyRule) {
+	uncoveredRules := []rbac.PolicyRule{}
+	for _, servantRule := range servantRules {
+		for _, subrule := range breakdownRule(servantRule) {
+			covered := false
+			for _, ownerRule := range ownerRules {
+				if ruleCovers(ownerRule, subrule) {
+					covered = true
+					break
+				}
+			}
+			if !covered {
+				uncoveredRules = append(uncoveredRules, subrule)
+			}
+		}
+	}
+	return len(uncoveredRules) == 0, uncoveredRules
+}
+
+// breakdownRule splits a rule into rules that each apply to a single verb, API group, resource and
+// resource name, or to a single verb and non-resource URL.
+func breakdownRule(rule rbac.PolicyRule) []rbac.PolicyRule {
+	subrules := []rbac.PolicyRule{}
+	for _, verb := range rule.Verbs {
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				if len(rule.ResourceNames) == 0 {
+					subrules = append(subrules, rbac.PolicyRule{Verbs: []string{verb}, APIGroups: []string{group}, Resources: []string{resource}})
+					continue
+				}
+				for _, name := range rule.ResourceNames {
+					subrules = append(subrules, rbac.PolicyRule{Verbs: []string{verb}, APIGroups: []string{group}, Resources: []string{resource}, ResourceNames: []string{name}})
+				}
+			}
+		}
+		for _, url := range rule.NonResourceURLs {
+			subrules = append(subrules, rbac.PolicyRule{Verbs: []string{verb}, NonResourceURLs: []string{url}})
+		}
+	}
+	return subrules
+}
+
+// ruleCovers determines whether the ownerRule allows everything the subrule, as produced by breakdownRule, allows.
+func ruleCovers(ownerRule, subrule rbac.PolicyRule) bool {
+	if !rbac.VerbMatches(ownerRule, subrule.Verbs[0]) {
+		return false
+	}
+	if len(subrule.NonResourceURLs) > 0 {
+		return rbac.NonResourceURLMatches(ownerRule, subrule.NonResourceURLs[0])
+	}
+	if !rbac.APIGroupMatches(ownerRule, subrule.APIGroups[0]) || !rbac.ResourceMatches(ownerRule, subrule.Resources[0]) {
+		return false
+	}
+	if len(subrule.ResourceNames) == 0 {
+		return len(ownerRule.ResourceNames) == 0
+	}
+	return rbac.ResourceNameMatches(ownerRule, subrule.ResourceNames[0])
+}
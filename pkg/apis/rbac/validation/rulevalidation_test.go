@@ -0,0 +1,255 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/rbac"
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+// staticRoles is an in-memory store of roles and bindings for testing rule resolution.
+type staticRoles struct {
+	roles               []rbac.Role
+	roleBindings        []rbac.RoleBinding
+	clusterRoles        []rbac.ClusterRole
+	clusterRoleBindings []rbac.ClusterRoleBinding
+}
+
+func (r *staticRoles) GetRole(ctx api.Context, name string) (*rbac.Role, error) {
+	namespace := api.NamespaceValue(ctx)
+	for i := range r.roles {
+		if r.roles[i].Namespace == namespace && r.roles[i].Name == name {
+			return &r.roles[i], nil
+		}
+	}
+	return nil, errors.NewNotFound("Role", name)
+}
+
+func (r *staticRoles) GetClusterRole(ctx api.Context, name string) (*rbac.ClusterRole, error) {
+	for i := range r.clusterRoles {
+		if r.clusterRoles[i].Name == name {
+			return &r.clusterRoles[i], nil
+		}
+	}
+	return nil, errors.NewNotFound("ClusterRole", name)
+}
+
+func (r *staticRoles) ListRoleBindings(ctx api.Context, options *unversioned.ListOptions) (*rbac.RoleBindingList, error) {
+	namespace := api.NamespaceValue(ctx)
+	list := &rbac.RoleBindingList{}
+	for _, binding := range r.roleBindings {
+		if binding.Namespace == namespace {
+			list.Items = append(list.Items, binding)
+		}
+	}
+	return list, nil
+}
+
+func (r *staticRoles) ListClusterRoleBindings(ctx api.Context, options *unversioned.ListOptions) (*rbac.ClusterRoleBindingList, error) {
+	return &rbac.ClusterRoleBindingList{Items: r.clusterRoleBindings}, nil
+}
+
+func newStaticRoleResolver() *DefaultRuleResolver {
+	r := &staticRoles{
+		roles: []rbac.Role{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "pod-reader", Namespace: "ns1"},
+				Rules:      []rbac.PolicyRule{{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+			},
+		},
+		roleBindings: []rbac.RoleBinding{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: "ns1"},
+				Subjects:   []rbac.Subject{{Kind: rbac.UserKind, Name: "bob"}, {Kind: rbac.ServiceAccountKind, Name: "builder"}},
+				RoleRef:    rbac.RoleRef{Kind: rbac.RoleKind, Name: "pod-reader"},
+			},
+			{
+				ObjectMeta: api.ObjectMeta{Name: "write-configmaps", Namespace: "ns1"},
+				Subjects:   []rbac.Subject{{Kind: rbac.GroupKind, Name: "editors"}},
+				RoleRef:    rbac.RoleRef{Kind: rbac.ClusterRoleKind, Name: "configmap-writer"},
+			},
+			{
+				ObjectMeta: api.ObjectMeta{Name: "missing-role", Namespace: "ns1"},
+				Subjects:   []rbac.Subject{{Kind: rbac.UserKind, Name: "bob"}},
+				RoleRef:    rbac.RoleRef{Kind: rbac.RoleKind, Name: "does-not-exist"},
+			},
+		},
+		clusterRoles: []rbac.ClusterRole{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "configmap-writer"},
+				Rules:      []rbac.PolicyRule{{Verbs: []string{"create", "update"}, APIGroups: []string{""}, Resources: []string{"configmaps"}}},
+			},
+			{
+				ObjectMeta: api.ObjectMeta{Name: "version-reader"},
+				Rules:      []rbac.PolicyRule{{Verbs: []string{"get"}, NonResourceURLs: []string{"/version"}}},
+			},
+		},
+		clusterRoleBindings: []rbac.ClusterRoleBinding{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "read-version"},
+				Subjects:   []rbac.Subject{{Kind: rbac.GroupKind, Name: "system:authenticated"}},
+				RoleRef:    rbac.RoleRef{Kind: rbac.ClusterRoleKind, Name: "version-reader"},
+			},
+		},
+	}
+	return NewDefaultRuleResolver(r, r, r, r)
+}
+
+func TestGetEffectivePolicyRules(t *testing.T) {
+	resolver := newStaticRoleResolver()
+	readPods := rbac.PolicyRule{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods"}}
+	writeConfigMaps := rbac.PolicyRule{Verbs: []string{"create", "update"}, APIGroups: []string{""}, Resources: []string{"configmaps"}}
+	readVersion := rbac.PolicyRule{Verbs: []string{"get"}, NonResourceURLs: []string{"/version"}}
+
+	testCases := map[string]struct {
+		user          user.Info
+		namespace     string
+		expectedRules []rbac.PolicyRule
+		expectedError bool
+	}{
+		"user in namespace": {
+			user:          &user.DefaultInfo{Name: "bob", Groups: []string{"system:authenticated"}},
+			namespace:     "ns1",
+			expectedRules: []rbac.PolicyRule{readVersion, readPods},
+			expectedError: true,
+		},
+		"user outside of namespace": {
+			user:          &user.DefaultInfo{Name: "bob", Groups: []string{"system:authenticated"}},
+			namespace:     "ns2",
+			expectedRules: []rbac.PolicyRule{readVersion},
+		},
+		"group": {
+			user:          &user.DefaultInfo{Name: "alice", Groups: []string{"editors"}},
+			namespace:     "ns1",
+			expectedRules: []rbac.PolicyRule{writeConfigMaps},
+		},
+		"service account defaulting to the binding namespace": {
+			user:          &user.DefaultInfo{Name: "system:serviceaccount:ns1:builder"},
+			namespace:     "ns1",
+			expectedRules: []rbac.PolicyRule{readPods},
+		},
+		"service account of another namespace": {
+			user:          &user.DefaultInfo{Name: "system:serviceaccount:ns2:builder"},
+			namespace:     "ns1",
+			expectedRules: []rbac.PolicyRule{},
+		},
+	}
+	for k, tc := range testCases {
+		ctx := api.WithNamespace(api.WithUser(api.NewContext(), tc.user), tc.namespace)
+		rules, err := resolver.GetEffectivePolicyRules(ctx)
+		if (err != nil) != tc.expectedError {
+			t.Errorf("%s: unexpected error state: %v", k, err)
+		}
+		if !reflect.DeepEqual(rules, tc.expectedRules) {
+			t.Errorf("%s: expected rules %v, got %v", k, tc.expectedRules, rules)
+		}
+	}
+}
+
+func TestCovers(t *testing.T) {
+	testCases := map[string]struct {
+		owner          []rbac.PolicyRule
+		servant        []rbac.PolicyRule
+		expectedCovers bool
+		expectedMissed []rbac.PolicyRule
+	}{
+		"exact match": {
+			owner:          []rbac.PolicyRule{{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+			servant:        []rbac.PolicyRule{{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+			expectedCovers: true,
+		},
+		"wildcards cover everything": {
+			owner: []rbac.PolicyRule{
+				{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}},
+				{Verbs: []string{"*"}, NonResourceURLs: []string{"*"}},
+			},
+			servant: []rbac.PolicyRule{
+				{Verbs: []string{"delete"}, APIGroups: []string{"extensions"}, Resources: []string{"deployments", "pods/log"}, ResourceNames: []string{"foo"}},
+				{Verbs: []string{"get"}, NonResourceURLs: []string{"/healthz/*"}},
+			},
+			expectedCovers: true,
+		},
+		"split across owner rules": {
+			owner: []rbac.PolicyRule{
+				{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}},
+				{Verbs: []string{"list"}, APIGroups: []string{""}, Resources: []string{"pods"}},
+			},
+			servant:        []rbac.PolicyRule{{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+			expectedCovers: true,
+		},
+		"missing verb": {
+			owner:          []rbac.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+			servant:        []rbac.PolicyRule{{Verbs: []string{"get", "delete"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+			expectedCovers: false,
+			expectedMissed: []rbac.PolicyRule{{Verbs: []string{"delete"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+		},
+		"resource does not cover subresource": {
+			owner:          []rbac.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+			servant:        []rbac.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods/log"}}},
+			expectedCovers: false,
+			expectedMissed: []rbac.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods/log"}}},
+		},
+		"named owner does not cover unnamed servant": {
+			owner:          []rbac.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"foo"}}},
+			servant:        []rbac.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}}},
+			expectedCovers: false,
+			expectedMissed: []rbac.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}}},
+		},
+		"specific owner does not cover wildcard servant": {
+			owner:          []rbac.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+			servant:        []rbac.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"*"}}},
+			expectedCovers: false,
+			expectedMissed: []rbac.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"*"}}},
+		},
+		"non-resource url prefix": {
+			owner:          []rbac.PolicyRule{{Verbs: []string{"get"}, NonResourceURLs: []string{"/healthz/*"}}},
+			servant:        []rbac.PolicyRule{{Verbs: []string{"get"}, NonResourceURLs: []string{"/healthz/ping", "/version"}}},
+			expectedCovers: false,
+			expectedMissed: []rbac.PolicyRule{{Verbs: []string{"get"}, NonResourceURLs: []string{"/version"}}},
+		},
+	}
+	for k, tc := range testCases {
+		covers, missed := Covers(tc.owner, tc.servant)
+		if covers != tc.expectedCovers {
+			t.Errorf("%s: expected covers %v, got %v", k, tc.expectedCovers, covers)
+		}
+		if len(missed) == 0 && len(tc.expectedMissed) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(missed, tc.expectedMissed) {
+			t.Errorf("%s: expected missed rules %v, got %v", k, tc.expectedMissed, missed)
+		}
+	}
+}
+
+func TestConfirmNoEscalation(t *testing.T) {
+	resolver := newStaticRoleResolver()
+	ctx := api.WithNamespace(api.WithUser(api.NewContext(), &user.DefaultInfo{Name: "bob"}), "ns1")
+
+	if err := ConfirmNoEscalation(ctx, resolver, []rbac.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}}); err != nil {
+		t.Errorf("unexpected error granting held rules: %v", err)
+	}
+	if err := ConfirmNoEscalation(ctx, resolver, []rbac.PolicyRule{{Verbs: []string{"delete"}, APIGroups: []string{""}, Resources: []string{"pods"}}}); err == nil {
+		t.Errorf("expected an error granting rules the user does not hold")
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	apivalidation "k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/apis/rbac"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/util/validation"
+)
+
+// ValidateRBACName validates that the given name can be used as the name of a role or binding.
+// Role names commonly carry a "system:" style prefix, so any valid path segment is accepted.
+func ValidateRBACName(name string, prefix bool) (bool, string) {
+	return apivalidation.ValidatePathSegmentName(name, prefix)
+}
+
+var validSubjectKinds = sets.NewString(rbac.UserKind, rbac.GroupKind, rbac.ServiceAccountKind)
+
+func ValidateRole(role *rbac.Role) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&role.ObjectMeta, true, ValidateRBACName).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(role.Rules, false).Prefix("rules")...)
+	return allErrs
+}
+
+func ValidateRoleUpdate(role *rbac.Role, oldRole *rbac.Role) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&role.ObjectMeta, &oldRole.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateRole(role)...)
+	return allErrs
+}
+
+func ValidateClusterRole(role *rbac.ClusterRole) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&role.ObjectMeta, false, ValidateRBACName).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(role.Rules, true).Prefix("rules")...)
+	return allErrs
+}
+
+func ValidateClusterRoleUpdate(role *rbac.ClusterRole, oldRole *rbac.ClusterRole) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&role.ObjectMeta, &oldRole.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateClusterRole(role)...)
+	return allErrs
+}
+
+func ValidateRoleBinding(binding *rbac.RoleBinding) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&binding.ObjectMeta, true, ValidateRBACName).Prefix("metadata")...)
+	allErrs = append(allErrs, validateRoleRef(binding.RoleRef, sets.NewString(rbac.RoleKind, rbac.ClusterRoleKind)).Prefix("roleRef")...)
+	allErrs = append(allErrs, validateSubjects(binding.Subjects, false).Prefix("subjects")...)
+	return allErrs
+}
+
+func ValidateRoleBindingUpdate(binding *rbac.RoleBinding, oldBinding *rbac.RoleBinding) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&binding.ObjectMeta, &oldBinding.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateRoleBinding(binding)...)
+	if binding.RoleRef != oldBinding.RoleRef {
+		allErrs = append(allErrs, validation.NewInvalidError("roleRef", binding.RoleRef, "cannot change roleRef"))
+	}
+	return allErrs
+}
+
+func ValidateClusterRoleBinding(binding *rbac.ClusterRoleBinding) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&binding.ObjectMeta, false, ValidateRBACName).Prefix("metadata")...)
+	allErrs = append(allErrs, validateRoleRef(binding.RoleRef, sets.NewString(rbac.ClusterRoleKind)).Prefix("roleRef")...)
+	allErrs = append(allErrs, validateSubjects(binding.Subjects, true).Prefix("subjects")...)
+	return allErrs
+}
+
+func ValidateClusterRoleBindingUpdate(binding *rbac.ClusterRoleBinding, oldBinding *rbac.ClusterRoleBinding) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&binding.ObjectMeta, &oldBinding.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateClusterRoleBinding(binding)...)
+	if binding.RoleRef != oldBinding.RoleRef {
+		allErrs = append(allErrs, validation.NewInvalidError("roleRef", binding.RoleRef, "cannot change roleRef"))
+	}
+	return allErrs
+}
+
+// validatePolicyRules checks that every rule names at least one verb and applies either to
+// API resources or, for cluster roles only, to non-resource URLs.
+func validatePolicyRules(rules []rbac.PolicyRule, allowNonResourceURLs bool) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	for i, rule := range rules {
+		ruleErrs := validation.ErrorList{}
+		if len(rule.Verbs) == 0 {
+			ruleErrs = append(ruleErrs, validation.NewRequiredError("verbs"))
+		}
+		if len(rule.NonResourceURLs) > 0 {
+			if !allowNonResourceURLs {
+				ruleErrs = append(ruleErrs, validation.NewInvalidError("nonResourceURLs", rule.NonResourceURLs, "only allowed in cluster roles"))
+			}
+			if len(rule.APIGroups) > 0 || len(rule.Resources) > 0 || len(rule.ResourceNames) > 0 {
+				ruleErrs = append(ruleErrs, validation.NewInvalidError("nonResourceURLs", rule.NonResourceURLs, "rules cannot apply to both regular resources and non-resource URLs"))
+			}
+			allErrs = append(allErrs, ruleErrs.PrefixIndex(i)...)
+			continue
+		}
+		if len(rule.APIGroups) == 0 {
+			ruleErrs = append(ruleErrs, validation.NewRequiredError("apiGroups"))
+		}
+		if len(rule.Resources) == 0 {
+			ruleErrs = append(ruleErrs, validation.NewRequiredError("resources"))
+		}
+		allErrs = append(allErrs, ruleErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
+func validateRoleRef(roleRef rbac.RoleRef, validKinds sets.String) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	if !validKinds.Has(roleRef.Kind) {
+		allErrs = append(allErrs, validation.NewNotSupportedError("kind", roleRef.Kind, validKinds.List()))
+	}
+	if len(roleRef.Name) == 0 {
+		allErrs = append(allErrs, validation.NewRequiredError("name"))
+	} else if ok, msg := ValidateRBACName(roleRef.Name, false); !ok {
+		allErrs = append(allErrs, validation.NewInvalidError("name", roleRef.Name, msg))
+	}
+	return allErrs
+}
+
+// validateSubjects checks the subjects of a binding.  Service accounts in a cluster role binding
+// must name their namespace; in a role binding they default to the namespace of the binding.
+func validateSubjects(subjects []rbac.Subject, requireServiceAccountNamespace bool) validation.ErrorList {
+	allErrs := validation.ErrorList{}
+	for i, subject := range subjects {
+		subjectErrs := validation.ErrorList{}
+		if len(subject.Name) == 0 {
+			subjectErrs = append(subjectErrs, validation.NewRequiredError("name"))
+		}
+		switch subject.Kind {
+		case rbac.ServiceAccountKind:
+			if len(subject.Name) > 0 {
+				if ok, msg := apivalidation.ValidateServiceAccountName(subject.Name, false); !ok {
+					subjectErrs = append(subjectErrs, validation.NewInvalidError("name", subject.Name, msg))
+				}
+			}
+			if len(subject.Namespace) == 0 {
+				if requireServiceAccountNamespace {
+					subjectErrs = append(subjectErrs, validation.NewRequiredError("namespace"))
+				}
+			} else if ok, msg := apivalidation.ValidateNamespaceName(subject.Namespace, false); !ok {
+				subjectErrs = append(subjectErrs, validation.NewInvalidError("namespace", subject.Namespace, msg))
+			}
+		case rbac.UserKind, rbac.GroupKind:
+			if len(subject.Namespace) > 0 {
+				subjectErrs = append(subjectErrs, validation.NewInvalidError("namespace", subject.Namespace, fmt.Sprintf("must be empty for %s subjects", subject.Kind)))
+			}
+		default:
+			subjectErrs = append(subjectErrs, validation.NewNotSupportedError("kind", subject.Kind, validSubjectKinds.List()))
+		}
+		allErrs = append(allErrs, subjectErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/rbac"
+)
+
+func TestValidateRole(t *testing.T) {
+	successCases := []rbac.Role{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "pod-reader", Namespace: api.NamespaceDefault},
+			Rules: []rbac.PolicyRule{
+				{Verbs: []string{"get", "list", "watch"}, APIGroups: []string{""}, Resources: []string{"pods", "pods/log"}},
+			},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "system:config-reader", Namespace: api.NamespaceDefault},
+			Rules: []rbac.PolicyRule{
+				{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"configmaps"}, ResourceNames: []string{"settings"}},
+			},
+		},
+	}
+	for _, successCase := range successCases {
+		if errs := ValidateRole(&successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	errorCases := map[string]struct {
+		role  rbac.Role
+		field string
+	}{
+		"missing namespace": {
+			role: rbac.Role{
+				ObjectMeta: api.ObjectMeta{Name: "pod-reader"},
+			},
+			field: "metadata.namespace",
+		},
+		"missing verbs": {
+			role: rbac.Role{
+				ObjectMeta: api.ObjectMeta{Name: "pod-reader", Namespace: api.NamespaceDefault},
+				Rules:      []rbac.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}}},
+			},
+			field: "rules[0].verbs",
+		},
+		"missing resources": {
+			role: rbac.Role{
+				ObjectMeta: api.ObjectMeta{Name: "pod-reader", Namespace: api.NamespaceDefault},
+				Rules:      []rbac.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}}},
+			},
+			field: "rules[0].resources",
+		},
+		"non-resource urls in a role": {
+			role: rbac.Role{
+				ObjectMeta: api.ObjectMeta{Name: "version-reader", Namespace: api.NamespaceDefault},
+				Rules:      []rbac.PolicyRule{{Verbs: []string{"get"}, NonResourceURLs: []string{"/version"}}},
+			},
+			field: "rules[0].nonResourceURLs",
+		},
+	}
+	for k, v := range errorCases {
+		errs := ValidateRole(&v.role)
+		if len(errs) == 0 {
+			t.Errorf("%s: expected failure", k)
+			continue
+		}
+		if errs[0].Field != v.field {
+			t.Errorf("%s: expected error on field %q, got %v", k, v.field, errs)
+		}
+	}
+}
+
+func TestValidateClusterRole(t *testing.T) {
+	role := rbac.ClusterRole{
+		ObjectMeta: api.ObjectMeta{Name: "cluster-reader"},
+		Rules: []rbac.PolicyRule{
+			{Verbs: []string{"get", "list", "watch"}, APIGroups: []string{"*"}, Resources: []string{"*"}},
+			{Verbs: []string{"get"}, NonResourceURLs: []string{"/version", "/healthz/*"}},
+		},
+	}
+	if errs := ValidateClusterRole(&role); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	role.Rules = append(role.Rules, rbac.PolicyRule{Verbs: []string{"get"}, Resources: []string{"pods"}, NonResourceURLs: []string{"/version"}})
+	errs := ValidateClusterRole(&role)
+	if len(errs) != 1 || errs[0].Field != "rules[2].nonResourceURLs" {
+		t.Errorf("expected an error for a rule mixing resources and non-resource urls, got %v", errs)
+	}
+}
+
+func TestValidateRoleBinding(t *testing.T) {
+	successCases := []rbac.RoleBinding{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: api.NamespaceDefault},
+			RoleRef:    rbac.RoleRef{Kind: rbac.RoleKind, Name: "pod-reader"},
+			Subjects: []rbac.Subject{
+				{Kind: rbac.UserKind, Name: "bob"},
+				{Kind: rbac.GroupKind, Name: "system:authenticated"},
+				{Kind: rbac.ServiceAccountKind, Name: "default"},
+				{Kind: rbac.ServiceAccountKind, Name: "builder", Namespace: "build"},
+			},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "read-cluster", Namespace: api.NamespaceDefault},
+			RoleRef:    rbac.RoleRef{Kind: rbac.ClusterRoleKind, Name: "cluster-reader"},
+		},
+	}
+	for _, successCase := range successCases {
+		if errs := ValidateRoleBinding(&successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	errorCases := map[string]struct {
+		binding rbac.RoleBinding
+		field   string
+	}{
+		"bad role kind": {
+			binding: rbac.RoleBinding{
+				ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: api.NamespaceDefault},
+				RoleRef:    rbac.RoleRef{Kind: "Pod", Name: "pod-reader"},
+			},
+			field: "roleRef.kind",
+		},
+		"missing role name": {
+			binding: rbac.RoleBinding{
+				ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: api.NamespaceDefault},
+				RoleRef:    rbac.RoleRef{Kind: rbac.RoleKind},
+			},
+			field: "roleRef.name",
+		},
+		"bad subject kind": {
+			binding: rbac.RoleBinding{
+				ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: api.NamespaceDefault},
+				RoleRef:    rbac.RoleRef{Kind: rbac.RoleKind, Name: "pod-reader"},
+				Subjects:   []rbac.Subject{{Kind: "Robot", Name: "bob"}},
+			},
+			field: "subjects[0].kind",
+		},
+		"user with namespace": {
+			binding: rbac.RoleBinding{
+				ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: api.NamespaceDefault},
+				RoleRef:    rbac.RoleRef{Kind: rbac.RoleKind, Name: "pod-reader"},
+				Subjects:   []rbac.Subject{{Kind: rbac.UserKind, Name: "bob", Namespace: "foo"}},
+			},
+			field: "subjects[0].namespace",
+		},
+		"missing subject name": {
+			binding: rbac.RoleBinding{
+				ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: api.NamespaceDefault},
+				RoleRef:    rbac.RoleRef{Kind: rbac.RoleKind, Name: "pod-reader"},
+				Subjects:   []rbac.Subject{{Kind: rbac.GroupKind}},
+			},
+			field: "subjects[0].name",
+		},
+	}
+	for k, v := range errorCases {
+		errs := ValidateRoleBinding(&v.binding)
+		if len(errs) == 0 {
+			t.Errorf("%s: expected failure", k)
+			continue
+		}
+		if errs[0].Field != v.field {
+			t.Errorf("%s: expected error on field %q, got %v", k, v.field, errs)
+		}
+	}
+}
+
+func TestValidateRoleBindingUpdate(t *testing.T) {
+	old := rbac.RoleBinding{
+		ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: api.NamespaceDefault, ResourceVersion: "1"},
+		RoleRef:    rbac.RoleRef{Kind: rbac.RoleKind, Name: "pod-reader"},
+	}
+
+	update := old
+	update.Subjects = []rbac.Subject{{Kind: rbac.UserKind, Name: "bob"}}
+	if errs := ValidateRoleBindingUpdate(&update, &old); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	update.RoleRef.Name = "pod-writer"
+	errs := ValidateRoleBindingUpdate(&update, &old)
+	if len(errs) != 1 || errs[0].Field != "roleRef" {
+		t.Errorf("expected an error on roleRef, got %v", errs)
+	}
+}
+
+func TestValidateClusterRoleBinding(t *testing.T) {
+	binding := rbac.ClusterRoleBinding{
+		ObjectMeta: api.ObjectMeta{Name: "read-cluster"},
+		RoleRef:    rbac.RoleRef{Kind: rbac.ClusterRoleKind, Name: "cluster-reader"},
+		Subjects:   []rbac.Subject{{Kind: rbac.ServiceAccountKind, Name: "default", Namespace: "kube-system"}},
+	}
+	if errs := ValidateClusterRoleBinding(&binding); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	errorCases := map[string]struct {
+		binding rbac.ClusterRoleBinding
+		field   string
+	}{
+		"namespaced role": {
+			binding: rbac.ClusterRoleBinding{
+				ObjectMeta: api.ObjectMeta{Name: "read-cluster"},
+				RoleRef:    rbac.RoleRef{Kind: rbac.RoleKind, Name: "pod-reader"},
+			},
+			field: "roleRef.kind",
+		},
+		"service account without namespace": {
+			binding: rbac.ClusterRoleBinding{
+				ObjectMeta: api.ObjectMeta{Name: "read-cluster"},
+				RoleRef:    rbac.RoleRef{Kind: rbac.ClusterRoleKind, Name: "cluster-reader"},
+				Subjects:   []rbac.Subject{{Kind: rbac.ServiceAccountKind, Name: "default"}},
+			},
+			field: "subjects[0].namespace",
+		},
+	}
+	for k, v := range errorCases {
+		errs := ValidateClusterRoleBinding(&v.binding)
+		if len(errs) == 0 {
+			t.Errorf("%s: expected failure", k)
+			continue
+		}
+		if errs[0].Field != v.field {
+			t.Errorf("%s: expected error on field %q, got %v", k, v.field, errs)
+		}
+	}
+}
@@ -319,6 +319,7 @@ type SimpleRESTStorage struct {
 
 	actualNamespace  string
 	namespacePresent bool
+	dryRun           bool
 
 	// These are set when Watch is called
 	fakeWatch                  *watch.FakeWatcher
@@ -423,6 +424,7 @@ func (storage *SimpleRESTStorage) NewList() runtime.Object {
 func (storage *SimpleRESTStorage) Create(ctx api.Context, obj runtime.Object) (runtime.Object, error) {
 	storage.checkContext(ctx)
 	storage.created = obj.(*apiservertesting.Simple)
+	storage.dryRun = api.IsDryRun(ctx)
 	if err := storage.errors["create"]; err != nil {
 		return nil, err
 	}
@@ -436,6 +438,7 @@ func (storage *SimpleRESTStorage) Create(ctx api.Context, obj runtime.Object) (r
 func (storage *SimpleRESTStorage) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool, error) {
 	storage.checkContext(ctx)
 	storage.updated = obj.(*apiservertesting.Simple)
+	storage.dryRun = api.IsDryRun(ctx)
 	if err := storage.errors["update"]; err != nil {
 		return nil, false, err
 	}
@@ -2535,6 +2538,49 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestCreateDryRun(t *testing.T) {
+	table := []struct {
+		query  string
+		status int
+		dryRun bool
+	}{
+		{"", http.StatusCreated, false},
+		{"?dryRun=false", http.StatusCreated, false},
+		{"?dryRun=true", http.StatusCreated, true},
+		{"?dryRun=maybe", http.StatusBadRequest, false},
+	}
+	for i, item := range table {
+		storage := SimpleRESTStorage{}
+		selfLinker := &setTestSelfLinker{
+			t:           t,
+			name:        "bar",
+			namespace:   "default",
+			expectedSet: "/" + prefix + "/" + testGroupVersion.Group + "/" + testGroupVersion.Version + "/namespaces/default/foo/bar",
+		}
+		handler := handleLinker(map[string]rest.Storage{"foo": &storage}, selfLinker)
+		server := httptest.NewServer(handler)
+		client := http.Client{}
+
+		data, err := codec.Encode(&apiservertesting.Simple{Other: "bar"})
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+		response, err := client.Post(server.URL+"/"+prefix+"/"+testGroupVersion.Group+"/"+testGroupVersion.Version+"/namespaces/default/foo"+item.query, "application/json", bytes.NewBuffer(data))
+		server.Close()
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		response.Body.Close()
+		if response.StatusCode != item.status {
+			t.Errorf("%d: expected status %d, got %d", i, item.status, response.StatusCode)
+		}
+		if storage.dryRun != item.dryRun {
+			t.Errorf("%d: expected dry run %t, got %t", i, item.dryRun, storage.dryRun)
+		}
+	}
+}
+
 func TestCreateInNamespace(t *testing.T) {
 	storage := SimpleRESTStorage{
 		injectedFunction: func(obj runtime.Object) (runtime.Object, error) {
@@ -0,0 +1,214 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records the requests served by the apiserver.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util"
+)
+
+// IDHeader is the response header that carries the ID of the audit events of a request.
+const IDHeader = "Audit-ID"
+
+// Stage tells which part of a request an audit event records.
+type Stage string
+
+const (
+	// StageRequest events are written when a request is received, before it is served.
+	StageRequest Stage = "request"
+	// StageResponse events are written once the response has been written.
+	StageResponse Stage = "response"
+)
+
+// Event is an audit record of a request.  It is written as one line of JSON.  Every request
+// produces a request event describing it and a response event carrying only the response code.
+type Event struct {
+	// ID uniquely identifies the request.  Both events of a request share it.
+	ID string `json:"id"`
+	// Stage tells whether the request was received or answered.
+	Stage Stage `json:"stage"`
+	// Timestamp is the time the request was received or answered.
+	Timestamp time.Time `json:"timestamp"`
+	// SourceIP is the address of the client the request was received from.
+	SourceIP string `json:"sourceIP,omitempty"`
+	// Method and URI are the HTTP method and request URI.
+	Method string `json:"method,omitempty"`
+	URI    string `json:"uri,omitempty"`
+
+	// User and Groups are the authenticated identity of the requester.
+	User   string   `json:"user,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+	// ImpersonatedUser and ImpersonatedGroups are the identity the requester asked to act as, if any.
+	ImpersonatedUser   string   `json:"impersonatedUser,omitempty"`
+	ImpersonatedGroups []string `json:"impersonatedGroups,omitempty"`
+
+	// Verb, APIGroup, Resource, Subresource, Namespace and Name describe the request as seen by
+	// the authorizer.
+	Verb        string `json:"verb,omitempty"`
+	APIGroup    string `json:"apiGroup,omitempty"`
+	Resource    string `json:"resource,omitempty"`
+	Subresource string `json:"subresource,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	Name        string `json:"name,omitempty"`
+
+	// ResponseCode is the HTTP status code returned to the client.  It is only set on
+	// response events.
+	ResponseCode int `json:"responseCode,omitempty"`
+}
+
+// auditResponseWriter records the status code written by the handler.
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (a *auditResponseWriter) WriteHeader(code int) {
+	if a.status == 0 {
+		a.status = code
+	}
+	a.ResponseWriter.WriteHeader(code)
+}
+
+func (a *auditResponseWriter) Write(b []byte) (int, error) {
+	if a.status == 0 {
+		// Default if WriteHeader hasn't been called
+		a.status = http.StatusOK
+	}
+	return a.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher so that streaming responses keep working.
+func (a *auditResponseWriter) Flush() {
+	if flusher, ok := a.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// CloseNotify implements http.CloseNotifier so that watches notice when the client goes away.
+func (a *auditResponseWriter) CloseNotify() <-chan bool {
+	if notifier, ok := a.ResponseWriter.(http.CloseNotifier); ok {
+		return notifier.CloseNotify()
+	}
+	// the wrapped writer cannot tell us, so never report the connection as closed
+	return make(chan bool)
+}
+
+// Hijack implements http.Hijacker so that upgraded connections keep working.
+func (a *auditResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := a.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("unable to hijack the connection: %T does not implement http.Hijacker", a.ResponseWriter)
+	}
+	if a.status == 0 {
+		a.status = http.StatusSwitchingProtocols
+	}
+	return hijacker.Hijack()
+}
+
+// WithAudit decorates handler so that every request it serves is recorded to out, one JSON
+// document per line.  A request event is written before the request is served, so that long
+// running requests such as watches, exec and proxy are recorded while they run, and a response
+// event with the same ID once the response has been written.  The ID is returned to the client
+// in the Audit-ID header.  WithAudit must run after authentication so that the requesting user
+// is known.
+func WithAudit(handler http.Handler, requestContextMapper api.RequestContextMapper, attributeGetter apiserver.RequestAttributeGetter, out io.Writer) http.Handler {
+	var lock sync.Mutex
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		event := newEvent(req, requestContextMapper, attributeGetter)
+		writeEvent(&lock, out, event)
+		w.Header().Set(IDHeader, event.ID)
+		respWriter := &auditResponseWriter{ResponseWriter: w}
+
+		defer func() {
+			response := &Event{ID: event.ID, Stage: StageResponse, Timestamp: time.Now(), ResponseCode: respWriter.status}
+			if r := recover(); r != nil {
+				response.ResponseCode = http.StatusInternalServerError
+				writeEvent(&lock, out, response)
+				panic(r)
+			}
+			if response.ResponseCode == 0 {
+				response.ResponseCode = http.StatusOK
+			}
+			writeEvent(&lock, out, response)
+		}()
+		handler.ServeHTTP(respWriter, req)
+	})
+}
+
+func newEvent(req *http.Request, requestContextMapper api.RequestContextMapper, attributeGetter apiserver.RequestAttributeGetter) *Event {
+	attribs := attributeGetter.GetAttribs(req)
+	event := &Event{
+		ID:                 string(util.NewUUID()),
+		Stage:              StageRequest,
+		Timestamp:          time.Now(),
+		SourceIP:           sourceIP(req),
+		Method:             req.Method,
+		URI:                req.RequestURI,
+		ImpersonatedUser:   req.Header.Get(user.ImpersonateUserHeader),
+		ImpersonatedGroups: req.Header[user.ImpersonateGroupHeader],
+		Verb:               attribs.GetVerb(),
+		APIGroup:           attribs.GetAPIGroup(),
+		Resource:           attribs.GetResource(),
+		Subresource:        attribs.GetSubresource(),
+		Namespace:          attribs.GetNamespace(),
+		Name:               attribs.GetName(),
+	}
+	if ctx, ok := requestContextMapper.Get(req); ok {
+		if requestor, ok := api.UserFrom(ctx); ok {
+			event.User = requestor.GetName()
+			event.Groups = requestor.GetGroups()
+		}
+	}
+	return event
+}
+
+// sourceIP returns the host part of the remote address of req.
+func sourceIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+func writeEvent(lock *sync.Mutex, out io.Writer, event *Event) {
+	line, err := json.Marshal(event)
+	if err != nil {
+		glog.Errorf("Unable to encode audit event %s: %v", event.ID, err)
+		return
+	}
+	line = append(line, '\n')
+
+	lock.Lock()
+	defer lock.Unlock()
+	if _, err := out.Write(line); err != nil {
+		glog.Errorf("Unable to write audit event %s: %v", event.ID, err)
+	}
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/sets"
+)
+
+// fakeRequestContextMapper maps every request to the same context.
+type fakeRequestContextMapper struct {
+	ctx api.Context
+}
+
+func (m *fakeRequestContextMapper) Get(req *http.Request) (api.Context, bool) {
+	return m.ctx, m.ctx != nil
+}
+
+func (*fakeRequestContextMapper) Update(req *http.Request, context api.Context) error {
+	return nil
+}
+
+func newTestAttributeGetter(mapper api.RequestContextMapper) apiserver.RequestAttributeGetter {
+	return apiserver.NewRequestAttributeGetter(mapper, &apiserver.RequestInfoResolver{
+		APIPrefixes:          sets.NewString("api", "apis"),
+		GrouplessAPIPrefixes: sets.NewString("api"),
+	})
+}
+
+func decodeEvents(t *testing.T, buf *bytes.Buffer) []Event {
+	var events []Event
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		if len(line) == 0 {
+			continue
+		}
+		var event Event
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			t.Fatalf("unable to decode audit line %q: %v", line, err)
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestWithAudit(t *testing.T) {
+	mapper := &fakeRequestContextMapper{
+		ctx: api.WithUser(api.NewContext(), &user.DefaultInfo{Name: "alice", Groups: []string{"admins"}}),
+	}
+	buf := &bytes.Buffer{}
+	handler := WithAudit(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "DELETE" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte("{}"))
+	}), mapper, newTestAttributeGetter(mapper), buf)
+
+	req, _ := http.NewRequest("GET", "/api/v1/namespaces/ns1/pods/foo/log", nil)
+	req.RequestURI = "/api/v1/namespaces/ns1/pods/foo/log"
+	req.RemoteAddr = "10.0.0.1:34567"
+	req.Header.Set("Impersonate-User", "bob")
+	req.Header.Add("Impersonate-Group", "developers")
+	req.Header.Add("Impersonate-Group", "qa")
+	getResp := httptest.NewRecorder()
+	handler.ServeHTTP(getResp, req)
+
+	req, _ = http.NewRequest("DELETE", "/apis/extensions/v1beta1/namespaces/ns1/deployments/web", nil)
+	req.RequestURI = "/apis/extensions/v1beta1/namespaces/ns1/deployments/web"
+	req.RemoteAddr = "10.0.0.2:34567"
+	deleteResp := httptest.NewRecorder()
+	handler.ServeHTTP(deleteResp, req)
+
+	events := decodeEvents(t, buf)
+	if len(events) != 4 {
+		t.Fatalf("expected 4 audit events, got %d: %s", len(events), buf.String())
+	}
+	if len(events[0].ID) == 0 || events[0].ID == events[2].ID {
+		t.Errorf("expected unique request IDs, got %q and %q", events[0].ID, events[2].ID)
+	}
+	for i, resp := range []*httptest.ResponseRecorder{getResp, deleteResp} {
+		if id := resp.Header().Get(IDHeader); id != events[2*i].ID {
+			t.Errorf("%d: expected the %s header to be %q, got %q", i, IDHeader, events[2*i].ID, id)
+		}
+	}
+	for i := range events {
+		if events[i].Timestamp.IsZero() {
+			t.Errorf("%d: expected a timestamp", i)
+		}
+		if i%2 == 1 && events[i].ID != events[i-1].ID {
+			t.Errorf("%d: expected the response event to have the request ID %q, got %q", i, events[i-1].ID, events[i].ID)
+		}
+	}
+	for i := range events {
+		events[i].ID = ""
+		events[i].Timestamp = events[0].Timestamp
+	}
+
+	expected := []Event{
+		{
+			Stage:              StageRequest,
+			Timestamp:          events[0].Timestamp,
+			SourceIP:           "10.0.0.1",
+			Method:             "GET",
+			URI:                "/api/v1/namespaces/ns1/pods/foo/log",
+			User:               "alice",
+			Groups:             []string{"admins"},
+			ImpersonatedUser:   "bob",
+			ImpersonatedGroups: []string{"developers", "qa"},
+			Verb:               "get",
+			Resource:           "pods",
+			Subresource:        "log",
+			Namespace:          "ns1",
+			Name:               "foo",
+		},
+		{
+			Stage:        StageResponse,
+			Timestamp:    events[0].Timestamp,
+			ResponseCode: http.StatusOK,
+		},
+		{
+			Stage:     StageRequest,
+			Timestamp: events[0].Timestamp,
+			SourceIP:  "10.0.0.2",
+			Method:    "DELETE",
+			URI:       "/apis/extensions/v1beta1/namespaces/ns1/deployments/web",
+			User:      "alice",
+			Groups:    []string{"admins"},
+			Verb:      "delete",
+			APIGroup:  "extensions",
+			Resource:  "deployments",
+			Namespace: "ns1",
+			Name:      "web",
+		},
+		{
+			Stage:        StageResponse,
+			Timestamp:    events[0].Timestamp,
+			ResponseCode: http.StatusForbidden,
+		},
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(events[i], expected[i]) {
+			t.Errorf("%d: expected audit event %#v, got %#v", i, expected[i], events[i])
+		}
+	}
+}
+
+func TestWithAuditUnauthenticated(t *testing.T) {
+	mapper := &fakeRequestContextMapper{}
+	buf := &bytes.Buffer{}
+	handler := WithAudit(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}), mapper, newTestAttributeGetter(mapper), buf)
+
+	req, _ := http.NewRequest("GET", "/version", nil)
+	req.RequestURI = "/version"
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	events := decodeEvents(t, buf)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 audit events, got %d: %s", len(events), buf.String())
+	}
+	if events[0].User != "" || events[0].URI != "/version" {
+		t.Errorf("unexpected audit request event %#v", events[0])
+	}
+	if events[1].ResponseCode != http.StatusOK {
+		t.Errorf("unexpected audit response event %#v", events[1])
+	}
+}
+
+func TestWithAuditPanic(t *testing.T) {
+	mapper := &fakeRequestContextMapper{}
+	buf := &bytes.Buffer{}
+	handler := WithAudit(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		panic("handler failed")
+	}), mapper, newTestAttributeGetter(mapper), buf)
+
+	req, _ := http.NewRequest("GET", "/api/v1/pods", nil)
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("expected the panic to be propagated")
+			}
+		}()
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}()
+
+	events := decodeEvents(t, buf)
+	if len(events) != 2 || events[1].Stage != StageResponse || events[1].ResponseCode != http.StatusInternalServerError {
+		t.Errorf("expected a response audit event with status 500, got %s", buf.String())
+	}
+}
+
+func TestWithAuditWatch(t *testing.T) {
+	mapper := &fakeRequestContextMapper{
+		ctx: api.WithUser(api.NewContext(), &user.DefaultInfo{Name: "alice"}),
+	}
+	buf := &bytes.Buffer{}
+	// serve like the apiserver's watch handler does: stream events until the client goes away
+	handler := WithAudit(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// the request must be recorded before it is served, not when the watch ends
+		events := decodeEvents(t, buf)
+		if len(events) != 1 || events[0].Stage != StageRequest || events[0].Verb != "watch" {
+			t.Errorf("expected a request audit event for the watch while it is served, got %s", buf.String())
+		} else if id := w.Header().Get(IDHeader); id != events[0].ID {
+			t.Errorf("expected the %s header to be %q, got %q", IDHeader, events[0].ID, id)
+		}
+		cn, ok := w.(http.CloseNotifier)
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		w.Header().Set("Transfer-Encoding", "chunked")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{\"type\":\"ADDED\"}\n"))
+		flusher.Flush()
+		<-cn.CloseNotify()
+	}), mapper, newTestAttributeGetter(mapper), buf)
+
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		defer close(done)
+		handler.ServeHTTP(w, req)
+	}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api/v1/watch/namespaces/ns1/pods")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the watch to be served, got status %d", resp.StatusCode)
+	}
+	if len(resp.Header.Get(IDHeader)) == 0 {
+		t.Errorf("expected the %s header to be returned to the client", IDHeader)
+	}
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	if err != nil || line != "{\"type\":\"ADDED\"}\n" {
+		t.Fatalf("unexpected watch event %q: %v", line, err)
+	}
+	resp.Body.Close()
+
+	select {
+	case <-done:
+	case <-time.After(util.ForeverTestTimeout):
+		t.Fatalf("watch did not notice the client closing the connection")
+	}
+	events := decodeEvents(t, buf)
+	if len(events) != 2 || events[1].Stage != StageResponse || events[1].ID != events[0].ID || events[1].ResponseCode != http.StatusOK {
+		t.Errorf("expected a response audit event for the watch once it ends, got %s", buf.String())
+	}
+}
+
+func TestWithAuditHijackUnsupported(t *testing.T) {
+	mapper := &fakeRequestContextMapper{}
+	buf := &bytes.Buffer{}
+	handler := WithAudit(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if _, _, err := w.(http.Hijacker).Hijack(); err == nil {
+			t.Errorf("expected an error hijacking a writer that does not support it")
+		}
+	}), mapper, newTestAttributeGetter(mapper), buf)
+
+	req, _ := http.NewRequest("GET", "/api/v1/pods", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+}
@@ -18,6 +18,7 @@ package apiserver
 
 import (
 	"crypto/rsa"
+	"time"
 
 	"k8s.io/kubernetes/pkg/auth/authenticator"
 	"k8s.io/kubernetes/pkg/auth/authenticator/bearertoken"
@@ -31,6 +32,7 @@ import (
 	"k8s.io/kubernetes/plugin/pkg/auth/authenticator/request/x509"
 	"k8s.io/kubernetes/plugin/pkg/auth/authenticator/token/oidc"
 	"k8s.io/kubernetes/plugin/pkg/auth/authenticator/token/tokenfile"
+	"k8s.io/kubernetes/plugin/pkg/auth/authenticator/token/webhook"
 )
 
 type AuthenticatorConfig struct {
@@ -45,6 +47,9 @@ type AuthenticatorConfig struct {
 	ServiceAccountLookup  bool
 	Storage               storage.Interface
 	KeystoneURL           string
+
+	WebhookTokenAuthnConfigFile string
+	WebhookTokenAuthnCacheTTL   time.Duration
 }
 
 // NewAuthenticator returns an authenticator.Request or an error
@@ -99,6 +104,14 @@ func NewAuthenticator(config AuthenticatorConfig) (authenticator.Request, error)
 		authenticators = append(authenticators, keystoneAuth)
 	}
 
+	if len(config.WebhookTokenAuthnConfigFile) > 0 {
+		webhookTokenAuth, err := newWebhookTokenAuthenticator(config.WebhookTokenAuthnConfigFile, config.WebhookTokenAuthnCacheTTL)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, webhookTokenAuth)
+	}
+
 	switch len(authenticators) {
 	case 0:
 		return nil, nil
@@ -185,3 +198,13 @@ func newAuthenticatorFromKeystoneURL(keystoneConfigFile string) (authenticator.R
 
 	return basicauth.New(keystoneAuthenticator), nil
 }
+
+// newWebhookTokenAuthenticator returns an authenticator.Request or an error
+func newWebhookTokenAuthenticator(webhookConfigFile string, ttl time.Duration) (authenticator.Request, error) {
+	webhookTokenAuthenticator, err := webhook.New(webhookConfigFile, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	return bearertoken.New(webhookTokenAuthenticator), nil
+}
@@ -42,30 +42,46 @@ func TestNewAlwaysDenyAuthorizer(t *testing.T) {
 // validates that errors are returned only when proper.
 func TestNewAuthorizerFromAuthorizationConfig(t *testing.T) {
 	// Unknown modes should return errors
-	if _, err := NewAuthorizerFromAuthorizationConfig([]string{"DoesNotExist"}, ""); err == nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{"DoesNotExist"}, AuthorizationConfig{}); err == nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig using a fake mode should have returned an error")
 	}
 
 	// ModeAlwaysAllow and ModeAlwaysDeny should return without authorizationPolicyFile
 	// but error if one is given
-	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny}, ""); err != nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny}, AuthorizationConfig{}); err != nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig returned an error: %s", err)
 	}
 
 	// ModeABAC requires a policy file
-	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny, ModeABAC}, ""); err == nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny, ModeABAC}, AuthorizationConfig{}); err == nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig using a fake mode should have returned an error")
 	}
 	// ModeABAC should not error if a valid policy path is provided
-	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny, ModeABAC}, "../auth/authorizer/abac/example_policy_file.jsonl"); err != nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny, ModeABAC}, AuthorizationConfig{PolicyFile: "../auth/authorizer/abac/example_policy_file.jsonl"}); err != nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig errored while using a valid policy file: %s", err)
 	}
 	// Authorization Policy file cannot be used without ModeABAC
-	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny}, "../auth/authorizer/abac/example_policy_file.jsonl"); err == nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny}, AuthorizationConfig{PolicyFile: "../auth/authorizer/abac/example_policy_file.jsonl"}); err == nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig should have errored when Authorization Policy File is used without ModeABAC")
 	}
+	// ModeRBAC requires a rule resolver
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeRBAC}, AuthorizationConfig{}); err == nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig should have errored when ModeRBAC is used without a rule resolver")
+	}
+	// RBAC super user cannot be used without ModeRBAC
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow}, AuthorizationConfig{RBACSuperUser: "admin"}); err == nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig should have errored when RBAC super user is used without ModeRBAC")
+	}
+	// ModeWebhook requires a configuration file
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeWebhook}, AuthorizationConfig{}); err == nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig should have errored when ModeWebhook is used without a configuration file")
+	}
+	// Webhook configuration file cannot be used without ModeWebhook
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow}, AuthorizationConfig{WebhookConfigFile: "webhook.kubeconfig"}); err == nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig should have errored when a webhook configuration file is used without ModeWebhook")
+	}
 	// Atleast one authorizationMode is necessary
-	if _, err := NewAuthorizerFromAuthorizationConfig([]string{}, "../auth/authorizer/abac/example_policy_file.jsonl"); err == nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{}, AuthorizationConfig{PolicyFile: "../auth/authorizer/abac/example_policy_file.jsonl"}); err == nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig should have errored when no authorization modes are passed")
 	}
 }
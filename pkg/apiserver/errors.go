@@ -75,6 +75,12 @@ func notFound(w http.ResponseWriter, req *http.Request) {
 	fmt.Fprintf(w, "Not Found: %#v", req.RequestURI)
 }
 
+// badRequest renders a simple bad request error.
+func badRequest(w http.ResponseWriter, req *http.Request, reason string) {
+	w.WriteHeader(http.StatusBadRequest)
+	fmt.Fprintf(w, "Bad Request: %s", reason)
+}
+
 // badGatewayError renders a simple bad gateway error.
 func badGatewayError(w http.ResponseWriter, req *http.Request) {
 	w.WriteHeader(http.StatusBadGateway)
@@ -32,6 +32,7 @@ import (
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/httplog"
 	"k8s.io/kubernetes/pkg/util/sets"
 )
@@ -366,10 +367,14 @@ func (r *requestAttributeGetter) GetAttribs(req *http.Request) authorizer.Attrib
 
 	attribs.APIGroup = apiRequestInfo.APIGroup
 	attribs.Verb = apiRequestInfo.Verb
+	attribs.ResourceRequest = apiRequestInfo.IsResourceRequest
+	attribs.Path = apiRequestInfo.Path
 
 	// If a path follows the conventions of the REST object store, then
 	// we can extract the resource.  Otherwise, not.
 	attribs.Resource = apiRequestInfo.Resource
+	attribs.Subresource = apiRequestInfo.Subresource
+	attribs.Name = apiRequestInfo.Name
 
 	// If the request specifies a namespace, then the namespace is filled in.
 	// Assumes there is no empty string namespace.  Unspecified results
@@ -391,6 +396,60 @@ func WithAuthorizationCheck(handler http.Handler, getAttribs RequestAttributeGet
 	})
 }
 
+// WithImpersonation replaces the authenticated user of requests carrying an Impersonate-User header with the
+// requested user and groups, provided the authenticated user is authorized to impersonate each of them.
+// It must run after authentication and before authorization so that the rest of the chain sees the new user.
+func WithImpersonation(handler http.Handler, requestContextMapper api.RequestContextMapper, a authorizer.Authorizer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		username := req.Header.Get(user.ImpersonateUserHeader)
+		groups := req.Header[user.ImpersonateGroupHeader]
+		if len(username) == 0 {
+			if len(groups) != 0 {
+				badRequest(w, req, fmt.Sprintf("%s requires %s", user.ImpersonateGroupHeader, user.ImpersonateUserHeader))
+				return
+			}
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		ctx, ok := requestContextMapper.Get(req)
+		if !ok {
+			forbidden(w, req)
+			return
+		}
+		requestor, ok := api.UserFrom(ctx)
+		if !ok {
+			forbidden(w, req)
+			return
+		}
+
+		// the requestor must be allowed to impersonate the user and every group
+		checks := []authorizer.AttributesRecord{{User: requestor, Verb: "impersonate", Resource: "users", Name: username, ResourceRequest: true}}
+		for _, group := range groups {
+			checks = append(checks, authorizer.AttributesRecord{User: requestor, Verb: "impersonate", Resource: "groups", Name: group, ResourceRequest: true})
+		}
+		for _, attribs := range checks {
+			if err := a.Authorize(&attribs); err != nil {
+				glog.V(4).Infof("%s is not allowed to impersonate %s %q: %v", requestor.GetName(), attribs.Resource, attribs.Name, err)
+				forbidden(w, req)
+				return
+			}
+		}
+
+		impersonated := &user.DefaultInfo{Name: username, Groups: groups}
+		if err := requestContextMapper.Update(req, api.WithUser(ctx, impersonated)); err != nil {
+			glog.Errorf("Unable to update the request context with the impersonated user: %v", err)
+			http.Error(w, "Unable to impersonate the requested user", http.StatusInternalServerError)
+			return
+		}
+
+		// the headers have been honored, nothing further down the chain should act on them again
+		req.Header.Del(user.ImpersonateUserHeader)
+		req.Header.Del(user.ImpersonateGroupHeader)
+		handler.ServeHTTP(w, req)
+	})
+}
+
 // RequestInfo holds information parsed from the http.Request
 type RequestInfo struct {
 	// IsResourceRequest indicates whether or not the request is for an API resource or subresource
@@ -17,6 +17,7 @@ limitations under the License.
 package apiserver
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -30,6 +31,8 @@ import (
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/auth/user"
 )
 
 type fakeRL bool
@@ -162,6 +165,120 @@ func TestReadOnly(t *testing.T) {
 	}
 }
 
+func TestImpersonation(t *testing.T) {
+	// alice may impersonate bob and the admins group, nobody else may impersonate anyone
+	impersonators := authorizer.AuthorizerFunc(func(a authorizer.Attributes) error {
+		if a.GetVerb() != "impersonate" || a.GetUserName() != "alice" {
+			return fmt.Errorf("not allowed")
+		}
+		if a.GetResource() == "users" && a.GetName() == "bob" || a.GetResource() == "groups" && a.GetName() == "admins" {
+			return nil
+		}
+		return fmt.Errorf("not allowed")
+	})
+
+	testCases := map[string]struct {
+		requestor      string
+		impersonate    string
+		groups         []string
+		expectedCode   int
+		expectedUser   string
+		expectedGroups []string
+	}{
+		"no impersonation": {
+			requestor:      "alice",
+			expectedCode:   http.StatusOK,
+			expectedUser:   "alice",
+			expectedGroups: []string{"alice-group"},
+		},
+		"impersonate user": {
+			requestor:    "alice",
+			impersonate:  "bob",
+			expectedCode: http.StatusOK,
+			expectedUser: "bob",
+		},
+		"impersonate user and group": {
+			requestor:      "alice",
+			impersonate:    "bob",
+			groups:         []string{"admins"},
+			expectedCode:   http.StatusOK,
+			expectedUser:   "bob",
+			expectedGroups: []string{"admins"},
+		},
+		"disallowed user": {
+			requestor:    "alice",
+			impersonate:  "carol",
+			expectedCode: http.StatusForbidden,
+		},
+		"disallowed group": {
+			requestor:    "alice",
+			impersonate:  "bob",
+			groups:       []string{"admins", "system:masters"},
+			expectedCode: http.StatusForbidden,
+		},
+		"disallowed requestor": {
+			requestor:    "bob",
+			impersonate:  "bob",
+			expectedCode: http.StatusForbidden,
+		},
+		"group without user": {
+			requestor:    "alice",
+			groups:       []string{"admins"},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for k, tc := range testCases {
+		mapper := api.NewRequestContextMapper()
+		var actualUser user.Info
+		var actualHeaders http.Header
+		handler := WithImpersonation(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx, _ := mapper.Get(req)
+			actualUser, _ = api.UserFrom(ctx)
+			actualHeaders = req.Header
+		}), mapper, impersonators)
+		authenticated := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx, _ := mapper.Get(req)
+			mapper.Update(req, api.WithUser(ctx, &user.DefaultInfo{Name: tc.requestor, Groups: []string{tc.requestor + "-group"}}))
+			handler.ServeHTTP(w, req)
+		})
+		contextHandler, err := api.NewRequestContextFilter(mapper, authenticated)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", k, err)
+		}
+
+		req, err := http.NewRequest("GET", "/api/v1/pods", nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", k, err)
+		}
+		if len(tc.impersonate) > 0 {
+			req.Header.Set("Impersonate-User", tc.impersonate)
+		}
+		for _, group := range tc.groups {
+			req.Header.Add("Impersonate-Group", group)
+		}
+		w := httptest.NewRecorder()
+		contextHandler.ServeHTTP(w, req)
+
+		if w.Code != tc.expectedCode {
+			t.Errorf("%s: expected code %d, got %d", k, tc.expectedCode, w.Code)
+			continue
+		}
+		if tc.expectedCode != http.StatusOK {
+			if actualUser != nil {
+				t.Errorf("%s: expected the request to be rejected, but it was served as %v", k, actualUser)
+			}
+			continue
+		}
+		if actualUser.GetName() != tc.expectedUser || !reflect.DeepEqual(actualUser.GetGroups(), tc.expectedGroups) {
+			t.Errorf("%s: expected user %q with groups %v, got %q with groups %v", k, tc.expectedUser, tc.expectedGroups, actualUser.GetName(), actualUser.GetGroups())
+		}
+		if len(actualHeaders.Get("Impersonate-User")) != 0 || len(actualHeaders["Impersonate-Group"]) != 0 {
+			t.Errorf("%s: expected impersonation headers to be removed, got %v", k, actualHeaders)
+		}
+	}
+}
+
 func TestTimeout(t *testing.T) {
 	sendResponse := make(chan struct{}, 1)
 	writeErrors := make(chan error, 1)
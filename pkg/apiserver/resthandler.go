@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"net/url"
 	gpath "path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -352,6 +353,11 @@ func createHandler(r rest.NamedCreater, scope RequestScope, typer runtime.Object
 
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
+		ctx, err = withDryRun(ctx, req.Request)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
 
 		body, err := readBody(req.Request)
 		if err != nil {
@@ -370,7 +376,7 @@ func createHandler(r rest.NamedCreater, scope RequestScope, typer runtime.Object
 		if admit != nil && admit.Handles(admission.Create) {
 			userInfo, _ := api.UserFrom(ctx)
 
-			err = admit.Admit(admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Create, userInfo))
+			err = admit.Admit(admissionAttributes(ctx, admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Create, userInfo)))
 			if err != nil {
 				errorJSON(err, scope.Codec, w)
 				return
@@ -436,12 +442,17 @@ func PatchResource(r rest.Patcher, scope RequestScope, typer runtime.ObjectTyper
 		obj := r.New()
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
+		ctx, err = withDryRun(ctx, req.Request)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
 
 		// PATCH requires same permission as UPDATE
 		if admit.Handles(admission.Update) {
 			userInfo, _ := api.UserFrom(ctx)
 
-			err = admit.Admit(admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Update, userInfo))
+			err = admit.Admit(admissionAttributes(ctx, admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Update, userInfo)))
 			if err != nil {
 				errorJSON(err, scope.Codec, w)
 				return
@@ -586,6 +597,11 @@ func UpdateResource(r rest.Updater, scope RequestScope, typer runtime.ObjectType
 		}
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
+		ctx, err = withDryRun(ctx, req.Request)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
 
 		body, err := readBody(req.Request)
 		if err != nil {
@@ -608,7 +624,7 @@ func UpdateResource(r rest.Updater, scope RequestScope, typer runtime.ObjectType
 		if admit != nil && admit.Handles(admission.Update) {
 			userInfo, _ := api.UserFrom(ctx)
 
-			err = admit.Admit(admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Update, userInfo))
+			err = admit.Admit(admissionAttributes(ctx, admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Update, userInfo)))
 			if err != nil {
 				errorJSON(err, scope.Codec, w)
 				return
@@ -752,6 +768,30 @@ func finishRequest(timeout time.Duration, fn resultFunc) (result runtime.Object,
 	}
 }
 
+// withDryRun marks ctx as a dry run if the request sets the dryRun query parameter.
+func withDryRun(ctx api.Context, req *http.Request) (api.Context, error) {
+	value := req.URL.Query().Get("dryRun")
+	if len(value) == 0 {
+		return ctx, nil
+	}
+	dryRun, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid value for dryRun: %q", value))
+	}
+	if dryRun {
+		ctx = api.WithDryRun(ctx)
+	}
+	return ctx, nil
+}
+
+// admissionAttributes tells admission controllers about a dry run on ctx.
+func admissionAttributes(ctx api.Context, a admission.Attributes) admission.Attributes {
+	if api.IsDryRun(ctx) {
+		return admission.NewDryRunAttributes(a)
+	}
+	return a
+}
+
 // transformDecodeError adds additional information when a decode fails.
 func transformDecodeError(typer runtime.ObjectTyper, baseErr error, into runtime.Object, body []byte) error {
 	_, kind, err := typer.ObjectVersionAndKind(into)
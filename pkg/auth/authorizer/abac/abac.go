@@ -23,9 +23,11 @@ import (
 	"bufio"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 
 	"k8s.io/kubernetes/pkg/auth/authorizer"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
 )
 
 // TODO: make this into a real API object.  Note that when that happens, it
@@ -36,6 +38,9 @@ import (
 // This will allow wildcard matching strings to be used in the future for the
 // body.Namespace, if we want to add that feature, without affecting the
 // meta.Namespace.
+
+// policy is a line of a policy file in the original, unversioned format.  An
+// unset property matches any value of the corresponding attribute.
 type policy struct {
 	User  string `json:"user,omitempty"`
 	Group string `json:"group,omitempty"`
@@ -64,8 +69,14 @@ type policy struct {
 	// certain podTemplates.
 }
 
-type policyList []policy
+// rule is a line of a policy file, in either format.
+type rule interface {
+	matches(a authorizer.Attributes) bool
+}
+
+type policyList []rule
 
+// NewFromFile reads a policy file.  Every invalid line is reported, prefixed by its line number.
 // TODO: Have policies be created via an API call and stored in REST storage.
 func NewFromFile(path string) (policyList, error) {
 	// File format is one map per line.  This allows easy concatentation of files,
@@ -78,25 +89,59 @@ func NewFromFile(path string) (policyList, error) {
 
 	scanner := bufio.NewScanner(file)
 	pl := make(policyList, 0)
+	var errs []error
 
-	for scanner.Scan() {
-		var p policy
-		b := scanner.Bytes()
+	for lineNum := 1; scanner.Scan(); lineNum++ {
 		// TODO: skip comment lines.
-		err = json.Unmarshal(b, &p)
-		if err != nil {
-			// TODO: line number in errors.
-			return nil, err
+		r, lineErrs := parseRule(scanner.Bytes())
+		for _, err := range lineErrs {
+			errs = append(errs, fmt.Errorf("%s:%d: %v", path, lineNum, err))
+		}
+		if len(lineErrs) == 0 {
+			pl = append(pl, r)
 		}
-		pl = append(pl, p)
 	}
 
 	if err := scanner.Err(); err != nil {
 		return nil, err
 	}
+	if len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
 	return pl, nil
 }
 
+// parseRule decodes a line of a policy file.  Lines with an apiVersion are versioned policies,
+// other lines are in the original, unversioned format.
+func parseRule(b []byte) (rule, []error) {
+	var version struct {
+		APIVersion string `json:"apiVersion"`
+	}
+	if err := json.Unmarshal(b, &version); err != nil {
+		return nil, []error{err}
+	}
+
+	switch version.APIVersion {
+	case "":
+		var p policy
+		if err := json.Unmarshal(b, &p); err != nil {
+			return nil, []error{err}
+		}
+		return p, nil
+	case PolicyVersion:
+		var p Policy
+		if err := json.Unmarshal(b, &p); err != nil {
+			return nil, []error{err}
+		}
+		if errs := validatePolicy(&p); len(errs) > 0 {
+			return nil, errs
+		}
+		return p.Spec, nil
+	default:
+		return nil, []error{fmt.Errorf("unsupported apiVersion %q, must be %q or unset", version.APIVersion, PolicyVersion)}
+	}
+}
+
 func (p policy) matches(a authorizer.Attributes) bool {
 	if p.subjectMatches(a) {
 		if p.Readonly == false || (p.Readonly == a.IsReadOnly()) {
@@ -19,10 +19,12 @@ package abac
 import (
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	"k8s.io/kubernetes/pkg/auth/authorizer"
 	"k8s.io/kubernetes/pkg/auth/user"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
 )
 
 func TestEmptyFile(t *testing.T) {
@@ -56,6 +58,48 @@ func TestExampleFile(t *testing.T) {
 	}
 }
 
+// Test the file in the versioned format that we will point users at as an example.
+func TestExampleVersionedFile(t *testing.T) {
+	_, err := NewFromFile("./example_policy_file_v1beta1.jsonl")
+	if err != nil {
+		t.Errorf("unable to read policy file: %v", err)
+	}
+}
+
+func TestMixedFormatFile(t *testing.T) {
+	_, err := newWithContents(t, `{"user":"scheduler",  "readonly": true, "resource": "pods"}
+{"apiVersion": "abac.authorization.kubernetes.io/v1beta1", "kind": "Policy", "spec": {"user": "scheduler", "verbs": ["get"], "nonResourcePath": "/healthz"}}
+`)
+	if err != nil {
+		t.Errorf("unable to read policy file: %v", err)
+	}
+}
+
+func TestInvalidFile(t *testing.T) {
+	_, err := newWithContents(t, `{"user":"scheduler",  "readonly": true, "resource": "pods"}
+{"user":"scheduler",  "readonly": true, "resource": "pods"
+{"apiVersion": "abac.authorization.kubernetes.io/v1", "kind": "Policy", "spec": {"user": "scheduler", "verbs": ["get"], "resource": "pods"}}
+{"apiVersion": "abac.authorization.kubernetes.io/v1beta1", "kind": "Policy", "spec": {"resource": "pods"}}
+{"apiVersion": "abac.authorization.kubernetes.io/v1beta1", "kind": "Policy", "spec": {"user": "*", "verbs": ["get"], "resource": "pods", "nonResourcePath": "/version"}}
+`)
+	if err == nil {
+		t.Fatalf("expected errors reading an invalid policy file")
+	}
+	agg, ok := err.(utilerrors.Aggregate)
+	if !ok {
+		t.Fatalf("expected an aggregate of errors, got %v", err)
+	}
+	expectedLines := []string{":2: ", ":3: ", ":4: ", ":4: ", ":5: "}
+	if len(agg.Errors()) != len(expectedLines) {
+		t.Fatalf("expected %d errors, got %v", len(expectedLines), err)
+	}
+	for i, err := range agg.Errors() {
+		if !strings.Contains(err.Error(), expectedLines[i]) {
+			t.Errorf("expected error %d to be reported on line %q, got %v", i, expectedLines[i], err)
+		}
+	}
+}
+
 func TestNotAuthorized(t *testing.T) {
 	a, err := newWithContents(t, `{                    "readonly": true, "resource": "events"   }
 {"user":"scheduler", "readonly": true, "resource": "pods"     }
@@ -344,3 +388,142 @@ func TestPolicy(t *testing.T) {
 		}
 	}
 }
+
+func TestVersionedPolicy(t *testing.T) {
+	uAlice := &user.DefaultInfo{Name: "alice", Groups: []string{"system:authenticated"}}
+	uBob := &user.DefaultInfo{Name: "bob", Groups: []string{"system:authenticated", "developers"}}
+
+	tests := []struct {
+		spec    PolicySpec
+		attr    authorizer.Attributes
+		matches bool
+		name    string
+	}{
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"get"}, Resource: "pods", Namespace: "ns1"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "get", Resource: "pods", Namespace: "ns1", ResourceRequest: true},
+			matches: true,
+			name:    "exact match",
+		},
+		{
+			spec:    PolicySpec{Verbs: []string{"*"}, APIGroup: "*", Resource: "*", Subresource: "*", Namespace: "*"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "get", Resource: "pods", Namespace: "ns1", ResourceRequest: true},
+			matches: false,
+			name:    "no subject matches nobody",
+		},
+		{
+			spec:    PolicySpec{User: "*", Verbs: []string{"*"}, APIGroup: "*", Resource: "*", Subresource: "*", Namespace: "*"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "delete", APIGroup: "extensions", Resource: "deployments", Subresource: "scale", Namespace: "ns1", ResourceRequest: true},
+			matches: true,
+			name:    "wildcards",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"get", "list"}, Resource: "pods", Namespace: "ns1"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "delete", Resource: "pods", Namespace: "ns1", ResourceRequest: true},
+			matches: false,
+			name:    "verb mismatch",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"get"}, Resource: "pods", Namespace: "ns1"},
+			attr:    authorizer.AttributesRecord{User: uBob, Verb: "get", Resource: "pods", Namespace: "ns1", ResourceRequest: true},
+			matches: false,
+			name:    "user mismatch",
+		},
+		{
+			spec:    PolicySpec{Group: "developers", Verbs: []string{"get"}, Resource: "pods", Namespace: "ns1"},
+			attr:    authorizer.AttributesRecord{User: uBob, Verb: "get", Resource: "pods", Namespace: "ns1", ResourceRequest: true},
+			matches: true,
+			name:    "group match",
+		},
+		{
+			spec:    PolicySpec{Group: "developers", Verbs: []string{"get"}, Resource: "pods", Namespace: "ns1"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "get", Resource: "pods", Namespace: "ns1", ResourceRequest: true},
+			matches: false,
+			name:    "group mismatch",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"get"}, Resource: "deployments", Namespace: "ns1"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "get", APIGroup: "extensions", Resource: "deployments", Namespace: "ns1", ResourceRequest: true},
+			matches: false,
+			name:    "unset api group only matches the legacy api",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"get"}, APIGroup: "extensions", Resource: "deployments", Namespace: "ns1"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "get", APIGroup: "extensions", Resource: "deployments", Namespace: "ns1", ResourceRequest: true},
+			matches: true,
+			name:    "api group match",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"get"}, Resource: "pods", Subresource: "log", Namespace: "ns1"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "get", Resource: "pods", Subresource: "log", Namespace: "ns1", ResourceRequest: true},
+			matches: true,
+			name:    "subresource match",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"get", "create"}, Resource: "pods", Subresource: "log", Namespace: "ns1"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "create", Resource: "pods", Subresource: "exec", Namespace: "ns1", ResourceRequest: true},
+			matches: false,
+			name:    "subresource mismatch",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"get"}, Resource: "pods", Namespace: "ns1"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "get", Resource: "pods", Subresource: "log", Namespace: "ns1", ResourceRequest: true},
+			matches: false,
+			name:    "unset subresource does not match subresources",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"get"}, Resource: "pods", Namespace: "ns1"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "get", Resource: "pods", Namespace: "ns2", ResourceRequest: true},
+			matches: false,
+			name:    "namespace mismatch",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"list"}, Resource: "pods", Namespace: "*"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "list", Resource: "pods", ResourceRequest: true},
+			matches: true,
+			name:    "wildcard namespace matches requests across namespaces",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"list"}, Resource: "nodes"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "list", Resource: "nodes", ResourceRequest: true},
+			matches: true,
+			name:    "unset namespace matches cluster scoped requests",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"get"}, NonResourcePath: "/healthz"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "get", Path: "/healthz"},
+			matches: true,
+			name:    "non-resource path match",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"get"}, NonResourcePath: "/healthz"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "get", Path: "/metrics"},
+			matches: false,
+			name:    "non-resource path mismatch",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"get"}, NonResourcePath: "/healthz*"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "get", Path: "/healthz/ping"},
+			matches: true,
+			name:    "non-resource path prefix",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"*"}, APIGroup: "*", Resource: "*", Subresource: "*", Namespace: "*"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "get", Path: "/version"},
+			matches: false,
+			name:    "resource policy does not match non-resource requests",
+		},
+		{
+			spec:    PolicySpec{User: "alice", Verbs: []string{"*"}, NonResourcePath: "*"},
+			attr:    authorizer.AttributesRecord{User: uAlice, Verb: "get", Resource: "pods", ResourceRequest: true},
+			matches: false,
+			name:    "non-resource policy does not match resource requests",
+		},
+	}
+	for _, test := range tests {
+		matches := test.spec.matches(test.attr)
+		if test.matches != matches {
+			t.Errorf("unexpected value for %s, expected: %t, saw: %t", test.name, test.matches, matches)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package abac
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+)
+
+const (
+	// PolicyVersion is the apiVersion of versioned policy lines.  Lines without an apiVersion
+	// are read in the original, unversioned format.
+	PolicyVersion = "abac.authorization.kubernetes.io/v1beta1"
+	// PolicyKind is the kind of versioned policy lines.
+	PolicyKind = "Policy"
+
+	// Wildcard matches any value of a property of a versioned policy.
+	Wildcard = "*"
+)
+
+// Policy is a line of a policy file in the versioned format.
+type Policy struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+
+	// Spec describes the requests the policy allows.
+	Spec PolicySpec `json:"spec"`
+}
+
+// PolicySpec describes the requests a versioned policy allows.  Every property must match for
+// the policy to allow a request.  Properties are compared exactly, so an unset property only
+// matches an empty attribute, except that "*" matches any value.
+type PolicySpec struct {
+	// User is the name of the authenticated user.
+	User string `json:"user,omitempty"`
+	// Group is one of the groups of the authenticated user.
+	Group string `json:"group,omitempty"`
+
+	// Verbs are the verbs the policy allows, such as get, list, watch, create, update,
+	// patch and delete.
+	Verbs []string `json:"verbs,omitempty"`
+
+	// APIGroup is the API group of the resource, empty for the legacy API.
+	APIGroup string `json:"apiGroup,omitempty"`
+	// Resource is the resource of the request, such as pods.
+	Resource string `json:"resource,omitempty"`
+	// Subresource is the subresource of the request, such as log or exec.  It is empty for
+	// requests on the resource itself.
+	Subresource string `json:"subresource,omitempty"`
+	// Namespace is the namespace of the request, empty for cluster scoped resources and
+	// requests across all namespaces.
+	Namespace string `json:"namespace,omitempty"`
+
+	// NonResourcePath is the path of requests that are not for API resources, such as
+	// /version.  A trailing "*" matches any path with the preceding prefix.
+	NonResourcePath string `json:"nonResourcePath,omitempty"`
+}
+
+func (p PolicySpec) matches(a authorizer.Attributes) bool {
+	if !p.subjectMatches(a) || !p.verbMatches(a) {
+		return false
+	}
+	if !a.IsResourceRequest() {
+		return pathMatches(p.NonResourcePath, a.GetPath())
+	}
+	return len(p.Resource) > 0 &&
+		propertyMatches(p.Resource, a.GetResource()) &&
+		propertyMatches(p.Subresource, a.GetSubresource()) &&
+		propertyMatches(p.APIGroup, a.GetAPIGroup()) &&
+		propertyMatches(p.Namespace, a.GetNamespace())
+}
+
+func (p PolicySpec) subjectMatches(a authorizer.Attributes) bool {
+	if len(p.User) == 0 && len(p.Group) == 0 {
+		return false
+	}
+	if len(p.User) > 0 && !propertyMatches(p.User, a.GetUserName()) {
+		return false
+	}
+	if len(p.Group) > 0 && p.Group != Wildcard {
+		for _, group := range a.GetGroups() {
+			if p.Group == group {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func (p PolicySpec) verbMatches(a authorizer.Attributes) bool {
+	for _, verb := range p.Verbs {
+		if verb == Wildcard || verb == a.GetVerb() {
+			return true
+		}
+	}
+	return false
+}
+
+func propertyMatches(property, value string) bool {
+	return property == Wildcard || property == value
+}
+
+func pathMatches(pattern, path string) bool {
+	if len(pattern) == 0 {
+		return false
+	}
+	if strings.HasSuffix(pattern, Wildcard) {
+		return strings.HasPrefix(path, strings.TrimSuffix(pattern, Wildcard))
+	}
+	return pattern == path
+}
+
+// validatePolicy returns the errors in a versioned policy.
+func validatePolicy(p *Policy) []error {
+	var errs []error
+	if p.Kind != PolicyKind {
+		errs = append(errs, fmt.Errorf("unsupported kind %q, must be %q", p.Kind, PolicyKind))
+	}
+
+	spec := p.Spec
+	if len(spec.User) == 0 && len(spec.Group) == 0 {
+		errs = append(errs, fmt.Errorf("one of user or group is required, use %q to match everyone", Wildcard))
+	}
+	if len(spec.Verbs) == 0 {
+		errs = append(errs, fmt.Errorf("verbs are required, use [%q] to match every verb", Wildcard))
+	}
+	for _, verb := range spec.Verbs {
+		if len(verb) == 0 {
+			errs = append(errs, fmt.Errorf("verbs must not be empty"))
+		}
+	}
+
+	hasResourceProperties := len(spec.APIGroup) > 0 || len(spec.Resource) > 0 || len(spec.Subresource) > 0 || len(spec.Namespace) > 0
+	switch {
+	case len(spec.NonResourcePath) > 0 && hasResourceProperties:
+		errs = append(errs, fmt.Errorf("nonResourcePath cannot be combined with apiGroup, resource, subresource or namespace"))
+	case len(spec.NonResourcePath) > 0:
+		if !strings.HasPrefix(spec.NonResourcePath, "/") && spec.NonResourcePath != Wildcard {
+			errs = append(errs, fmt.Errorf("nonResourcePath %q must start with \"/\"", spec.NonResourcePath))
+		}
+	case len(spec.Resource) == 0:
+		errs = append(errs, fmt.Errorf("one of resource or nonResourcePath is required"))
+	case strings.Contains(spec.Resource, "/"):
+		errs = append(errs, fmt.Errorf("resource %q must not contain \"/\", use subresource instead", spec.Resource))
+	}
+	return errs
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/rbac"
+	"k8s.io/kubernetes/pkg/apis/rbac/validation"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// ListerWatcher lists and watches objects across all namespaces, like the REST storage of the rbac resources.
+type ListerWatcher interface {
+	List(ctx api.Context, options *unversioned.ListOptions) (runtime.Object, error)
+	Watch(ctx api.Context, options *unversioned.ListOptions) (watch.Interface, error)
+}
+
+// NewCachedRuleResolver returns a rule resolver that evaluates roles and bindings from informer caches,
+// so that authorizing a request does not read from storage.  The caches are filled from the given
+// storage and kept up to date until stopCh is closed.
+func NewCachedRuleResolver(roles, roleBindings, clusterRoles, clusterRoleBindings ListerWatcher, stopCh <-chan struct{}) validation.AuthorizationRuleResolver {
+	roleIndexer, roleController := newIndexerInformer(roles, &rbac.Role{})
+	roleBindingIndexer, roleBindingController := newIndexerInformer(roleBindings, &rbac.RoleBinding{})
+	clusterRoleIndexer, clusterRoleController := newIndexerInformer(clusterRoles, &rbac.ClusterRole{})
+	clusterRoleBindingIndexer, clusterRoleBindingController := newIndexerInformer(clusterRoleBindings, &rbac.ClusterRoleBinding{})
+
+	go roleController.Run(stopCh)
+	go roleBindingController.Run(stopCh)
+	go clusterRoleController.Run(stopCh)
+	go clusterRoleBindingController.Run(stopCh)
+
+	return validation.NewDefaultRuleResolver(
+		&roleCache{roleIndexer},
+		&roleBindingCache{roleBindingIndexer},
+		&clusterRoleCache{clusterRoleIndexer},
+		&clusterRoleBindingCache{clusterRoleBindingIndexer},
+	)
+}
+
+func newIndexerInformer(lw ListerWatcher, objType runtime.Object) (cache.Indexer, *framework.Controller) {
+	return framework.NewIndexerInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return lw.List(api.NewContext(), &unversioned.ListOptions{})
+			},
+			WatchFunc: func(options unversioned.ListOptions) (watch.Interface, error) {
+				return lw.Watch(api.NewContext(), &options)
+			},
+		},
+		objType,
+		0,
+		framework.ResourceEventHandlerFuncs{},
+		cache.Indexers{"namespace": cache.MetaNamespaceIndexFunc},
+	)
+}
+
+type roleCache struct {
+	indexer cache.Indexer
+}
+
+func (c *roleCache) GetRole(ctx api.Context, name string) (*rbac.Role, error) {
+	obj, exists, err := c.indexer.GetByKey(api.NamespaceValue(ctx) + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound("Role", name)
+	}
+	return obj.(*rbac.Role), nil
+}
+
+type roleBindingCache struct {
+	indexer cache.Indexer
+}
+
+func (c *roleBindingCache) ListRoleBindings(ctx api.Context, options *unversioned.ListOptions) (*rbac.RoleBindingList, error) {
+	objs, err := c.indexer.ByIndex("namespace", api.NamespaceValue(ctx))
+	if err != nil {
+		return nil, err
+	}
+	list := &rbac.RoleBindingList{}
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*rbac.RoleBinding))
+	}
+	return list, nil
+}
+
+type clusterRoleCache struct {
+	indexer cache.Indexer
+}
+
+func (c *clusterRoleCache) GetClusterRole(ctx api.Context, name string) (*rbac.ClusterRole, error) {
+	obj, exists, err := c.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound("ClusterRole", name)
+	}
+	return obj.(*rbac.ClusterRole), nil
+}
+
+type clusterRoleBindingCache struct {
+	indexer cache.Indexer
+}
+
+func (c *clusterRoleBindingCache) ListClusterRoleBindings(ctx api.Context, options *unversioned.ListOptions) (*rbac.ClusterRoleBindingList, error) {
+	list := &rbac.ClusterRoleBindingList{}
+	for _, obj := range c.indexer.List() {
+		list.Items = append(list.Items, *obj.(*rbac.ClusterRoleBinding))
+	}
+	return list, nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rbac implements the authorizer.Authorizer interface using roles and bindings
+// from the rbac API group.
+package rbac
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/rbac"
+	"k8s.io/kubernetes/pkg/apis/rbac/validation"
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+// RBACAuthorizer allows requests that are granted by a rule of a role bound to the requesting user,
+// either cluster wide through a ClusterRoleBinding or in the namespace of the request through a RoleBinding.
+type RBACAuthorizer struct {
+	superUser string
+
+	authorizationRuleResolver validation.AuthorizationRuleResolver
+}
+
+// New returns an RBACAuthorizer that resolves rules with the given resolver.  Requests from
+// superUser, if set, are always allowed.
+func New(ruleResolver validation.AuthorizationRuleResolver, superUser string) *RBACAuthorizer {
+	return &RBACAuthorizer{
+		superUser:                 superUser,
+		authorizationRuleResolver: ruleResolver,
+	}
+}
+
+func (r *RBACAuthorizer) Authorize(attr authorizer.Attributes) error {
+	if len(r.superUser) > 0 && attr.GetUserName() == r.superUser {
+		return nil
+	}
+
+	userInfo := &user.DefaultInfo{
+		Name:   attr.GetUserName(),
+		Groups: attr.GetGroups(),
+	}
+	ctx := api.WithNamespace(api.WithUser(api.NewContext(), userInfo), attr.GetNamespace())
+
+	// Rule resolution errors are not fatal: rules are purely additive, so any rule that was
+	// found and allows the request is enough.
+	rules, ruleResolutionErr := r.authorizationRuleResolver.GetEffectivePolicyRules(ctx)
+	if RulesAllow(attr, rules...) {
+		return nil
+	}
+	if ruleResolutionErr != nil {
+		return fmt.Errorf("RBAC: no rule allows the request, some rules could not be resolved: %v", ruleResolutionErr)
+	}
+	return errors.New("RBAC: no rule allows the request")
+}
+
+// RulesAllow returns true if any of the rules allows the request described by attr.
+func RulesAllow(attr authorizer.Attributes, rules ...rbac.PolicyRule) bool {
+	for _, rule := range rules {
+		if RuleAllows(attr, rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleAllows returns true if the rule allows the request described by attr.  Resource requests
+// are matched on verb, API group, resource and subresource, and name; other requests are
+// matched on verb and path.
+func RuleAllows(attr authorizer.Attributes, rule rbac.PolicyRule) bool {
+	if !rbac.VerbMatches(rule, attr.GetVerb()) {
+		return false
+	}
+	if !attr.IsResourceRequest() {
+		return rbac.NonResourceURLMatches(rule, attr.GetPath())
+	}
+
+	resource := attr.GetResource()
+	if len(attr.GetSubresource()) > 0 {
+		resource = attr.GetResource() + "/" + attr.GetSubresource()
+	}
+	return rbac.APIGroupMatches(rule, attr.GetAPIGroup()) &&
+		rbac.ResourceMatches(rule, resource) &&
+		rbac.ResourceNameMatches(rule, attr.GetName())
+}
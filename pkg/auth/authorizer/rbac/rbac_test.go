@@ -0,0 +1,156 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"errors"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/rbac"
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+// staticResolver returns a fixed set of rules regardless of the requesting user.
+type staticResolver struct {
+	rules []rbac.PolicyRule
+	err   error
+}
+
+func (r *staticResolver) GetRoleReferenceRules(ctx api.Context, roleRef rbac.RoleRef, namespace string) ([]rbac.PolicyRule, error) {
+	return r.rules, r.err
+}
+
+func (r *staticResolver) GetEffectivePolicyRules(ctx api.Context) ([]rbac.PolicyRule, error) {
+	return r.rules, r.err
+}
+
+func TestRuleAllows(t *testing.T) {
+	testCases := map[string]struct {
+		attr     authorizer.AttributesRecord
+		rule     rbac.PolicyRule
+		expected bool
+	}{
+		"verb and resource": {
+			attr:     authorizer.AttributesRecord{Verb: "get", Resource: "pods", ResourceRequest: true},
+			rule:     rbac.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}},
+			expected: true,
+		},
+		"wrong verb": {
+			attr:     authorizer.AttributesRecord{Verb: "delete", Resource: "pods", ResourceRequest: true},
+			rule:     rbac.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}},
+			expected: false,
+		},
+		"wrong api group": {
+			attr:     authorizer.AttributesRecord{Verb: "get", APIGroup: "extensions", Resource: "deployments", ResourceRequest: true},
+			rule:     rbac.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"deployments"}},
+			expected: false,
+		},
+		"wildcards": {
+			attr:     authorizer.AttributesRecord{Verb: "delete", APIGroup: "extensions", Resource: "deployments", Name: "foo", ResourceRequest: true},
+			rule:     rbac.PolicyRule{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}},
+			expected: true,
+		},
+		"subresource": {
+			attr:     authorizer.AttributesRecord{Verb: "get", Resource: "pods", Subresource: "log", ResourceRequest: true},
+			rule:     rbac.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods/log"}},
+			expected: true,
+		},
+		"resource does not grant subresource": {
+			attr:     authorizer.AttributesRecord{Verb: "get", Resource: "pods", Subresource: "log", ResourceRequest: true},
+			rule:     rbac.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}},
+			expected: false,
+		},
+		"resource name": {
+			attr:     authorizer.AttributesRecord{Verb: "get", Resource: "secrets", Name: "foo", ResourceRequest: true},
+			rule:     rbac.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"foo"}},
+			expected: true,
+		},
+		"other resource name": {
+			attr:     authorizer.AttributesRecord{Verb: "get", Resource: "secrets", Name: "bar", ResourceRequest: true},
+			rule:     rbac.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"foo"}},
+			expected: false,
+		},
+		"resource name does not grant list": {
+			attr:     authorizer.AttributesRecord{Verb: "list", Resource: "secrets", ResourceRequest: true},
+			rule:     rbac.PolicyRule{Verbs: []string{"list"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"foo"}},
+			expected: false,
+		},
+		"non-resource url": {
+			attr:     authorizer.AttributesRecord{Verb: "get", Path: "/version"},
+			rule:     rbac.PolicyRule{Verbs: []string{"get"}, NonResourceURLs: []string{"/version"}},
+			expected: true,
+		},
+		"non-resource url prefix": {
+			attr:     authorizer.AttributesRecord{Verb: "get", Path: "/healthz/ping"},
+			rule:     rbac.PolicyRule{Verbs: []string{"get"}, NonResourceURLs: []string{"/healthz/*"}},
+			expected: true,
+		},
+		"resource rule does not grant non-resource url": {
+			attr:     authorizer.AttributesRecord{Verb: "get", Path: "/version"},
+			rule:     rbac.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{"*"}, Resources: []string{"*"}},
+			expected: false,
+		},
+	}
+	for k, tc := range testCases {
+		if actual := RuleAllows(tc.attr, tc.rule); actual != tc.expected {
+			t.Errorf("%s: expected %v, got %v", k, tc.expected, actual)
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	readPods := []rbac.PolicyRule{{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods"}}}
+	testCases := map[string]struct {
+		resolver      *staticResolver
+		superUser     string
+		attr          authorizer.AttributesRecord
+		expectAllowed bool
+	}{
+		"allowed": {
+			resolver:      &staticResolver{rules: readPods},
+			attr:          authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "bob"}, Verb: "list", Namespace: "ns1", Resource: "pods", ResourceRequest: true},
+			expectAllowed: true,
+		},
+		"denied": {
+			resolver:      &staticResolver{rules: readPods},
+			attr:          authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "bob"}, Verb: "delete", Namespace: "ns1", Resource: "pods", ResourceRequest: true},
+			expectAllowed: false,
+		},
+		"allowed despite resolution errors": {
+			resolver:      &staticResolver{rules: readPods, err: errors.New("role not found")},
+			attr:          authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "bob"}, Verb: "get", Namespace: "ns1", Resource: "pods", ResourceRequest: true},
+			expectAllowed: true,
+		},
+		"super user": {
+			resolver:      &staticResolver{},
+			superUser:     "admin",
+			attr:          authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "admin"}, Verb: "delete", Namespace: "ns1", Resource: "pods", ResourceRequest: true},
+			expectAllowed: true,
+		},
+	}
+	for k, tc := range testCases {
+		err := New(tc.resolver, tc.superUser).Authorize(tc.attr)
+		if tc.expectAllowed && err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+		}
+		if !tc.expectAllowed && err == nil {
+			t.Errorf("%s: expected request to be denied", k)
+		}
+	}
+}
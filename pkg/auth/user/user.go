@@ -16,6 +16,15 @@ limitations under the License.
 
 package user
 
+const (
+	// ImpersonateUserHeader names the user a request should be handled as,
+	// in place of the authenticated user.
+	ImpersonateUserHeader = "Impersonate-User"
+	// ImpersonateGroupHeader names a group the impersonated user belongs to.
+	// It may be repeated and is only honored along with ImpersonateUserHeader.
+	ImpersonateGroupHeader = "Impersonate-Group"
+)
+
 // Info describes a user that has been authenticated to the system.
 type Info interface {
 	// GetName returns the name that uniquely identifies this user among all
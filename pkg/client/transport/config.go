@@ -34,6 +34,10 @@ type Config struct {
 	// Bearer token for authentication
 	BearerToken string
 
+	// Username and groups to act as, on behalf of the authenticated user
+	Impersonate       string
+	ImpersonateGroups []string
+
 	// Transport may be used for custom HTTP behavior. This attribute may
 	// not be specified with the TLS client certificate options. Use
 	// WrapTransport for most client level operations.
@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/auth/user"
 )
 
 // HTTPWrappersForConfig wraps a round tripper with any relevant layered
@@ -45,6 +47,12 @@ func HTTPWrappersForConfig(config *Config, rt http.RoundTripper) (http.RoundTrip
 	case config.HasBasicAuth():
 		rt = newBasicAuthRoundTripper(config.Username, config.Password, rt)
 	}
+	if len(config.ImpersonateGroups) > 0 && len(config.Impersonate) == 0 {
+		return nil, fmt.Errorf("impersonated groups may only be set along with an impersonated user")
+	}
+	if len(config.Impersonate) > 0 {
+		rt = newImpersonatingRoundTripper(config.Impersonate, config.ImpersonateGroups, rt)
+	}
 	if len(config.UserAgent) > 0 {
 		rt = newUserAgentRoundTripper(config.UserAgent, rt)
 	}
@@ -127,6 +135,30 @@ func (rt *bearerAuthRoundTripper) RoundTrip(req *http.Request) (*http.Response,
 	return rt.rt.RoundTrip(req)
 }
 
+type impersonatingRoundTripper struct {
+	user   string
+	groups []string
+	rt     http.RoundTripper
+}
+
+// newImpersonatingRoundTripper asks the server to handle a request as the
+// given user and groups unless the request already names a user to impersonate.
+func newImpersonatingRoundTripper(user string, groups []string, rt http.RoundTripper) http.RoundTripper {
+	return &impersonatingRoundTripper{user, groups, rt}
+}
+
+func (rt *impersonatingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(req.Header.Get(user.ImpersonateUserHeader)) != 0 {
+		return rt.rt.RoundTrip(req)
+	}
+	req = cloneRequest(req)
+	req.Header.Set(user.ImpersonateUserHeader, rt.user)
+	for _, group := range rt.groups {
+		req.Header.Add(user.ImpersonateGroupHeader, group)
+	}
+	return rt.rt.RoundTrip(req)
+}
+
 // cloneRequest returns a clone of the provided *http.Request.
 // The clone is a shallow copy of the struct and its Header map.
 func cloneRequest(r *http.Request) *http.Request {
@@ -18,6 +18,7 @@ package transport
 
 import (
 	"net/http"
+	"reflect"
 	"testing"
 )
 
@@ -91,3 +92,33 @@ func TestUserAgentRoundTripper(t *testing.T) {
 		t.Errorf("unexpected user agent header: %#v", rt.Request)
 	}
 }
+
+func TestImpersonatingRoundTripper(t *testing.T) {
+	rt := &testRoundTripper{}
+	req := &http.Request{}
+	newImpersonatingRoundTripper("bob", []string{"admins", "ops"}, rt).RoundTrip(req)
+	if rt.Request == nil {
+		t.Fatalf("unexpected nil request: %v", rt)
+	}
+	if rt.Request == req {
+		t.Fatalf("round tripper should have copied request object: %#v", rt.Request)
+	}
+	if rt.Request.Header.Get("Impersonate-User") != "bob" {
+		t.Errorf("unexpected impersonate user header: %#v", rt.Request)
+	}
+	if groups := rt.Request.Header["Impersonate-Group"]; !reflect.DeepEqual(groups, []string{"admins", "ops"}) {
+		t.Errorf("unexpected impersonate group headers: %v", groups)
+	}
+
+	req = &http.Request{
+		Header: make(http.Header),
+	}
+	req.Header.Set("Impersonate-User", "alice")
+	newImpersonatingRoundTripper("bob", []string{"admins"}, rt).RoundTrip(req)
+	if rt.Request != req {
+		t.Fatalf("round tripper should not have copied request object: %#v", rt.Request)
+	}
+	if rt.Request.Header.Get("Impersonate-User") != "alice" || len(rt.Request.Header["Impersonate-Group"]) != 0 {
+		t.Errorf("unexpected impersonate headers: %#v", rt.Request)
+	}
+}
@@ -45,6 +45,7 @@ type Interface interface {
 	ResourceQuotasNamespacer
 	ServiceAccountsNamespacer
 	SecretsNamespacer
+	ConfigMapsNamespacer
 	NamespacesInterface
 	PersistentVolumesInterface
 	PersistentVolumeClaimsNamespacer
@@ -97,6 +98,10 @@ func (c *Client) Secrets(namespace string) SecretsInterface {
 	return newSecrets(c, namespace)
 }
 
+func (c *Client) ConfigMaps(namespace string) ConfigMapsInterface {
+	return newConfigMaps(c, namespace)
+}
+
 func (c *Client) Namespaces() NamespaceInterface {
 	return newNamespaces(c)
 }
@@ -88,6 +88,10 @@ type AuthInfo struct {
 	Username string `json:"username,omitempty"`
 	// Password is the password for basic authentication to the kubernetes cluster.
 	Password string `json:"password,omitempty"`
+	// Impersonate is the username to act as.
+	Impersonate string `json:"as,omitempty"`
+	// ImpersonateGroups is the groups to act as, along with Impersonate.
+	ImpersonateGroups []string `json:"as-groups,omitempty"`
 	// Extensions holds additional information. This is useful for extenders so that reads and writes don't clobber unknown fields
 	Extensions map[string]*runtime.EmbeddedObject `json:"extensions,omitempty"`
 }
@@ -172,6 +172,10 @@ func getUserIdentificationPartialConfig(configAuthInfo clientcmdapi.AuthInfo, fa
 		mergedConfig.Username = configAuthInfo.Username
 		mergedConfig.Password = configAuthInfo.Password
 	}
+	// copied as is, so that groups without a user are rejected when the
+	// transport is built instead of being silently dropped
+	mergedConfig.Impersonate = configAuthInfo.Impersonate
+	mergedConfig.ImpersonateGroups = configAuthInfo.ImpersonateGroups
 
 	// if there still isn't enough information to authenticate the user, try prompting
 	if !canIdentifyUser(*mergedConfig) && (fallbackReader != nil) {
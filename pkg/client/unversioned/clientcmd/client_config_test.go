@@ -20,6 +20,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/spf13/pflag"
+
 	"k8s.io/kubernetes/pkg/api/testapi"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	clientcmdapi "k8s.io/kubernetes/pkg/client/unversioned/clientcmd/api"
@@ -146,6 +148,64 @@ func TestBasicAuthData(t *testing.T) {
 	matchStringArg(password, clientConfig.Password, t)
 }
 
+func TestImpersonateOverride(t *testing.T) {
+	config := clientcmdapi.NewConfig()
+	config.Clusters["clean"] = &clientcmdapi.Cluster{
+		Server:     "https://localhost:8443",
+		APIVersion: testapi.Default.Version(),
+	}
+	config.AuthInfos["clean"] = &clientcmdapi.AuthInfo{
+		Token: "my-secret-token",
+	}
+	config.Contexts["clean"] = &clientcmdapi.Context{
+		Cluster:  "clean",
+		AuthInfo: "clean",
+	}
+	config.CurrentContext = "clean"
+
+	overrides := &ConfigOverrides{
+		AuthInfo: clientcmdapi.AuthInfo{
+			Impersonate:       "bob",
+			ImpersonateGroups: []string{"admins", "ops"},
+		},
+	}
+	clientBuilder := NewNonInteractiveClientConfig(*config, "clean", overrides)
+
+	clientConfig, err := clientBuilder.ClientConfig()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Make sure impersonation data gets into config along with the credentials
+	matchStringArg("my-secret-token", clientConfig.BearerToken, t)
+	matchStringArg("bob", clientConfig.Impersonate, t)
+	if !reflect.DeepEqual([]string{"admins", "ops"}, clientConfig.ImpersonateGroups) {
+		t.Errorf("Expected groups %v, got %v", []string{"admins", "ops"}, clientConfig.ImpersonateGroups)
+	}
+}
+
+func TestImpersonateGroupsWithoutUser(t *testing.T) {
+	config := createValidTestConfig()
+	overrides := &ConfigOverrides{}
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindOverrideFlags(overrides, flags, RecommendedConfigOverrideFlags(""))
+	if err := flags.Parse([]string{"--as-group=admins"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	clientBuilder := NewNonInteractiveClientConfig(*config, "clean", overrides)
+
+	clientConfig, err := clientBuilder.ClientConfig()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual([]string{"admins"}, clientConfig.ImpersonateGroups) {
+		t.Errorf("Expected groups %v, got %v", []string{"admins"}, clientConfig.ImpersonateGroups)
+	}
+	if _, err := client.TransportFor(clientConfig); err == nil {
+		t.Errorf("Expected an error for impersonated groups without an impersonated user")
+	}
+}
+
 func TestCreateClean(t *testing.T) {
 	config := createValidTestConfig()
 	clientBuilder := NewNonInteractiveClientConfig(*config, "clean", &ConfigOverrides{})
@@ -49,6 +49,8 @@ type AuthOverrideFlags struct {
 	Token             FlagInfo
 	Username          FlagInfo
 	Password          FlagInfo
+	Impersonate       FlagInfo
+	ImpersonateGroups FlagInfo
 }
 
 // ContextOverrideFlags holds the flag names to be used for binding command line flags for Cluster objects
@@ -88,6 +90,15 @@ func (f FlagInfo) BindStringFlag(flags *pflag.FlagSet, target *string) {
 	}
 }
 
+// BindStringSliceFlag binds the flag based on the provided info.  If LongName == "", nothing is registered
+func (f FlagInfo) BindStringSliceFlag(flags *pflag.FlagSet, target *[]string) {
+	// you can't register a flag without a long name
+	if len(f.LongName) > 0 {
+		// the default is always empty; a repeated flag accumulates values
+		flags.StringSliceVarP(target, f.LongName, f.ShortName, []string{}, f.Description)
+	}
+}
+
 // BindBoolFlag binds the flag based on the provided info.  If LongName == "", nothing is registered
 func (f FlagInfo) BindBoolFlag(flags *pflag.FlagSet, target *bool) {
 	// you can't register a flag without a long name
@@ -117,6 +128,9 @@ const (
 	FlagBearerToken  = "token"
 	FlagUsername     = "username"
 	FlagPassword     = "password"
+
+	FlagImpersonate      = "as"
+	FlagImpersonateGroup = "as-group"
 )
 
 // RecommendedAuthOverrideFlags is a convenience method to return recommended flag names prefixed with a string of your choosing
@@ -127,6 +141,8 @@ func RecommendedAuthOverrideFlags(prefix string) AuthOverrideFlags {
 		Token:             FlagInfo{prefix + FlagBearerToken, "", "", "Bearer token for authentication to the API server."},
 		Username:          FlagInfo{prefix + FlagUsername, "", "", "Username for basic authentication to the API server."},
 		Password:          FlagInfo{prefix + FlagPassword, "", "", "Password for basic authentication to the API server."},
+		Impersonate:       FlagInfo{prefix + FlagImpersonate, "", "", "Username to impersonate for the operation."},
+		ImpersonateGroups: FlagInfo{prefix + FlagImpersonateGroup, "", "", "Group to impersonate for the operation, this flag can be repeated to specify multiple groups."},
 	}
 }
 
@@ -166,6 +182,8 @@ func BindAuthInfoFlags(authInfo *clientcmdapi.AuthInfo, flags *pflag.FlagSet, fl
 	flagNames.Token.BindStringFlag(flags, &authInfo.Token)
 	flagNames.Username.BindStringFlag(flags, &authInfo.Username)
 	flagNames.Password.BindStringFlag(flags, &authInfo.Password)
+	flagNames.Impersonate.BindStringFlag(flags, &authInfo.Impersonate)
+	flagNames.ImpersonateGroups.BindStringSliceFlag(flags, &authInfo.ImpersonateGroups)
 }
 
 // BindClusterFlags is a convenience method to bind the specified flags to their associated variables
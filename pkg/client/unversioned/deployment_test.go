@@ -17,6 +17,7 @@ limitations under the License.
 package unversioned
 
 import (
+	"net/http"
 	"net/url"
 	"testing"
 
@@ -172,3 +173,23 @@ func TestDeploymentWatch(t *testing.T) {
 	_, err := c.Setup(t).Deployments(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), unversioned.ListOptions{})
 	c.Validate(t, nil, err)
 }
+
+func TestDeploymentRollback(t *testing.T) {
+	ns := api.NamespaceDefault
+	deploymentRollback := &extensions.DeploymentRollback{
+		Name:               "abc",
+		UpdatedAnnotations: map[string]string{},
+		RollbackTo:         extensions.RollbackConfig{Revision: 1},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   testapi.Extensions.ResourcePath(getDeploymentsResoureName(), ns, "abc") + "/rollback",
+			Query:  buildQueryValues(nil),
+			Body:   deploymentRollback,
+		},
+		Response: Response{StatusCode: http.StatusOK},
+	}
+	err := c.Setup(t).Deployments(ns).Rollback(deploymentRollback)
+	c.Validate(t, nil, err)
+}
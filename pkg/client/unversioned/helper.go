@@ -66,6 +66,13 @@ type Config struct {
 	// TODO: demonstrate an OAuth2 compatible client.
 	BearerToken string
 
+	// Impersonate is the username that this client will act as. The server
+	// must authorize the authenticated user to impersonate it.
+	Impersonate string
+	// ImpersonateGroups are the groups this client will act as in addition to
+	// Impersonate. Requires Impersonate to be set.
+	ImpersonateGroups []string
+
 	// TLSClientConfig contains settings to enable transport layer security
 	TLSClientConfig
 
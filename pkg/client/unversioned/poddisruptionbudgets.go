@@ -0,0 +1,114 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unversioned
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// PodDisruptionBudgetNamespacer has methods to work with PodDisruptionBudget resources in a namespace
+type PodDisruptionBudgetNamespacer interface {
+	PodDisruptionBudgets(namespace string) PodDisruptionBudgetInterface
+}
+
+// PodDisruptionBudgetInterface exposes methods to work on PodDisruptionBudget resources.
+type PodDisruptionBudgetInterface interface {
+	List(label labels.Selector, field fields.Selector) (*extensions.PodDisruptionBudgetList, error)
+	Get(name string) (*extensions.PodDisruptionBudget, error)
+	Create(podDisruptionBudget *extensions.PodDisruptionBudget) (*extensions.PodDisruptionBudget, error)
+	Update(podDisruptionBudget *extensions.PodDisruptionBudget) (*extensions.PodDisruptionBudget, error)
+	Delete(name string, options *api.DeleteOptions) error
+	Watch(label labels.Selector, field fields.Selector, opts unversioned.ListOptions) (watch.Interface, error)
+	UpdateStatus(podDisruptionBudget *extensions.PodDisruptionBudget) (*extensions.PodDisruptionBudget, error)
+}
+
+// podDisruptionBudgets implements PodDisruptionBudgetNamespacer interface
+type podDisruptionBudgets struct {
+	r  *ExtensionsClient
+	ns string
+}
+
+// newPodDisruptionBudgets returns a podDisruptionBudgets
+func newPodDisruptionBudgets(c *ExtensionsClient, namespace string) *podDisruptionBudgets {
+	return &podDisruptionBudgets{c, namespace}
+}
+
+// List returns a list of pod disruption budgets that match the label and field selectors.
+func (c *podDisruptionBudgets) List(label labels.Selector, field fields.Selector) (result *extensions.PodDisruptionBudgetList, err error) {
+	result = &extensions.PodDisruptionBudgetList{}
+	err = c.r.Get().Namespace(c.ns).Resource("poddisruptionbudgets").LabelsSelectorParam(label).FieldsSelectorParam(field).Do().Into(result)
+	return
+}
+
+// Get returns information about a particular pod disruption budget.
+func (c *podDisruptionBudgets) Get(name string) (result *extensions.PodDisruptionBudget, err error) {
+	result = &extensions.PodDisruptionBudget{}
+	err = c.r.Get().Namespace(c.ns).Resource("poddisruptionbudgets").Name(name).Do().Into(result)
+	return
+}
+
+// Create creates a new pod disruption budget.
+func (c *podDisruptionBudgets) Create(podDisruptionBudget *extensions.PodDisruptionBudget) (result *extensions.PodDisruptionBudget, err error) {
+	result = &extensions.PodDisruptionBudget{}
+	err = c.r.Post().Namespace(c.ns).Resource("poddisruptionbudgets").Body(podDisruptionBudget).Do().Into(result)
+	return
+}
+
+// Update updates an existing pod disruption budget.
+func (c *podDisruptionBudgets) Update(podDisruptionBudget *extensions.PodDisruptionBudget) (result *extensions.PodDisruptionBudget, err error) {
+	result = &extensions.PodDisruptionBudget{}
+	err = c.r.Put().Namespace(c.ns).Resource("poddisruptionbudgets").Name(podDisruptionBudget.Name).Body(podDisruptionBudget).Do().Into(result)
+	return
+}
+
+// Delete deletes a pod disruption budget, returns error if one occurs.
+func (c *podDisruptionBudgets) Delete(name string, options *api.DeleteOptions) (err error) {
+	if options == nil {
+		return c.r.Delete().Namespace(c.ns).Resource("poddisruptionbudgets").Name(name).Do().Error()
+	}
+
+	body, err := api.Scheme.EncodeToVersion(options, c.r.APIVersion())
+	if err != nil {
+		return err
+	}
+	return c.r.Delete().Namespace(c.ns).Resource("poddisruptionbudgets").Name(name).Body(body).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested pod disruption budgets.
+func (c *podDisruptionBudgets) Watch(label labels.Selector, field fields.Selector, opts unversioned.ListOptions) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("poddisruptionbudgets").
+		Param("resourceVersion", opts.ResourceVersion).
+		TimeoutSeconds(TimeoutFromListOptions(opts)).
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Watch()
+}
+
+// UpdateStatus takes the name of the pod disruption budget and the new status.  Returns the server's representation of the pod disruption budget, and an error, if it occurs.
+func (c *podDisruptionBudgets) UpdateStatus(podDisruptionBudget *extensions.PodDisruptionBudget) (result *extensions.PodDisruptionBudget, err error) {
+	result = &extensions.PodDisruptionBudget{}
+	err = c.r.Put().Namespace(c.ns).Resource("poddisruptionbudgets").Name(podDisruptionBudget.Name).SubResource("status").Body(podDisruptionBudget).Do().Into(result)
+	return
+}
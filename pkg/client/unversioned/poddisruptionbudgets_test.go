@@ -0,0 +1,121 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unversioned
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func getPodDisruptionBudgetResourceName() string {
+	return "poddisruptionbudgets"
+}
+
+func TestListPodDisruptionBudgets(t *testing.T) {
+	ns := api.NamespaceAll
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.Extensions.ResourcePath(getPodDisruptionBudgetResourceName(), ns, ""),
+		},
+		Response: Response{StatusCode: 200,
+			Body: &extensions.PodDisruptionBudgetList{
+				Items: []extensions.PodDisruptionBudget{
+					{
+						ObjectMeta: api.ObjectMeta{
+							Name:   "foo",
+							Labels: map[string]string{"foo": "bar"},
+						},
+						Spec: extensions.PodDisruptionBudgetSpec{
+							MinAvailable: intstr.FromInt(1),
+						},
+					},
+				},
+			},
+		},
+	}
+	receivedList, err := c.Setup(t).Extensions().PodDisruptionBudgets(ns).List(labels.Everything(), fields.Everything())
+	c.Validate(t, receivedList, err)
+}
+
+func TestGetPodDisruptionBudget(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.Extensions.ResourcePath(getPodDisruptionBudgetResourceName(), ns, "foo"),
+			Query:  buildQueryValues(nil),
+		},
+		Response: Response{
+			StatusCode: 200,
+			Body: &extensions.PodDisruptionBudget{
+				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: ns},
+				Spec: extensions.PodDisruptionBudgetSpec{
+					MinAvailable: intstr.FromString("50%"),
+				},
+			},
+		},
+	}
+	receivedPodDisruptionBudget, err := c.Setup(t).Extensions().PodDisruptionBudgets(ns).Get("foo")
+	c.Validate(t, receivedPodDisruptionBudget, err)
+}
+
+func TestUpdatePodDisruptionBudgetStatus(t *testing.T) {
+	ns := api.NamespaceDefault
+	requestPodDisruptionBudget := &extensions.PodDisruptionBudget{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: ns, ResourceVersion: "1"},
+		Status: extensions.PodDisruptionBudgetStatus{
+			PodDisruptionsAllowed: 1,
+			CurrentHealthy:        3,
+			DesiredHealthy:        2,
+			ExpectedPods:          3,
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "PUT",
+			Path:   testapi.Extensions.ResourcePath(getPodDisruptionBudgetResourceName(), ns, "foo") + "/status",
+			Query:  buildQueryValues(nil),
+		},
+		Response: Response{
+			StatusCode: 200,
+			Body:       requestPodDisruptionBudget,
+		},
+	}
+	receivedPodDisruptionBudget, err := c.Setup(t).Extensions().PodDisruptionBudgets(ns).UpdateStatus(requestPodDisruptionBudget)
+	c.Validate(t, receivedPodDisruptionBudget, err)
+}
+
+func TestDeletePodDisruptionBudget(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request: testRequest{
+			Method: "DELETE",
+			Path:   testapi.Extensions.ResourcePath(getPodDisruptionBudgetResourceName(), ns, "foo"),
+			Query:  buildQueryValues(nil),
+		},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup(t).Extensions().PodDisruptionBudgets(ns).Delete("foo", nil)
+	c.Validate(t, nil, err)
+}
@@ -38,6 +38,7 @@ type PodInterface interface {
 	Update(pod *api.Pod) (*api.Pod, error)
 	Watch(label labels.Selector, field fields.Selector, opts unversioned.ListOptions) (watch.Interface, error)
 	Bind(binding *api.Binding) error
+	Evict(eviction *api.Eviction) error
 	UpdateStatus(pod *api.Pod) (*api.Pod, error)
 	GetLogs(name string, opts *api.PodLogOptions) *Request
 }
@@ -114,6 +115,12 @@ func (c *pods) Bind(binding *api.Binding) error {
 	return c.r.Post().Namespace(c.ns).Resource("pods").Name(binding.Name).SubResource("binding").Body(binding).Do().Error()
 }
 
+// Evict submits a policy eviction request for the pod named in the eviction. The pod is deleted
+// only if doing so does not violate the pod disruption budget covering it.
+func (c *pods) Evict(eviction *api.Eviction) error {
+	return c.r.Post().Namespace(c.ns).Resource("pods").Name(eviction.Name).SubResource("eviction").Body(eviction).Do().Error()
+}
+
 // UpdateStatus takes the name of the pod and the new status.  Returns the server's representation of the pod, and an error, if it occurs.
 func (c *pods) UpdateStatus(pod *api.Pod) (result *api.Pod, err error) {
 	result = &api.Pod{}
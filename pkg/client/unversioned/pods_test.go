@@ -142,6 +142,17 @@ func TestDeletePod(t *testing.T) {
 	c.Validate(t, nil, err)
 }
 
+func TestEvictPod(t *testing.T) {
+	ns := api.NamespaceDefault
+	eviction := &api.Eviction{ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: ns}}
+	c := &testClient{
+		Request:  testRequest{Method: "POST", Path: testapi.Default.ResourcePath("pods", ns, "foo") + "/eviction", Query: buildQueryValues(nil), Body: eviction},
+		Response: Response{StatusCode: http.StatusOK},
+	}
+	err := c.Setup(t).Pods(ns).Evict(eviction)
+	c.Validate(t, nil, err)
+}
+
 func TestCreatePod(t *testing.T) {
 	ns := api.NamespaceDefault
 	requestPod := &api.Pod{
@@ -82,6 +82,17 @@ func NewCreateAction(resource, namespace string, object runtime.Object) CreateAc
 	return action
 }
 
+func NewCreateSubresourceAction(resource, subresource, namespace string, object runtime.Object) CreateActionImpl {
+	action := CreateActionImpl{}
+	action.Verb = "create"
+	action.Resource = resource
+	action.Subresource = subresource
+	action.Namespace = namespace
+	action.Object = object
+
+	return action
+}
+
 func NewRootUpdateAction(resource string, object runtime.Object) UpdateActionImpl {
 	action := UpdateActionImpl{}
 	action.Verb = "update"
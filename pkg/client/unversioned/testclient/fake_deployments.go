@@ -90,3 +90,8 @@ func (c *FakeDeployments) Delete(name string, options *api.DeleteOptions) error
 func (c *FakeDeployments) Watch(label labels.Selector, field fields.Selector, opts unversioned.ListOptions) (watch.Interface, error) {
 	return c.Fake.InvokesWatch(NewWatchAction("deployments", c.Namespace, label, field, opts))
 }
+
+func (c *FakeDeployments) Rollback(deploymentRollback *extensions.DeploymentRollback) error {
+	_, err := c.Fake.Invokes(NewCreateSubresourceAction("deployments", "rollback", c.Namespace, deploymentRollback), deploymentRollback)
+	return err
+}
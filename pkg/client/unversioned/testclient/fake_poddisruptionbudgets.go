@@ -0,0 +1,87 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testclient
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// FakePodDisruptionBudgets implements PodDisruptionBudgetInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the method you want to test easier.
+type FakePodDisruptionBudgets struct {
+	Fake      *FakeExperimental
+	Namespace string
+}
+
+func (c *FakePodDisruptionBudgets) Get(name string) (*extensions.PodDisruptionBudget, error) {
+	obj, err := c.Fake.Invokes(NewGetAction("poddisruptionbudgets", c.Namespace, name), &extensions.PodDisruptionBudget{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*extensions.PodDisruptionBudget), err
+}
+
+func (c *FakePodDisruptionBudgets) List(label labels.Selector, fields fields.Selector) (*extensions.PodDisruptionBudgetList, error) {
+	obj, err := c.Fake.Invokes(NewListAction("poddisruptionbudgets", c.Namespace, label, nil), &extensions.PodDisruptionBudgetList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*extensions.PodDisruptionBudgetList), err
+}
+
+func (c *FakePodDisruptionBudgets) Create(podDisruptionBudget *extensions.PodDisruptionBudget) (*extensions.PodDisruptionBudget, error) {
+	obj, err := c.Fake.Invokes(NewCreateAction("poddisruptionbudgets", c.Namespace, podDisruptionBudget), podDisruptionBudget)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*extensions.PodDisruptionBudget), err
+}
+
+func (c *FakePodDisruptionBudgets) Update(podDisruptionBudget *extensions.PodDisruptionBudget) (*extensions.PodDisruptionBudget, error) {
+	obj, err := c.Fake.Invokes(NewUpdateAction("poddisruptionbudgets", c.Namespace, podDisruptionBudget), podDisruptionBudget)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*extensions.PodDisruptionBudget), err
+}
+
+func (c *FakePodDisruptionBudgets) Delete(name string, options *api.DeleteOptions) error {
+	_, err := c.Fake.Invokes(NewDeleteAction("poddisruptionbudgets", c.Namespace, name), &extensions.PodDisruptionBudget{})
+	return err
+}
+
+func (c *FakePodDisruptionBudgets) Watch(label labels.Selector, field fields.Selector, opts unversioned.ListOptions) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(NewWatchAction("poddisruptionbudgets", c.Namespace, label, field, opts))
+}
+
+func (c *FakePodDisruptionBudgets) UpdateStatus(podDisruptionBudget *extensions.PodDisruptionBudget) (result *extensions.PodDisruptionBudget, err error) {
+	obj, err := c.Fake.Invokes(NewUpdateSubresourceAction("poddisruptionbudgets", "status", c.Namespace, podDisruptionBudget), podDisruptionBudget)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*extensions.PodDisruptionBudget), err
+}
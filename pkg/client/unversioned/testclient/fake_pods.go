@@ -93,6 +93,17 @@ func (c *FakePods) Bind(binding *api.Binding) error {
 	return err
 }
 
+func (c *FakePods) Evict(eviction *api.Eviction) error {
+	action := CreateActionImpl{}
+	action.Verb = "create"
+	action.Resource = "pods"
+	action.Subresource = "eviction"
+	action.Object = eviction
+
+	_, err := c.Fake.Invokes(action, eviction)
+	return err
+}
+
 func (c *FakePods) UpdateStatus(pod *api.Pod) (*api.Pod, error) {
 	obj, err := c.Fake.Invokes(NewUpdateSubresourceAction("pods", "status", c.Namespace, pod), pod)
 	if obj == nil {
@@ -266,6 +266,10 @@ func (c *Fake) Secrets(namespace string) client.SecretsInterface {
 	return &FakeSecrets{Fake: c, Namespace: namespace}
 }
 
+func (c *Fake) ConfigMaps(namespace string) client.ConfigMapsInterface {
+	return &FakeConfigMaps{Fake: c, Namespace: namespace}
+}
+
 func (c *Fake) Namespaces() client.NamespaceInterface {
 	return &FakeNamespaces{Fake: c}
 }
@@ -343,6 +347,10 @@ func (c *FakeExperimental) Ingress(namespace string) client.IngressInterface {
 	return &FakeIngress{Fake: c, Namespace: namespace}
 }
 
+func (c *FakeExperimental) PodDisruptionBudgets(namespace string) client.PodDisruptionBudgetInterface {
+	return &FakePodDisruptionBudgets{Fake: c, Namespace: namespace}
+}
+
 type FakeDiscovery struct {
 	*Fake
 }
@@ -59,9 +59,11 @@ func (c *Config) transportConfig() *transport.Config {
 			KeyData:  c.KeyData,
 			Insecure: c.Insecure,
 		},
-		Username:    c.Username,
-		Password:    c.Password,
-		BearerToken: c.BearerToken,
+		Username:          c.Username,
+		Password:          c.Password,
+		BearerToken:       c.BearerToken,
+		Impersonate:       c.Impersonate,
+		ImpersonateGroups: c.ImpersonateGroups,
 	}
 }
 
@@ -19,6 +19,7 @@ package deployment
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"time"
 
 	"github.com/golang/glog"
@@ -74,6 +75,14 @@ func (d *DeploymentController) reconcileDeployments() []error {
 }
 
 func (d *DeploymentController) reconcileDeployment(deployment *extensions.Deployment) error {
+	if deployment.Spec.Paused {
+		// Nothing is rolled out while paused, but scaling and status are still handled.
+		return d.reconcilePausedDeployment(*deployment)
+	}
+	if deployment.Spec.RollbackTo != nil {
+		// The updated template is rolled out in the next sync.
+		return d.rollback(deployment)
+	}
 	switch deployment.Spec.Strategy.Type {
 	case extensions.RecreateDeploymentStrategyType:
 		return d.reconcileRecreateDeployment(*deployment)
@@ -84,8 +93,48 @@ func (d *DeploymentController) reconcileDeployment(deployment *extensions.Deploy
 }
 
 func (d *DeploymentController) reconcileRecreateDeployment(deployment extensions.Deployment) error {
-	// TODO: implement me.
-	return nil
+	newRC, err := d.getNewRC(deployment)
+	if err != nil {
+		return err
+	}
+
+	oldRCs, err := d.getOldRCs(deployment)
+	if err != nil {
+		return err
+	}
+
+	allRCs := append(oldRCs, newRC)
+
+	// Scale down all old RCs to zero.
+	scaledDown, err := d.scaleDownOldRCsForRecreate(oldRCs, deployment)
+	if err != nil {
+		return err
+	}
+	if scaledDown {
+		// Update DeploymentStatus
+		return d.updateDeploymentStatus(allRCs, newRC, deployment)
+	}
+
+	// Do not bring up new pods until every pod of the old RCs is gone.
+	oldPodsCount, err := deploymentutil.GetActivePodCountForRCs(d.client, oldRCs)
+	if err != nil {
+		return fmt.Errorf("could not count pods of old replication controllers: %v", err)
+	}
+	if oldPodsCount > 0 {
+		glog.V(4).Infof("Waiting for %d pods of old replication controllers of deployment %s/%s to terminate", oldPodsCount, deployment.Namespace, deployment.Name)
+		return d.syncDeploymentStatus(allRCs, newRC, deployment)
+	}
+
+	// Scale up the new RC.
+	scaledUp, err := d.scaleUpNewRCForRecreate(newRC, deployment)
+	if err != nil {
+		return err
+	}
+	if scaledUp {
+		// Update DeploymentStatus
+		return d.updateDeploymentStatus(allRCs, newRC, deployment)
+	}
+	return d.syncDeploymentStatus(allRCs, newRC, deployment)
 }
 
 func (d *DeploymentController) reconcileRollingUpdateDeployment(deployment extensions.Deployment) error {
@@ -120,10 +169,140 @@ func (d *DeploymentController) reconcileRollingUpdateDeployment(deployment exten
 		// Update DeploymentStatus
 		return d.updateDeploymentStatus(allRCs, newRC, deployment)
 	}
-	// TODO: raise an event, neither scaled up nor down.
+	// Neither scaled up nor down; keep the rollout conditions current.
+	return d.syncDeploymentStatus(allRCs, newRC, deployment)
+}
+
+// reconcilePausedDeployment keeps the current mix of old and new RCs of a paused
+// deployment. When the deployment is resized, the RCs that are running pods are
+// scaled in proportion to their current size.
+func (d *DeploymentController) reconcilePausedDeployment(deployment extensions.Deployment) error {
+	newRC, err := deploymentutil.GetNewRC(deployment, d.client)
+	if err != nil {
+		return err
+	}
+	allRCs, err := deploymentutil.GetAllOldRCs(deployment, d.client)
+	if err != nil {
+		return err
+	}
+	if newRC != nil {
+		// Keep the new RC first so that it is the one getting any rounding leftover.
+		allRCs = append([]*api.ReplicationController{newRC}, allRCs...)
+	}
+
+	if err := d.scaleRCsProportionally(allRCs, newRC, deployment); err != nil {
+		return err
+	}
+	return d.syncDeploymentStatus(allRCs, newRC, deployment)
+}
+
+// scaleRCsProportionally resizes the active RCs among the given ones so that
+// they add up to the replicas of the deployment.
+func (d *DeploymentController) scaleRCsProportionally(allRCs []*api.ReplicationController, newRC *api.ReplicationController, deployment extensions.Deployment) error {
+	activeRCs := []*api.ReplicationController{}
+	for _, rc := range allRCs {
+		if rc.Spec.Replicas > 0 {
+			activeRCs = append(activeRCs, rc)
+		}
+	}
+	if len(activeRCs) == 0 {
+		// Nothing is running; the new RC, if any, takes all the replicas.
+		if newRC == nil || newRC.Spec.Replicas == deployment.Spec.Replicas {
+			return nil
+		}
+		_, err := d.scaleRCAndRecordEvent(newRC, deployment.Spec.Replicas, deployment)
+		return err
+	}
+	if deploymentutil.GetReplicaCountForRCs(activeRCs) == deployment.Spec.Replicas {
+		// Scaling not required.
+		return nil
+	}
+	for i, replicas := range getProportionalReplicas(activeRCs, deployment.Spec.Replicas) {
+		if activeRCs[i].Spec.Replicas == replicas {
+			continue
+		}
+		if _, err := d.scaleRCAndRecordEvent(activeRCs[i], replicas, deployment); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// getProportionalReplicas returns the sizes that make the given RCs add up to
+// replicas while keeping their current ratio. The rounding leftover goes to
+// the first RC. rcs must have at least one replica in total.
+func getProportionalReplicas(rcs []*api.ReplicationController, replicas int) []int {
+	total := deploymentutil.GetReplicaCountForRCs(rcs)
+	sizes := make([]int, len(rcs))
+	assigned := 0
+	for i, rc := range rcs {
+		sizes[i] = rc.Spec.Replicas * replicas / total
+		assigned += sizes[i]
+	}
+	sizes[0] += replicas - assigned
+	return sizes
+}
+
+// rollback replaces the pod template of the given deployment with the template
+// of the RC at the revision requested by Spec.RollbackTo, and clears RollbackTo.
+// Revision 0 means the revision right before the current one.
+func (d *DeploymentController) rollback(deployment *extensions.Deployment) error {
+	newRC, err := deploymentutil.GetNewRC(*deployment, d.client)
+	if err != nil {
+		return err
+	}
+	allRCs, err := deploymentutil.GetAllOldRCs(*deployment, d.client)
+	if err != nil {
+		return err
+	}
+	if newRC != nil {
+		allRCs = append(allRCs, newRC)
+	}
+
+	toRevision := deployment.Spec.RollbackTo.Revision
+	if toRevision == 0 {
+		if toRevision = deploymentutil.LastRevision(allRCs); toRevision == 0 {
+			d.eventRecorder.Eventf(deployment, api.EventTypeWarning, "RollbackRevisionNotFound", "Unable to find last revision")
+			// Give up the rollback.
+			return d.clearRollbackTo(deployment)
+		}
+	}
+	for _, rc := range allRCs {
+		v, err := deploymentutil.Revision(rc)
+		if err != nil {
+			glog.V(4).Infof("Unable to extract revision from replication controller %s: %v", rc.Name, err)
+			continue
+		}
+		if v != toRevision {
+			continue
+		}
+		if newRC != nil && rc.Name == newRC.Name {
+			d.eventRecorder.Eventf(deployment, api.EventTypeWarning, "RollbackTemplateUnchanged", "The rollback to revision %d is a no-op since it is the current revision", toRevision)
+			return d.clearRollbackTo(deployment)
+		}
+		template := *rc.Spec.Template
+		// Drop the label the controller added to tell the RCs of this deployment apart.
+		template.Labels = deploymentutil.CloneAndRemoveLabel(template.Labels, deployment.Spec.UniqueLabelKey)
+		deployment.Spec.Template = template
+		deployment.Spec.RollbackTo = nil
+		if _, err := d.updateDeployment(deployment); err != nil {
+			return err
+		}
+		d.eventRecorder.Eventf(deployment, api.EventTypeNormal, "DeploymentRollback", "Rolled back deployment %s to revision %d", deployment.Name, toRevision)
+		return nil
+	}
+	d.eventRecorder.Eventf(deployment, api.EventTypeWarning, "RollbackRevisionNotFound", "Unable to find revision %d", toRevision)
+	// Give up the rollback.
+	return d.clearRollbackTo(deployment)
+}
+
+// clearRollbackTo removes the rollback request from the given deployment.
+func (d *DeploymentController) clearRollbackTo(deployment *extensions.Deployment) error {
+	deployment.Spec.RollbackTo = nil
+	_, err := d.updateDeployment(deployment)
+	return err
+}
+
 func (d *DeploymentController) getOldRCs(deployment extensions.Deployment) ([]*api.ReplicationController, error) {
 	return deploymentutil.GetOldRCs(deployment, d.client)
 }
@@ -131,9 +310,20 @@ func (d *DeploymentController) getOldRCs(deployment extensions.Deployment) ([]*a
 // Returns an RC that matches the intent of the given deployment.
 // It creates a new RC if required.
 func (d *DeploymentController) getNewRC(deployment extensions.Deployment) (*api.ReplicationController, error) {
+	allOldRCs, err := deploymentutil.GetAllOldRCs(deployment, d.client)
+	if err != nil {
+		return nil, err
+	}
+	// The new RC is always at the latest revision.
+	newRevision := deploymentutil.MaxRevision(allOldRCs) + 1
+
 	existingNewRC, err := deploymentutil.GetNewRC(deployment, d.client)
-	if err != nil || existingNewRC != nil {
-		return existingNewRC, err
+	if err != nil {
+		return nil, err
+	}
+	if existingNewRC != nil {
+		// An old RC becomes the new RC again after a rollback; move it to the latest revision.
+		return d.updateRCRevision(existingNewRC, newRevision)
 	}
 	// new RC does not exist, create one.
 	namespace := deployment.ObjectMeta.Namespace
@@ -146,6 +336,9 @@ func (d *DeploymentController) getNewRC(deployment extensions.Deployment) (*api.
 		ObjectMeta: api.ObjectMeta{
 			GenerateName: deployment.Name + "-",
 			Namespace:    namespace,
+			Annotations: map[string]string{
+				deploymentutil.RevisionAnnotation: strconv.FormatInt(newRevision, 10),
+			},
 		},
 		Spec: api.ReplicationControllerSpec{
 			Replicas: 0,
@@ -155,11 +348,26 @@ func (d *DeploymentController) getNewRC(deployment extensions.Deployment) (*api.
 	}
 	createdRC, err := d.client.ReplicationControllers(namespace).Create(&newRC)
 	if err != nil {
+		d.setReplicaFailure(deployment, deploymentutil.FailedRCCreateReason, fmt.Sprintf("Failed to create new replication controller: %v", err))
 		return nil, fmt.Errorf("error creating replication controller: %v", err)
 	}
 	return createdRC, nil
 }
 
+// updateRCRevision sets the revision of the given RC to the given revision,
+// unless the RC is already at that or a later revision.
+func (d *DeploymentController) updateRCRevision(rc *api.ReplicationController, revision int64) (*api.ReplicationController, error) {
+	if v, err := deploymentutil.Revision(rc); err == nil && v >= revision {
+		return rc, nil
+	}
+	if rc.Annotations == nil {
+		rc.Annotations = make(map[string]string)
+	}
+	rc.Annotations[deploymentutil.RevisionAnnotation] = strconv.FormatInt(revision, 10)
+	// TODO: Using client for now, update to use store when it is ready.
+	return d.client.ReplicationControllers(rc.ObjectMeta.Namespace).Update(rc)
+}
+
 func (d *DeploymentController) reconcileNewRC(allRCs []*api.ReplicationController, newRC *api.ReplicationController, deployment extensions.Deployment) (bool, error) {
 	if newRC.Spec.Replicas == deployment.Spec.Replicas {
 		// Scaling not required.
@@ -200,12 +408,9 @@ func (d *DeploymentController) reconcileOldRCs(allRCs []*api.ReplicationControll
 		// Cant scale down further
 		return false, nil
 	}
-	maxUnavailable, isPercent, err := util.GetIntOrPercentValue(&deployment.Spec.Strategy.RollingUpdate.MaxUnavailable)
+	maxUnavailable, err := deploymentutil.MaxUnavailable(deployment)
 	if err != nil {
-		return false, fmt.Errorf("invalid value for MaxUnavailable: %v", err)
-	}
-	if isPercent {
-		maxUnavailable = util.GetValueFromPercent(maxUnavailable, deployment.Spec.Replicas)
+		return false, err
 	}
 	// Check if we can scale down.
 	minAvailable := deployment.Spec.Replicas - maxUnavailable
@@ -241,29 +446,161 @@ func (d *DeploymentController) reconcileOldRCs(allRCs []*api.ReplicationControll
 	return true, err
 }
 
+// scaleDownOldRCsForRecreate scales down all old RCs to zero.
+// Returns true if any of them was scaled.
+func (d *DeploymentController) scaleDownOldRCsForRecreate(oldRCs []*api.ReplicationController, deployment extensions.Deployment) (bool, error) {
+	scaled := false
+	for _, rc := range oldRCs {
+		if rc.Spec.Replicas == 0 {
+			// Already scaled down.
+			continue
+		}
+		_, err := d.scaleRCAndRecordEvent(rc, 0, deployment)
+		if err != nil {
+			return false, err
+		}
+		scaled = true
+	}
+	return scaled, nil
+}
+
+// scaleUpNewRCForRecreate scales the new RC to the desired number of replicas
+// of the deployment in one step. Returns true if the RC was scaled.
+func (d *DeploymentController) scaleUpNewRCForRecreate(newRC *api.ReplicationController, deployment extensions.Deployment) (bool, error) {
+	if newRC.Spec.Replicas == deployment.Spec.Replicas {
+		// Scaling not required.
+		return false, nil
+	}
+	_, err := d.scaleRCAndRecordEvent(newRC, deployment.Spec.Replicas, deployment)
+	return true, err
+}
+
 func (d *DeploymentController) updateDeploymentStatus(allRCs []*api.ReplicationController, newRC *api.ReplicationController, deployment extensions.Deployment) error {
-	totalReplicas := deploymentutil.GetReplicaCountForRCs(allRCs)
-	updatedReplicas := deploymentutil.GetReplicaCountForRCs([]*api.ReplicationController{newRC})
+	newStatus, err := d.calculateStatus(allRCs, newRC, deployment)
+	if err != nil {
+		return err
+	}
 	newDeployment := deployment
-	// TODO: Reconcile this with API definition. API definition talks about ready pods, while this just computes created pods.
-	newDeployment.Status = extensions.DeploymentStatus{
-		Replicas:        totalReplicas,
-		UpdatedReplicas: updatedReplicas,
+	newDeployment.Status = newStatus
+	_, err = d.client.Extensions().Deployments(deployment.ObjectMeta.Namespace).UpdateStatus(&newDeployment)
+	return err
+}
+
+// syncDeploymentStatus updates the status of the given deployment only if it
+// is out of date, e.g. when the progress deadline passed while nothing was scaled.
+func (d *DeploymentController) syncDeploymentStatus(allRCs []*api.ReplicationController, newRC *api.ReplicationController, deployment extensions.Deployment) error {
+	newStatus, err := d.calculateStatus(allRCs, newRC, deployment)
+	if err != nil {
+		return err
 	}
-	_, err := d.client.Extensions().Deployments(deployment.ObjectMeta.Namespace).UpdateStatus(&newDeployment)
+	if api.Semantic.DeepEqual(newStatus, deployment.Status) {
+		return nil
+	}
+	newDeployment := deployment
+	newDeployment.Status = newStatus
+	_, err = d.client.Extensions().Deployments(deployment.ObjectMeta.Namespace).UpdateStatus(&newDeployment)
 	return err
 }
 
+// calculateStatus returns the status of a deployment made of the given RCs.
+// newRC may be nil, e.g. when a paused deployment has not created it yet.
+func (d *DeploymentController) calculateStatus(allRCs []*api.ReplicationController, newRC *api.ReplicationController, deployment extensions.Deployment) (extensions.DeploymentStatus, error) {
+	updatedReplicas := 0
+	if newRC != nil {
+		updatedReplicas = newRC.Spec.Replicas
+	}
+	availableReplicas, err := deploymentutil.GetAvailablePodsForRCs(d.client, allRCs)
+	if err != nil {
+		return deployment.Status, fmt.Errorf("could not find available pods: %v", err)
+	}
+	// TODO: Reconcile this with API definition. API definition talks about ready pods, while this just computes created pods.
+	status := extensions.DeploymentStatus{
+		ObservedGeneration: deployment.Generation,
+		Replicas:           deploymentutil.GetReplicaCountForRCs(allRCs),
+		UpdatedReplicas:    updatedReplicas,
+		AvailableReplicas:  availableReplicas,
+	}
+	if len(deployment.Status.Conditions) > 0 {
+		status.Conditions = make([]extensions.DeploymentCondition, len(deployment.Status.Conditions))
+		copy(status.Conditions, deployment.Status.Conditions)
+	}
+
+	maxUnavailable, err := deploymentutil.MaxUnavailable(deployment)
+	if err != nil {
+		return deployment.Status, err
+	}
+	if availableReplicas >= deployment.Spec.Replicas-maxUnavailable {
+		deploymentutil.SetDeploymentCondition(&status, deploymentutil.NewDeploymentCondition(extensions.DeploymentAvailable, api.ConditionTrue, deploymentutil.MinimumReplicasAvailable, "Deployment has minimum availability."))
+	} else {
+		deploymentutil.SetDeploymentCondition(&status, deploymentutil.NewDeploymentCondition(extensions.DeploymentAvailable, api.ConditionFalse, deploymentutil.MinimumReplicasUnavailable, "Deployment does not have minimum availability."))
+	}
+	setProgressingCondition(deployment, newRC, &status)
+	// The RCs could be listed and, if needed, scaled, so any earlier failure is resolved.
+	deploymentutil.RemoveDeploymentCondition(&status, extensions.DeploymentReplicaFailure)
+	return status, nil
+}
+
+// setProgressingCondition updates the Progressing condition in the given status
+// of a deployment that was computed from its RCs.
+func setProgressingCondition(deployment extensions.Deployment, newRC *api.ReplicationController, status *extensions.DeploymentStatus) {
+	if deployment.Spec.Paused {
+		deploymentutil.SetDeploymentCondition(status, deploymentutil.NewDeploymentCondition(extensions.DeploymentProgressing, api.ConditionUnknown, deploymentutil.PausedDeployReason, "Deployment is paused"))
+		return
+	}
+	currentCond := deploymentutil.GetDeploymentCondition(*status, extensions.DeploymentProgressing)
+	if currentCond != nil && currentCond.Reason == deploymentutil.PausedDeployReason {
+		// The progress clock starts over when a deployment is resumed.
+		deploymentutil.SetDeploymentCondition(status, deploymentutil.NewDeploymentCondition(extensions.DeploymentProgressing, api.ConditionUnknown, deploymentutil.ResumedDeployReason, "Deployment is resumed"))
+		currentCond = deploymentutil.GetDeploymentCondition(*status, extensions.DeploymentProgressing)
+	}
+	newRCName := ""
+	if newRC != nil {
+		newRCName = newRC.Name
+	}
+
+	switch {
+	case deploymentutil.DeploymentComplete(deployment, *status):
+		deploymentutil.SetDeploymentCondition(status, deploymentutil.NewDeploymentCondition(extensions.DeploymentProgressing, api.ConditionTrue, deploymentutil.NewRCAvailableReason, fmt.Sprintf("Replication controller %q has successfully progressed.", newRCName)))
+	case currentCond == nil || deployment.Generation > deployment.Status.ObservedGeneration || deploymentutil.DeploymentProgressing(deployment, *status):
+		// The spec changed or pods moved forward; restart the progress clock.
+		condition := deploymentutil.NewDeploymentCondition(extensions.DeploymentProgressing, api.ConditionTrue, deploymentutil.RCUpdatedReason, fmt.Sprintf("Replication controller %q is progressing.", newRCName))
+		if currentCond != nil {
+			if currentCond.Status == api.ConditionTrue {
+				condition.LastTransitionTime = currentCond.LastTransitionTime
+			}
+			deploymentutil.RemoveDeploymentCondition(status, extensions.DeploymentProgressing)
+		}
+		deploymentutil.SetDeploymentCondition(status, condition)
+	case deploymentutil.DeploymentTimedOut(deployment, *status):
+		deploymentutil.SetDeploymentCondition(status, deploymentutil.NewDeploymentCondition(extensions.DeploymentProgressing, api.ConditionFalse, deploymentutil.TimedOutReason, fmt.Sprintf("Replication controller %q has timed out progressing.", newRCName)))
+	}
+}
+
+// setReplicaFailure records in the status of the given deployment that one of
+// its RCs could not be created or scaled. Failures to record it are only logged,
+// since the caller reports the original error.
+func (d *DeploymentController) setReplicaFailure(deployment extensions.Deployment, reason, message string) {
+	newDeployment := deployment
+	newDeployment.Status.Conditions = make([]extensions.DeploymentCondition, len(deployment.Status.Conditions))
+	copy(newDeployment.Status.Conditions, deployment.Status.Conditions)
+	deploymentutil.SetDeploymentCondition(&newDeployment.Status, deploymentutil.NewDeploymentCondition(extensions.DeploymentReplicaFailure, api.ConditionTrue, reason, message))
+	if _, err := d.client.Extensions().Deployments(deployment.ObjectMeta.Namespace).UpdateStatus(&newDeployment); err != nil {
+		glog.Errorf("Failed to record replica failure of deployment %s/%s: %v", deployment.Namespace, deployment.Name, err)
+	}
+}
+
 func (d *DeploymentController) scaleRCAndRecordEvent(rc *api.ReplicationController, newScale int, deployment extensions.Deployment) (*api.ReplicationController, error) {
 	scalingOperation := "down"
 	if rc.Spec.Replicas < newScale {
 		scalingOperation = "up"
 	}
 	newRC, err := d.scaleRC(rc, newScale)
-	if err == nil {
-		d.eventRecorder.Eventf(&deployment, api.EventTypeNormal, "ScalingRC", "Scaled %s rc %s to %d", scalingOperation, rc.Name, newScale)
+	if err != nil {
+		d.setReplicaFailure(deployment, deploymentutil.FailedRCScaleReason, fmt.Sprintf("Failed to scale replication controller %q to %d: %v", rc.Name, newScale, err))
+		return newRC, err
 	}
-	return newRC, err
+	d.eventRecorder.Eventf(&deployment, api.EventTypeNormal, "ScalingRC", "Scaled %s rc %s to %d", scalingOperation, rc.Name, newScale)
+	return newRC, nil
 }
 
 func (d *DeploymentController) scaleRC(rc *api.ReplicationController, newScale int) (*api.ReplicationController, error) {
@@ -18,13 +18,17 @@ package deployment
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
+	"time"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	exp "k8s.io/kubernetes/pkg/apis/extensions"
 	"k8s.io/kubernetes/pkg/client/record"
 	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/runtime"
+	deploymentutil "k8s.io/kubernetes/pkg/util/deployment"
 	"k8s.io/kubernetes/pkg/util/intstr"
 )
 
@@ -229,6 +233,471 @@ func TestDeploymentController_reconcileOldRCs(t *testing.T) {
 	}
 }
 
+func TestDeploymentController_scaleDownOldRCsForRecreate(t *testing.T) {
+	tests := []struct {
+		oldReplicas   []int
+		scaleExpected bool
+	}{
+		{
+			oldReplicas:   []int{0},
+			scaleExpected: false,
+		},
+		{
+			oldReplicas:   []int{3},
+			scaleExpected: true,
+		},
+		{
+			oldReplicas:   []int{0, 2, 5},
+			scaleExpected: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Logf("executing scenario %d", i)
+		oldRcs := []*api.ReplicationController{}
+		expectedUpdates := 0
+		for j, replicas := range test.oldReplicas {
+			oldRcs = append(oldRcs, rc(fmt.Sprintf("foo-v%d", j), replicas))
+			if replicas > 0 {
+				expectedUpdates++
+			}
+		}
+		deployment := deployment("foo", 10, intstr.FromInt(0), intstr.FromInt(0))
+		deployment.Spec.Strategy = exp.DeploymentStrategy{Type: exp.RecreateDeploymentStrategyType}
+		fake := &testclient.Fake{}
+		controller := &DeploymentController{
+			client:        fake,
+			eventRecorder: &record.FakeRecorder{},
+		}
+		scaled, err := controller.scaleDownOldRCsForRecreate(oldRcs, deployment)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if scaled != test.scaleExpected {
+			t.Errorf("expected scaling %v, got %v", test.scaleExpected, scaled)
+			continue
+		}
+		if len(fake.Actions()) != expectedUpdates {
+			t.Errorf("expected %d actions during scale, got: %v", expectedUpdates, fake.Actions())
+			continue
+		}
+		for _, action := range fake.Actions() {
+			updated := action.(testclient.UpdateAction).GetObject().(*api.ReplicationController)
+			if updated.Spec.Replicas != 0 {
+				t.Errorf("expected update to 0 replicas, got %d", updated.Spec.Replicas)
+			}
+		}
+	}
+}
+
+func TestDeploymentController_scaleUpNewRCForRecreate(t *testing.T) {
+	tests := []struct {
+		deploymentReplicas int
+		newReplicas        int
+		scaleExpected      bool
+	}{
+		{
+			deploymentReplicas: 10,
+			newReplicas:        10,
+			scaleExpected:      false,
+		},
+		{
+			deploymentReplicas: 10,
+			newReplicas:        0,
+			scaleExpected:      true,
+		},
+		{
+			deploymentReplicas: 5,
+			newReplicas:        8,
+			scaleExpected:      true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Logf("executing scenario %d", i)
+		newRc := rc("foo-v2", test.newReplicas)
+		deployment := deployment("foo", test.deploymentReplicas, intstr.FromInt(0), intstr.FromInt(0))
+		deployment.Spec.Strategy = exp.DeploymentStrategy{Type: exp.RecreateDeploymentStrategyType}
+		fake := &testclient.Fake{}
+		controller := &DeploymentController{
+			client:        fake,
+			eventRecorder: &record.FakeRecorder{},
+		}
+		scaled, err := controller.scaleUpNewRCForRecreate(newRc, deployment)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if !test.scaleExpected {
+			if scaled || len(fake.Actions()) > 0 {
+				t.Errorf("unexpected scaling: %v", fake.Actions())
+			}
+			continue
+		}
+		if !scaled {
+			t.Errorf("expected scaling to occur")
+			continue
+		}
+		if len(fake.Actions()) != 1 {
+			t.Errorf("expected 1 action during scale, got: %v", fake.Actions())
+			continue
+		}
+		updated := fake.Actions()[0].(testclient.UpdateAction).GetObject().(*api.ReplicationController)
+		if e, a := test.deploymentReplicas, updated.Spec.Replicas; e != a {
+			t.Errorf("expected update to %d replicas, got %d", e, a)
+		}
+	}
+}
+
+func TestDeploymentController_rollback(t *testing.T) {
+	tests := []struct {
+		// revisions of the RCs that belong to the deployment; the last one is the new RC.
+		revisions        []int64
+		rollbackTo       int64
+		expectedTemplate string
+	}{
+		{
+			// Roll back to an explicit revision.
+			revisions:        []int64{1, 2, 3},
+			rollbackTo:       1,
+			expectedTemplate: "image-1",
+		},
+		{
+			// Revision 0 means the last revision.
+			revisions:        []int64{1, 2, 3},
+			rollbackTo:       0,
+			expectedTemplate: "image-2",
+		},
+		{
+			// Unknown revisions leave the template alone.
+			revisions:        []int64{1, 2, 3},
+			rollbackTo:       5,
+			expectedTemplate: "image-3",
+		},
+		{
+			// Rolling back to the current revision is a no-op.
+			revisions:        []int64{1, 2, 3},
+			rollbackTo:       3,
+			expectedTemplate: "image-3",
+		},
+		{
+			// There is no last revision to roll back to.
+			revisions:        []int64{1},
+			rollbackTo:       0,
+			expectedTemplate: "image-1",
+		},
+	}
+
+	for i, test := range tests {
+		t.Logf("executing scenario %d", i)
+		d := deployment("foo", 1, intstr.FromInt(0), intstr.FromInt(1))
+		d.Spec.Selector = map[string]string{"name": "foo"}
+		d.Spec.UniqueLabelKey = "hash"
+		rcList := &api.ReplicationControllerList{}
+		for _, revision := range test.revisions {
+			d.Spec.Template = podTemplate(fmt.Sprintf("image-%d", revision))
+			newRc := rc(fmt.Sprintf("foo-%d", revision), 0)
+			template := deploymentutil.GetNewRCTemplate(d)
+			newRc.Spec.Template = &template
+			newRc.Annotations = map[string]string{deploymentutil.RevisionAnnotation: fmt.Sprintf("%d", revision)}
+			rcList.Items = append(rcList.Items, *newRc)
+		}
+		d.Spec.RollbackTo = &exp.RollbackConfig{Revision: test.rollbackTo}
+		fake := &testclient.Fake{}
+		fake.AddReactor("list", "replicationcontrollers", func(action testclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, rcList, nil
+		})
+		controller := &DeploymentController{
+			client:        fake,
+			eventRecorder: &record.FakeRecorder{},
+		}
+		if err := controller.rollback(&d); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		var updated *exp.Deployment
+		for _, action := range fake.Actions() {
+			if a, ok := action.(testclient.UpdateAction); ok {
+				updated = a.GetObject().(*exp.Deployment)
+			}
+		}
+		if updated == nil {
+			t.Errorf("expected the deployment to be updated; actions: %v", fake.Actions())
+			continue
+		}
+		if updated.Spec.RollbackTo != nil {
+			t.Errorf("expected rollbackTo to be cleared, got %v", updated.Spec.RollbackTo)
+		}
+		if e, a := test.expectedTemplate, updated.Spec.Template.Spec.Containers[0].Image; e != a {
+			t.Errorf("expected template with image %s, got %s", e, a)
+		}
+		if _, found := updated.Spec.Template.Labels[d.Spec.UniqueLabelKey]; found {
+			t.Errorf("expected unique label to be removed from template labels: %v", updated.Spec.Template.Labels)
+		}
+	}
+}
+
+func TestGetProportionalReplicas(t *testing.T) {
+	tests := []struct {
+		current  []int
+		replicas int
+		expected []int
+	}{
+		{
+			current:  []int{5},
+			replicas: 8,
+			expected: []int{8},
+		},
+		{
+			current:  []int{2, 2},
+			replicas: 8,
+			expected: []int{4, 4},
+		},
+		{
+			// The rounding leftover goes to the first RC.
+			current:  []int{1, 1, 1},
+			replicas: 5,
+			expected: []int{3, 1, 1},
+		},
+		{
+			current:  []int{6, 3},
+			replicas: 3,
+			expected: []int{2, 1},
+		},
+		{
+			current:  []int{3, 1},
+			replicas: 0,
+			expected: []int{0, 0},
+		},
+	}
+	for i, test := range tests {
+		rcs := []*api.ReplicationController{}
+		for j, replicas := range test.current {
+			rcs = append(rcs, rc(fmt.Sprintf("foo-%d", j), replicas))
+		}
+		if e, a := test.expected, getProportionalReplicas(rcs, test.replicas); !reflect.DeepEqual(e, a) {
+			t.Errorf("%d: expected %v, got %v", i, e, a)
+		}
+	}
+}
+
+func TestDeploymentController_reconcilePausedDeployment(t *testing.T) {
+	tests := []struct {
+		oldReplicas int
+		newReplicas int
+		replicas    int
+		// expected replicas of the RCs after the sync, or -1 if the RC must not be scaled.
+		expectedOld int
+		expectedNew int
+	}{
+		{
+			// Nothing changes while the deployment keeps its size.
+			oldReplicas: 2,
+			newReplicas: 1,
+			replicas:    3,
+			expectedOld: -1,
+			expectedNew: -1,
+		},
+		{
+			oldReplicas: 2,
+			newReplicas: 1,
+			replicas:    6,
+			expectedOld: 4,
+			expectedNew: 2,
+		},
+		{
+			oldReplicas: 4,
+			newReplicas: 2,
+			replicas:    3,
+			expectedOld: 2,
+			expectedNew: 1,
+		},
+		{
+			// The old RC is not scaled back up once it is gone.
+			oldReplicas: 0,
+			newReplicas: 2,
+			replicas:    4,
+			expectedOld: -1,
+			expectedNew: 4,
+		},
+	}
+
+	for i, test := range tests {
+		t.Logf("executing scenario %d", i)
+		d := deployment("foo", test.replicas, intstr.FromInt(0), intstr.FromInt(1))
+		d.Spec.Selector = map[string]string{"name": "foo"}
+		d.Spec.UniqueLabelKey = "hash"
+		d.Spec.Paused = true
+		d.Spec.Template = podTemplate("image-2")
+		newTemplate := deploymentutil.GetNewRCTemplate(d)
+		newRc := rc("foo-new", test.newReplicas)
+		newRc.Spec.Template = &newTemplate
+		oldTemplate := podTemplate("image-1")
+		oldRc := rc("foo-old", test.oldReplicas)
+		oldRc.Spec.Template = &oldTemplate
+		rcList := &api.ReplicationControllerList{Items: []api.ReplicationController{*oldRc, *newRc}}
+
+		fake := &testclient.Fake{}
+		fake.AddReactor("list", "replicationcontrollers", func(action testclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, rcList, nil
+		})
+		controller := &DeploymentController{
+			client:        fake,
+			eventRecorder: &record.FakeRecorder{},
+		}
+		if err := controller.reconcileDeployment(&d); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		scaled := map[string]int{}
+		for _, action := range fake.Actions() {
+			if action.GetVerb() == "create" {
+				t.Errorf("expected no RC to be created for a paused deployment, got %v", action)
+			}
+			if a, ok := action.(testclient.UpdateAction); ok {
+				if updated, ok := a.GetObject().(*api.ReplicationController); ok {
+					scaled[updated.Name] = updated.Spec.Replicas
+				}
+			}
+		}
+		for name, expected := range map[string]int{"foo-old": test.expectedOld, "foo-new": test.expectedNew} {
+			actual, found := scaled[name]
+			if expected == -1 {
+				if found {
+					t.Errorf("expected %s not to be scaled, got %d", name, actual)
+				}
+				continue
+			}
+			if !found || actual != expected {
+				t.Errorf("expected %s to be scaled to %d, got %d (found: %v)", name, expected, actual, found)
+			}
+		}
+	}
+}
+
+func TestSetProgressingCondition(t *testing.T) {
+	deadline := 60
+	longAgo := unversioned.NewTime(time.Now().Add(-time.Hour))
+	progressing := func(status api.ConditionStatus, reason string) *exp.DeploymentCondition {
+		return &exp.DeploymentCondition{
+			Type:           exp.DeploymentProgressing,
+			Status:         status,
+			Reason:         reason,
+			LastUpdateTime: longAgo,
+		}
+	}
+	tests := []struct {
+		name      string
+		paused    bool
+		deadline  *int
+		current   *exp.DeploymentCondition
+		oldStatus exp.DeploymentStatus
+		newStatus exp.DeploymentStatus
+
+		expectedStatus api.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:           "first observation starts the clock",
+			deadline:       &deadline,
+			newStatus:      exp.DeploymentStatus{Replicas: 3, UpdatedReplicas: 1},
+			expectedStatus: api.ConditionTrue,
+			expectedReason: deploymentutil.RCUpdatedReason,
+		},
+		{
+			name:           "complete rollout",
+			deadline:       &deadline,
+			current:        progressing(api.ConditionTrue, deploymentutil.RCUpdatedReason),
+			newStatus:      exp.DeploymentStatus{Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			expectedStatus: api.ConditionTrue,
+			expectedReason: deploymentutil.NewRCAvailableReason,
+		},
+		{
+			name:           "progress resets the clock",
+			deadline:       &deadline,
+			current:        progressing(api.ConditionTrue, deploymentutil.RCUpdatedReason),
+			oldStatus:      exp.DeploymentStatus{Replicas: 3, UpdatedReplicas: 1},
+			newStatus:      exp.DeploymentStatus{Replicas: 3, UpdatedReplicas: 2},
+			expectedStatus: api.ConditionTrue,
+			expectedReason: deploymentutil.RCUpdatedReason,
+		},
+		{
+			name:           "no progress past the deadline",
+			deadline:       &deadline,
+			current:        progressing(api.ConditionTrue, deploymentutil.RCUpdatedReason),
+			oldStatus:      exp.DeploymentStatus{Replicas: 3, UpdatedReplicas: 1},
+			newStatus:      exp.DeploymentStatus{Replicas: 3, UpdatedReplicas: 1},
+			expectedStatus: api.ConditionFalse,
+			expectedReason: deploymentutil.TimedOutReason,
+		},
+		{
+			name:           "no deadline",
+			current:        progressing(api.ConditionTrue, deploymentutil.RCUpdatedReason),
+			oldStatus:      exp.DeploymentStatus{Replicas: 3, UpdatedReplicas: 1},
+			newStatus:      exp.DeploymentStatus{Replicas: 3, UpdatedReplicas: 1},
+			expectedStatus: api.ConditionTrue,
+			expectedReason: deploymentutil.RCUpdatedReason,
+		},
+		{
+			name:           "paused deployments do not time out",
+			paused:         true,
+			deadline:       &deadline,
+			current:        progressing(api.ConditionTrue, deploymentutil.RCUpdatedReason),
+			oldStatus:      exp.DeploymentStatus{Replicas: 3, UpdatedReplicas: 1},
+			newStatus:      exp.DeploymentStatus{Replicas: 3, UpdatedReplicas: 1},
+			expectedStatus: api.ConditionUnknown,
+			expectedReason: deploymentutil.PausedDeployReason,
+		},
+		{
+			name:           "resuming restarts the clock",
+			deadline:       &deadline,
+			current:        progressing(api.ConditionUnknown, deploymentutil.PausedDeployReason),
+			oldStatus:      exp.DeploymentStatus{Replicas: 3, UpdatedReplicas: 1},
+			newStatus:      exp.DeploymentStatus{Replicas: 3, UpdatedReplicas: 1},
+			expectedStatus: api.ConditionUnknown,
+			expectedReason: deploymentutil.ResumedDeployReason,
+		},
+	}
+
+	for _, test := range tests {
+		d := deployment("foo", 3, intstr.FromInt(1), intstr.FromInt(0))
+		d.Spec.Paused = test.paused
+		d.Spec.ProgressDeadlineSeconds = test.deadline
+		d.Status = test.oldStatus
+		status := test.newStatus
+		if test.current != nil {
+			d.Status.Conditions = []exp.DeploymentCondition{*test.current}
+			status.Conditions = []exp.DeploymentCondition{*test.current}
+		}
+		setProgressingCondition(d, rc("foo-new", 3), &status)
+		condition := deploymentutil.GetDeploymentCondition(status, exp.DeploymentProgressing)
+		if condition == nil {
+			t.Errorf("%s: expected a Progressing condition", test.name)
+			continue
+		}
+		if condition.Status != test.expectedStatus || condition.Reason != test.expectedReason {
+			t.Errorf("%s: expected %s/%s, got %s/%s", test.name, test.expectedStatus, test.expectedReason, condition.Status, condition.Reason)
+		}
+	}
+}
+
+func podTemplate(image string) api.PodTemplateSpec {
+	return api.PodTemplateSpec{
+		ObjectMeta: api.ObjectMeta{
+			Labels: map[string]string{"name": "foo"},
+		},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{
+					Name:  "foo",
+					Image: image,
+				},
+			},
+		},
+	}
+}
+
 func rc(name string, replicas int) *api.ReplicationController {
 	return &api.ReplicationController{
 		ObjectMeta: api.ObjectMeta{
@@ -0,0 +1,408 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disruption
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/cache"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	replicationcontroller "k8s.io/kubernetes/pkg/controller/replication"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/workqueue"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// DeletionTimeout is how long a pod evicted through the API server stays
+// listed as disrupted in the budget status if its deletion is never observed.
+// After that the pod is counted again, so a failed deletion does not hold on
+// to a disruption forever.
+const DeletionTimeout = 2 * time.Minute
+
+// DisruptionController keeps the status of every pod disruption budget up to
+// date with the number of healthy pods it covers, so that evictions can tell
+// whether removing another pod would violate the budget.
+type DisruptionController struct {
+	kubeClient client.Interface
+
+	// To allow injection of updatePodDisruptionBudgetStatus for testing.
+	updateHandler func(pdb *extensions.PodDisruptionBudget) error
+	syncHandler   func(pdbKey string) error
+	// podStoreSynced returns true if the pod store has been synced at least once.
+	// Added as a member to the struct to allow injection for testing.
+	podStoreSynced func() bool
+
+	// A store of pod disruption budgets, populated by the pdbController
+	pdbStore cache.Store
+	// Watches changes to all pod disruption budgets
+	pdbController *framework.Controller
+
+	// A store of pods, populated by the podController
+	podStore cache.StoreToPodLister
+	// Watches changes to all pods
+	podController *framework.Controller
+
+	// A store of replication controllers, populated by the rcController. Used
+	// to work out how many pods a percentage budget applies to.
+	rcStore cache.StoreToReplicationControllerLister
+	// Watches changes to all replication controllers
+	rcController *framework.Controller
+
+	// Pod disruption budgets that need to be updated
+	queue *workqueue.Type
+
+	// To allow injection of a fake clock for testing the expiry of disrupted pods.
+	clock util.Clock
+}
+
+func NewDisruptionController(kubeClient client.Interface, resyncPeriod controller.ResyncPeriodFunc) *DisruptionController {
+	dc := &DisruptionController{
+		kubeClient: kubeClient,
+		queue:      workqueue.New(),
+		clock:      util.RealClock{},
+	}
+
+	dc.pdbStore, dc.pdbController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dc.kubeClient.Extensions().PodDisruptionBudgets(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(options unversioned.ListOptions) (watch.Interface, error) {
+				return dc.kubeClient.Extensions().PodDisruptionBudgets(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), options)
+			},
+		},
+		&extensions.PodDisruptionBudget{},
+		replicationcontroller.FullControllerResyncPeriod,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc: dc.enqueuePodDisruptionBudget,
+			UpdateFunc: func(old, cur interface{}) {
+				dc.enqueuePodDisruptionBudget(cur)
+			},
+			DeleteFunc: dc.enqueuePodDisruptionBudget,
+		},
+	)
+
+	dc.podStore.Store, dc.podController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dc.kubeClient.Pods(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(options unversioned.ListOptions) (watch.Interface, error) {
+				return dc.kubeClient.Pods(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), options)
+			},
+		},
+		&api.Pod{},
+		resyncPeriod(),
+		framework.ResourceEventHandlerFuncs{
+			AddFunc:    dc.addPod,
+			UpdateFunc: dc.updatePod,
+			DeleteFunc: dc.deletePod,
+		},
+	)
+
+	dc.rcStore.Store, dc.rcController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dc.kubeClient.ReplicationControllers(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(options unversioned.ListOptions) (watch.Interface, error) {
+				return dc.kubeClient.ReplicationControllers(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), options)
+			},
+		},
+		&api.ReplicationController{},
+		replicationcontroller.FullControllerResyncPeriod,
+		framework.ResourceEventHandlerFuncs{},
+	)
+
+	dc.updateHandler = dc.updatePodDisruptionBudgetStatus
+	dc.syncHandler = dc.syncPodDisruptionBudget
+	dc.podStoreSynced = dc.podController.HasSynced
+	return dc
+}
+
+// Run the main goroutine responsible for watching and syncing pod disruption budgets.
+func (dc *DisruptionController) Run(workers int, stopCh <-chan struct{}) {
+	defer util.HandleCrash()
+	go dc.pdbController.Run(stopCh)
+	go dc.podController.Run(stopCh)
+	go dc.rcController.Run(stopCh)
+	for i := 0; i < workers; i++ {
+		go util.Until(dc.worker, time.Second, stopCh)
+	}
+	<-stopCh
+	glog.Infof("Shutting down disruption controller")
+	dc.queue.ShutDown()
+}
+
+// getPodDisruptionBudgets returns the pod disruption budgets in the pod's
+// namespace whose selector matches the pod.
+func (dc *DisruptionController) getPodDisruptionBudgets(pod *api.Pod) []*extensions.PodDisruptionBudget {
+	var pdbs []*extensions.PodDisruptionBudget
+	if len(pod.Labels) == 0 {
+		return pdbs
+	}
+	for _, obj := range dc.pdbStore.List() {
+		pdb := obj.(*extensions.PodDisruptionBudget)
+		if pdb.Namespace != pod.Namespace {
+			continue
+		}
+		selector, err := extensions.PodSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			glog.Warningf("Invalid selector on pod disruption budget %s/%s: %v", pdb.Namespace, pdb.Name, err)
+			continue
+		}
+		if selector.Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		pdbs = append(pdbs, pdb)
+	}
+	if len(pdbs) > 1 {
+		glog.Warningf("Pod %s/%s is covered by more than one pod disruption budget, evictions of it will be refused", pod.Namespace, pod.Name)
+	}
+	return pdbs
+}
+
+func (dc *DisruptionController) enqueuePodBudgets(pod *api.Pod) {
+	for _, pdb := range dc.getPodDisruptionBudgets(pod) {
+		dc.enqueuePodDisruptionBudget(pdb)
+	}
+}
+
+// When a pod is created, enqueue the budgets covering it.
+func (dc *DisruptionController) addPod(obj interface{}) {
+	dc.enqueuePodBudgets(obj.(*api.Pod))
+}
+
+// When a pod is updated, enqueue the budgets covering it. If the labels of the
+// pod have changed we need to awaken the budgets covering the old pod as well.
+func (dc *DisruptionController) updatePod(old, cur interface{}) {
+	if api.Semantic.DeepEqual(old, cur) {
+		// A periodic relist will send update events for all known pods.
+		return
+	}
+	curPod := cur.(*api.Pod)
+	oldPod := old.(*api.Pod)
+	dc.enqueuePodBudgets(curPod)
+	if !api.Semantic.DeepEqual(curPod.Labels, oldPod.Labels) {
+		dc.enqueuePodBudgets(oldPod)
+	}
+}
+
+// When a pod is deleted, enqueue the budgets covering it.
+// obj could be an *api.Pod, or a DeletionFinalStateUnknown marker item.
+func (dc *DisruptionController) deletePod(obj interface{}) {
+	pod, ok := obj.(*api.Pod)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			glog.Errorf("Couldn't get object from tombstone %+v", obj)
+			return
+		}
+		pod, ok = tombstone.Obj.(*api.Pod)
+		if !ok {
+			glog.Errorf("Tombstone contained object that is not a pod %+v", obj)
+			return
+		}
+	}
+	dc.enqueuePodBudgets(pod)
+}
+
+// obj could be an *extensions.PodDisruptionBudget, or a DeletionFinalStateUnknown marker item.
+func (dc *DisruptionController) enqueuePodDisruptionBudget(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	dc.queue.Add(key)
+}
+
+// worker runs a worker thread that just dequeues items, processes them, and marks them done.
+// It enforces that the syncHandler is never invoked concurrently with the same key.
+func (dc *DisruptionController) worker() {
+	for {
+		func() {
+			key, quit := dc.queue.Get()
+			if quit {
+				return
+			}
+			defer dc.queue.Done(key)
+			if err := dc.syncHandler(key.(string)); err != nil {
+				glog.Errorf("Error syncing pod disruption budget: %v", err)
+			}
+		}()
+	}
+}
+
+// syncPodDisruptionBudget recomputes the status of the pod disruption budget
+// with the given key and writes it back if it changed. This function is not
+// meant to be invoked concurrently with the same key.
+func (dc *DisruptionController) syncPodDisruptionBudget(key string) error {
+	startTime := time.Now()
+	defer func() {
+		glog.V(4).Infof("Finished syncing pod disruption budget %q (%v)", key, time.Now().Sub(startTime))
+	}()
+
+	obj, exists, err := dc.pdbStore.GetByKey(key)
+	if !exists {
+		glog.V(4).Infof("Pod disruption budget has been deleted: %v", key)
+		return nil
+	}
+	if err != nil {
+		glog.Errorf("Unable to retrieve pod disruption budget %v from store: %v", key, err)
+		dc.queue.Add(key)
+		return err
+	}
+	pdb := *obj.(*extensions.PodDisruptionBudget)
+	if !dc.podStoreSynced() {
+		// Sleep so we give the pod reflector goroutine a chance to run.
+		time.Sleep(replicationcontroller.PodStoreSyncedPollPeriod)
+		glog.V(4).Infof("Waiting for pods controller to sync, requeuing pod disruption budget %v", pdb.Name)
+		dc.enqueuePodDisruptionBudget(&pdb)
+		return nil
+	}
+
+	selector, err := extensions.PodSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	podList, err := dc.podStore.Pods(pdb.Namespace).List(selector)
+	if err != nil {
+		glog.Errorf("Error getting pods for pod disruption budget %q: %v", key, err)
+		dc.queue.Add(key)
+		return err
+	}
+
+	// Pods that were evicted but whose deletion the pod store has not caught up
+	// with yet must not be counted, otherwise the budget would be restored and
+	// the same disruption allowed twice.
+	status := extensions.PodDisruptionBudgetStatus{
+		DisruptedPods: dc.pendingDisruptions(&pdb, podList.Items),
+	}
+	expectedPods, desiredHealthy, err := dc.getExpectedAndDesired(&pdb, podList.Items)
+	if err != nil {
+		// Fail safe: without a trustworthy count no disruptions are allowed.
+		glog.Warningf("Unable to compute desired healthy pods for pod disruption budget %q: %v", key, err)
+	} else {
+		status.ExpectedPods = expectedPods
+		status.DesiredHealthy = desiredHealthy
+		status.CurrentHealthy = countHealthyPods(podList.Items, status.DisruptedPods)
+		if allowed := status.CurrentHealthy - status.DesiredHealthy; allowed > 0 {
+			status.PodDisruptionsAllowed = allowed
+		}
+	}
+
+	// no need to update the budget if the status hasn't changed since last time
+	if api.Semantic.DeepEqual(pdb.Status, status) {
+		return nil
+	}
+	pdb.Status = status
+	if err := dc.updateHandler(&pdb); err != nil {
+		glog.Errorf("Failed to update pod disruption budget %v, requeuing.  Error: %v", pdb.Name, err)
+		dc.enqueuePodDisruptionBudget(&pdb)
+		return err
+	}
+	return nil
+}
+
+// getExpectedAndDesired returns the number of pods the budget expects to
+// exist and how many of them must stay healthy. An absolute minAvailable is
+// taken as is; a percentage is applied to the replica counts of the
+// replication controllers managing the covered pods, with each unmanaged pod
+// counting as one.
+func (dc *DisruptionController) getExpectedAndDesired(pdb *extensions.PodDisruptionBudget, pods []api.Pod) (expected, desired int, err error) {
+	value, isPercent, err := util.GetIntOrPercentValue(&pdb.Spec.MinAvailable)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !isPercent {
+		return len(pods), value, nil
+	}
+
+	controllers := map[string]int{}
+	for i := range pods {
+		rcs, err := dc.rcStore.GetPodControllers(&pods[i])
+		if err != nil || len(rcs) == 0 {
+			expected++
+			continue
+		}
+		if len(rcs) > 1 {
+			return 0, 0, fmt.Errorf("pod %q is managed by more than one replication controller", pods[i].Name)
+		}
+		controllers[rcs[0].Name] = rcs[0].Spec.Replicas
+	}
+	for _, replicas := range controllers {
+		expected += replicas
+	}
+	return expected, util.GetValueFromPercent(value, expected), nil
+}
+
+// pendingDisruptions returns the entries of the budget's disrupted pods that
+// still apply: the pod exists, is not yet being deleted and was evicted less
+// than DeletionTimeout ago. It returns nil if there are none.
+func (dc *DisruptionController) pendingDisruptions(pdb *extensions.PodDisruptionBudget, pods []api.Pod) map[string]unversioned.Time {
+	if len(pdb.Status.DisruptedPods) == 0 {
+		return nil
+	}
+	now := dc.clock.Now()
+	var pending map[string]unversioned.Time
+	for i := range pods {
+		evictedAt, found := pdb.Status.DisruptedPods[pods[i].Name]
+		if !found || pods[i].DeletionTimestamp != nil {
+			continue
+		}
+		if evictedAt.Add(DeletionTimeout).Before(now) {
+			glog.Warningf("Pod %s/%s was evicted %v ago but has not been deleted", pods[i].Namespace, pods[i].Name, now.Sub(evictedAt.Time))
+			continue
+		}
+		if pending == nil {
+			pending = make(map[string]unversioned.Time)
+		}
+		pending[pods[i].Name] = evictedAt
+	}
+	return pending
+}
+
+// countHealthyPods returns the number of ready pods that are neither being
+// deleted nor listed as disrupted.
+func countHealthyPods(pods []api.Pod, disruptedPods map[string]unversioned.Time) int {
+	healthy := 0
+	for i := range pods {
+		if _, disrupted := disruptedPods[pods[i].Name]; disrupted {
+			continue
+		}
+		if pods[i].DeletionTimestamp == nil && api.IsPodReady(&pods[i]) {
+			healthy++
+		}
+	}
+	return healthy
+}
+
+func (dc *DisruptionController) updatePodDisruptionBudgetStatus(pdb *extensions.PodDisruptionBudget) error {
+	_, err := dc.kubeClient.Extensions().PodDisruptionBudgets(pdb.Namespace).UpdateStatus(pdb)
+	return err
+}
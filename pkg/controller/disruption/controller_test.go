@@ -0,0 +1,290 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disruption
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+var alwaysReady = func() bool { return true }
+
+func newTestController() (*DisruptionController, *[]extensions.PodDisruptionBudget) {
+	client := client.NewOrDie(&client.Config{Host: "", GroupVersion: testapi.Default.GroupVersion()})
+	dc := NewDisruptionController(client, controller.NoResyncPeriodFunc)
+	dc.podStoreSynced = alwaysReady
+	updates := []extensions.PodDisruptionBudget{}
+	dc.updateHandler = func(pdb *extensions.PodDisruptionBudget) error {
+		updates = append(updates, *pdb)
+		return nil
+	}
+	return dc, &updates
+}
+
+func newPodDisruptionBudget(minAvailable intstr.IntOrString) *extensions.PodDisruptionBudget {
+	return &extensions.PodDisruptionBudget{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "foobar",
+			Namespace: api.NamespaceDefault,
+		},
+		Spec: extensions.PodDisruptionBudgetSpec{
+			MinAvailable: minAvailable,
+			Selector: &extensions.PodSelector{
+				MatchLabels: map[string]string{"foo": "bar"},
+			},
+		},
+	}
+}
+
+func newPod(name string, ready bool) *api.Pod {
+	status := api.ConditionFalse
+	if ready {
+		status = api.ConditionTrue
+	}
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: api.NamespaceDefault,
+			Labels:    map[string]string{"foo": "bar"},
+		},
+		Status: api.PodStatus{
+			Conditions: []api.PodCondition{{Type: api.PodReady, Status: status}},
+		},
+	}
+}
+
+func getKey(pdb *extensions.PodDisruptionBudget, t *testing.T) string {
+	key, err := controller.KeyFunc(pdb)
+	if err != nil {
+		t.Errorf("Unexpected error getting key for pod disruption budget %v: %v", pdb.Name, err)
+	}
+	return key
+}
+
+func TestSyncPodDisruptionBudget(t *testing.T) {
+	now := unversioned.Now()
+	testCases := map[string]struct {
+		minAvailable  intstr.IntOrString
+		readyPods     int
+		unreadyPods   int
+		deletingPods  int
+		rcReplicas    int
+		expectedState extensions.PodDisruptionBudgetStatus
+	}{
+		"no pods": {
+			minAvailable:  intstr.FromInt(1),
+			expectedState: extensions.PodDisruptionBudgetStatus{DesiredHealthy: 1},
+		},
+		"one disruption allowed": {
+			minAvailable:  intstr.FromInt(2),
+			readyPods:     3,
+			expectedState: extensions.PodDisruptionBudgetStatus{PodDisruptionsAllowed: 1, CurrentHealthy: 3, DesiredHealthy: 2, ExpectedPods: 3},
+		},
+		"unready and deleting pods are not healthy": {
+			minAvailable:  intstr.FromInt(2),
+			readyPods:     2,
+			unreadyPods:   1,
+			deletingPods:  1,
+			expectedState: extensions.PodDisruptionBudgetStatus{CurrentHealthy: 2, DesiredHealthy: 2, ExpectedPods: 4},
+		},
+		"percentage of unmanaged pods": {
+			minAvailable:  intstr.FromString("50%"),
+			readyPods:     4,
+			expectedState: extensions.PodDisruptionBudgetStatus{PodDisruptionsAllowed: 2, CurrentHealthy: 4, DesiredHealthy: 2, ExpectedPods: 4},
+		},
+		"percentage of controller replicas": {
+			minAvailable:  intstr.FromString("50%"),
+			readyPods:     3,
+			rcReplicas:    8,
+			expectedState: extensions.PodDisruptionBudgetStatus{CurrentHealthy: 3, DesiredHealthy: 4, ExpectedPods: 8},
+		},
+	}
+
+	for name, tc := range testCases {
+		dc, updates := newTestController()
+		pdb := newPodDisruptionBudget(tc.minAvailable)
+		dc.pdbStore.Add(pdb)
+		for i := 0; i < tc.readyPods; i++ {
+			dc.podStore.Store.Add(newPod(fmt.Sprintf("ready-%d", i), true))
+		}
+		for i := 0; i < tc.unreadyPods; i++ {
+			dc.podStore.Store.Add(newPod(fmt.Sprintf("unready-%d", i), false))
+		}
+		for i := 0; i < tc.deletingPods; i++ {
+			pod := newPod(fmt.Sprintf("deleting-%d", i), true)
+			pod.DeletionTimestamp = &now
+			dc.podStore.Store.Add(pod)
+		}
+		if tc.rcReplicas > 0 {
+			dc.rcStore.Store.Add(&api.ReplicationController{
+				ObjectMeta: api.ObjectMeta{Name: "rc", Namespace: api.NamespaceDefault},
+				Spec: api.ReplicationControllerSpec{
+					Replicas: tc.rcReplicas,
+					Selector: map[string]string{"foo": "bar"},
+				},
+			})
+		}
+
+		if err := dc.syncPodDisruptionBudget(getKey(pdb, t)); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if len(*updates) != 1 {
+			t.Errorf("%s: expected 1 status update, got %d", name, len(*updates))
+			continue
+		}
+		if got := (*updates)[0].Status; !api.Semantic.DeepEqual(got, tc.expectedState) {
+			t.Errorf("%s: expected status %+v, got %+v", name, tc.expectedState, got)
+		}
+	}
+}
+
+// TestSyncPodDisruptionBudgetDisruptedPods covers the window between an
+// eviction updating the budget and the pod store observing the deletion: the
+// evicted pod must not be counted, or the budget would allow a second eviction.
+func TestSyncPodDisruptionBudgetDisruptedPods(t *testing.T) {
+	fakeClock := &util.FakeClock{Time: time.Now()}
+	evictedAt := unversioned.NewTime(fakeClock.Now())
+
+	testCases := map[string]struct {
+		elapsed       time.Duration
+		deleting      bool
+		expectedState extensions.PodDisruptionBudgetStatus
+	}{
+		"evicted pod not yet deleted": {
+			expectedState: extensions.PodDisruptionBudgetStatus{
+				CurrentHealthy: 2,
+				DesiredHealthy: 2,
+				ExpectedPods:   3,
+				DisruptedPods:  map[string]unversioned.Time{"c": evictedAt},
+			},
+		},
+		"evicted pod being deleted": {
+			deleting:      true,
+			expectedState: extensions.PodDisruptionBudgetStatus{CurrentHealthy: 2, DesiredHealthy: 2, ExpectedPods: 3},
+		},
+		"evicted pod never deleted": {
+			elapsed:       DeletionTimeout + time.Second,
+			expectedState: extensions.PodDisruptionBudgetStatus{PodDisruptionsAllowed: 1, CurrentHealthy: 3, DesiredHealthy: 2, ExpectedPods: 3},
+		},
+	}
+
+	for name, tc := range testCases {
+		dc, updates := newTestController()
+		dc.clock = &util.FakeClock{Time: fakeClock.Now().Add(tc.elapsed)}
+		pdb := newPodDisruptionBudget(intstr.FromInt(2))
+		// The status as left behind by the eviction of pod c.
+		pdb.Status = extensions.PodDisruptionBudgetStatus{
+			CurrentHealthy: 3,
+			DesiredHealthy: 2,
+			ExpectedPods:   3,
+			DisruptedPods:  map[string]unversioned.Time{"c": evictedAt},
+		}
+		dc.pdbStore.Add(pdb)
+		dc.podStore.Store.Add(newPod("a", true))
+		dc.podStore.Store.Add(newPod("b", true))
+		evicted := newPod("c", true)
+		if tc.deleting {
+			now := unversioned.NewTime(dc.clock.Now())
+			evicted.DeletionTimestamp = &now
+		}
+		dc.podStore.Store.Add(evicted)
+
+		if err := dc.syncPodDisruptionBudget(getKey(pdb, t)); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if len(*updates) != 1 {
+			t.Errorf("%s: expected 1 status update, got %d", name, len(*updates))
+			continue
+		}
+		if got := (*updates)[0].Status; !api.Semantic.DeepEqual(got, tc.expectedState) {
+			t.Errorf("%s: expected status %+v, got %+v", name, tc.expectedState, got)
+		}
+	}
+}
+
+func TestSyncPodDisruptionBudgetUnchanged(t *testing.T) {
+	dc, updates := newTestController()
+	pdb := newPodDisruptionBudget(intstr.FromInt(1))
+	pdb.Status = extensions.PodDisruptionBudgetStatus{PodDisruptionsAllowed: 1, CurrentHealthy: 2, DesiredHealthy: 1, ExpectedPods: 2}
+	dc.pdbStore.Add(pdb)
+	dc.podStore.Store.Add(newPod("a", true))
+	dc.podStore.Store.Add(newPod("b", true))
+
+	if err := dc.syncPodDisruptionBudget(getKey(pdb, t)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(*updates) != 0 {
+		t.Errorf("expected no status update when status is unchanged, got %d", len(*updates))
+	}
+}
+
+func TestSyncPodDisruptionBudgetDeleted(t *testing.T) {
+	dc, updates := newTestController()
+	pdb := newPodDisruptionBudget(intstr.FromInt(1))
+	if err := dc.syncPodDisruptionBudget(getKey(pdb, t)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(*updates) != 0 {
+		t.Errorf("expected no status update for a deleted budget, got %d", len(*updates))
+	}
+}
+
+func TestSyncPodDisruptionBudgetUpdateRequeue(t *testing.T) {
+	dc, _ := newTestController()
+	dc.updateHandler = func(pdb *extensions.PodDisruptionBudget) error { return fmt.Errorf("Fake error") }
+	pdb := newPodDisruptionBudget(intstr.FromInt(1))
+	dc.pdbStore.Add(pdb)
+	dc.podStore.Store.Add(newPod("a", true))
+
+	if err := dc.syncPodDisruptionBudget(getKey(pdb, t)); err == nil {
+		t.Errorf("expected error from failed status update")
+	}
+	key, _ := dc.queue.Get()
+	if expectedKey := getKey(pdb, t); key != expectedKey {
+		t.Errorf("Expected requeue of pod disruption budget with key %s got %s", expectedKey, key)
+	}
+}
+
+func TestPodLookup(t *testing.T) {
+	dc, _ := newTestController()
+	pdb := newPodDisruptionBudget(intstr.FromInt(1))
+	dc.pdbStore.Add(pdb)
+	other := newPodDisruptionBudget(intstr.FromInt(1))
+	other.Namespace = "other"
+	dc.pdbStore.Add(other)
+
+	if pdbs := dc.getPodDisruptionBudgets(newPod("a", true)); len(pdbs) != 1 || pdbs[0].Name != pdb.Name || pdbs[0].Namespace != pdb.Namespace {
+		t.Errorf("expected pod to be covered by %s/%s, got %v", pdb.Namespace, pdb.Name, pdbs)
+	}
+	unlabelled := newPod("b", true)
+	unlabelled.Labels = nil
+	if pdbs := dc.getPodDisruptionBudgets(unlabelled); len(pdbs) != 0 {
+		t.Errorf("expected unlabelled pod not to be covered, got %v", pdbs)
+	}
+}
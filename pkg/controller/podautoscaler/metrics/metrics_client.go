@@ -157,18 +157,12 @@ func (h *HeapsterMetricsClient) getForPods(resourceName api.ResourceName, namesp
 	if !metricDefined {
 		return nil, fmt.Errorf("heapster metric not defined for %v", resourceName)
 	}
-	now := time.Now()
-
-	startTime := now.Add(heapsterQueryStart)
 	metricPath := fmt.Sprintf("/api/v1/model/namespaces/%s/pod-list/%s/metrics/%s",
 		namespace,
 		strings.Join(podNames, ","),
 		metricSpec.name)
 
-	resultRaw, err := h.client.Services(h.heapsterNamespace).
-		ProxyGet(h.heapsterScheme, h.heapsterService, h.heapsterPort, metricPath, map[string]string{"start": startTime.Format(time.RFC3339)}).
-		DoRaw()
-
+	resultRaw, err := h.getRaw(metricPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pods metrics: %v", err)
 	}
@@ -189,19 +183,114 @@ func (h *HeapsterMetricsClient) getForPods(resourceName api.ResourceName, namesp
 	return &currentConsumption, nil
 }
 
+// GetNodeResourceUsage returns the latest CPU and memory usage of a node.
+func (h *HeapsterMetricsClient) GetNodeResourceUsage(nodeName string) (api.ResourceList, error) {
+	return h.getResourceUsage(fmt.Sprintf("/api/v1/model/nodes/%s", nodeName))
+}
+
+// GetContainerResourceUsage returns the latest CPU and memory usage of a single container.
+func (h *HeapsterMetricsClient) GetContainerResourceUsage(namespace, podName, containerName string) (api.ResourceList, error) {
+	return h.getResourceUsage(fmt.Sprintf("/api/v1/model/namespaces/%s/pods/%s/containers/%s", namespace, podName, containerName))
+}
+
+// GetPodResourceUsage returns the latest CPU and memory usage of each of the
+// given pods, keyed by pod name. Pods without any reported samples are omitted.
+func (h *HeapsterMetricsClient) GetPodResourceUsage(namespace string, podNames []string) (map[string]api.ResourceList, error) {
+	usage := map[string]api.ResourceList{}
+	if len(podNames) == 0 {
+		return usage, nil
+	}
+	for _, resourceName := range []api.ResourceName{api.ResourceCPU, api.ResourceMemory} {
+		metricPath := fmt.Sprintf("/api/v1/model/namespaces/%s/pod-list/%s/metrics/%s",
+			namespace,
+			strings.Join(podNames, ","),
+			h.resourceDefinitions[resourceName].name)
+		resultRaw, err := h.getRaw(metricPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pods metrics: %v", err)
+		}
+		var metrics heapster.MetricResultList
+		if err := json.Unmarshal(resultRaw, &metrics); err != nil {
+			return nil, fmt.Errorf("failed to unmarshall heapster response: %v", err)
+		}
+		// Heapster returns one result per requested pod, in order.
+		if len(metrics.Items) != len(podNames) {
+			return nil, fmt.Errorf("metrics obtained for %d/%d of pods", len(metrics.Items), len(podNames))
+		}
+		for i, result := range metrics.Items {
+			value, ok := latestSample(result)
+			if !ok {
+				continue
+			}
+			if usage[podNames[i]] == nil {
+				usage[podNames[i]] = api.ResourceList{}
+			}
+			usage[podNames[i]][resourceName] = usageQuantity(resourceName, value)
+		}
+	}
+	return usage, nil
+}
+
+// getResourceUsage returns the latest CPU and memory samples of the heapster
+// model entity at entityPath.
+func (h *HeapsterMetricsClient) getResourceUsage(entityPath string) (api.ResourceList, error) {
+	usage := api.ResourceList{}
+	for _, resourceName := range []api.ResourceName{api.ResourceCPU, api.ResourceMemory} {
+		resultRaw, err := h.getRaw(fmt.Sprintf("%s/metrics/%s", entityPath, h.resourceDefinitions[resourceName].name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metrics: %v", err)
+		}
+		var result heapster.MetricResult
+		if err := json.Unmarshal(resultRaw, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshall heapster response: %v", err)
+		}
+		value, ok := latestSample(result)
+		if !ok {
+			return nil, fmt.Errorf("no %s metrics available for %s", resourceName, entityPath)
+		}
+		usage[resourceName] = usageQuantity(resourceName, value)
+	}
+	return usage, nil
+}
+
+// getRaw queries heapster through the apiserver service proxy for samples
+// newer than heapsterQueryStart.
+func (h *HeapsterMetricsClient) getRaw(metricPath string) ([]byte, error) {
+	startTime := time.Now().Add(heapsterQueryStart)
+	return h.client.Services(h.heapsterNamespace).
+		ProxyGet(h.heapsterScheme, h.heapsterService, h.heapsterPort, metricPath, map[string]string{"start": startTime.Format(time.RFC3339)}).
+		DoRaw()
+}
+
+// usageQuantity converts a heapster sample to a quantity. CPU usage is
+// reported in millicores and memory usage in bytes.
+func usageQuantity(resourceName api.ResourceName, value uint64) resource.Quantity {
+	if resourceName == api.ResourceCPU {
+		return *resource.NewMilliQuantity(int64(value), resource.DecimalSI)
+	}
+	return *resource.NewQuantity(int64(value), resource.BinarySI)
+}
+
+// latestSample returns the value of the newest point in result.
+func latestSample(result heapster.MetricResult) (uint64, bool) {
+	var newest *heapster.MetricPoint
+	for i, metricPoint := range result.Metrics {
+		if newest == nil || newest.Timestamp.Before(metricPoint.Timestamp) {
+			newest = &result.Metrics[i]
+		}
+	}
+	if newest == nil {
+		return 0, false
+	}
+	return newest.Value, true
+}
+
 func calculateSumFromLatestSample(metrics heapster.MetricResultList) (uint64, int) {
 	sum := uint64(0)
 	count := 0
 	for _, metrics := range metrics.Items {
-		var newest *heapster.MetricPoint
-		newest = nil
-		for i, metricPoint := range metrics.Metrics {
-			if newest == nil || newest.Timestamp.Before(metricPoint.Timestamp) {
-				newest = &metrics.Metrics[i]
-			}
-		}
-		if newest != nil {
-			sum += newest.Value
+		if value, ok := latestSample(metrics); ok {
+			sum += value
 			count++
 		}
 	}
@@ -379,3 +379,79 @@ func TestMemoryEmptyMetricsForOnePod(t *testing.T) {
 }
 
 // TODO: add proper tests for request
+
+func newUsageTestClient(t *testing.T, responses map[string]interface{}) *testclient.Fake {
+	fakeClient := &testclient.Fake{}
+	fakeClient.AddProxyReactor("services", func(action testclient.Action) (handled bool, ret client.ResponseWrapper, err error) {
+		path := action.(testclient.ProxyGetAction).GetPath()
+		response, found := responses[path]
+		if !found {
+			t.Fatalf("unexpected heapster query: %s", path)
+		}
+		raw, _ := json.Marshal(response)
+		return true, newFakeResponseWrapper(raw), nil
+	})
+	return fakeClient
+}
+
+func newMetricResult(values ...uint64) heapster.MetricResult {
+	result := heapster.MetricResult{}
+	now := time.Now()
+	for i, value := range values {
+		result.Metrics = append(result.Metrics, heapster.MetricPoint{Timestamp: now.Add(time.Duration(i) * time.Minute), Value: value})
+	}
+	return result
+}
+
+func TestGetNodeResourceUsage(t *testing.T) {
+	testClient := newUsageTestClient(t, map[string]interface{}{
+		"/api/v1/model/nodes/node-1/metrics/cpu-usage":    newMetricResult(100, 250),
+		"/api/v1/model/nodes/node-1/metrics/memory-usage": newMetricResult(1024),
+	})
+	metricsClient := NewHeapsterMetricsClient(testClient, DefaultHeapsterNamespace, DefaultHeapsterScheme, DefaultHeapsterService, DefaultHeapsterPort)
+	usage, err := metricsClient.GetNodeResourceUsage("node-1")
+	assert.NoError(t, err)
+	cpu, memory := usage[api.ResourceCPU], usage[api.ResourceMemory]
+	assert.Equal(t, int64(250), cpu.MilliValue())
+	assert.Equal(t, int64(1024), memory.Value())
+}
+
+func TestGetNodeResourceUsageNoSamples(t *testing.T) {
+	testClient := newUsageTestClient(t, map[string]interface{}{
+		"/api/v1/model/nodes/node-1/metrics/cpu-usage": newMetricResult(),
+	})
+	metricsClient := NewHeapsterMetricsClient(testClient, DefaultHeapsterNamespace, DefaultHeapsterScheme, DefaultHeapsterService, DefaultHeapsterPort)
+	_, err := metricsClient.GetNodeResourceUsage("node-1")
+	assert.Error(t, err)
+}
+
+func TestGetContainerResourceUsage(t *testing.T) {
+	testClient := newUsageTestClient(t, map[string]interface{}{
+		"/api/v1/model/namespaces/ns/pods/pod-1/containers/c/metrics/cpu-usage":    newMetricResult(10),
+		"/api/v1/model/namespaces/ns/pods/pod-1/containers/c/metrics/memory-usage": newMetricResult(2048),
+	})
+	metricsClient := NewHeapsterMetricsClient(testClient, DefaultHeapsterNamespace, DefaultHeapsterScheme, DefaultHeapsterService, DefaultHeapsterPort)
+	usage, err := metricsClient.GetContainerResourceUsage("ns", "pod-1", "c")
+	assert.NoError(t, err)
+	cpu, memory := usage[api.ResourceCPU], usage[api.ResourceMemory]
+	assert.Equal(t, int64(10), cpu.MilliValue())
+	assert.Equal(t, int64(2048), memory.Value())
+}
+
+func TestGetPodResourceUsage(t *testing.T) {
+	testClient := newUsageTestClient(t, map[string]interface{}{
+		"/api/v1/model/namespaces/ns/pod-list/pod-1,pod-2/metrics/cpu-usage": heapster.MetricResultList{
+			Items: []heapster.MetricResult{newMetricResult(10, 20), newMetricResult()},
+		},
+		"/api/v1/model/namespaces/ns/pod-list/pod-1,pod-2/metrics/memory-usage": heapster.MetricResultList{
+			Items: []heapster.MetricResult{newMetricResult(4096), newMetricResult()},
+		},
+	})
+	metricsClient := NewHeapsterMetricsClient(testClient, DefaultHeapsterNamespace, DefaultHeapsterScheme, DefaultHeapsterService, DefaultHeapsterPort)
+	usage, err := metricsClient.GetPodResourceUsage("ns", []string{"pod-1", "pod-2"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(usage))
+	cpu, memory := usage["pod-1"][api.ResourceCPU], usage["pod-1"][api.ResourceMemory]
+	assert.Equal(t, int64(20), cpu.MilliValue())
+	assert.Equal(t, int64(4096), memory.Value())
+}
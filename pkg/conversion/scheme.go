@@ -70,6 +70,7 @@ func NewScheme() *Scheme {
 			"componentconfig": {Group: "componentconfig"},
 			"extensions":      {Group: "extensions"},
 			"metrics":         {Group: "metrics"},
+			"rbac":            {Group: "rbac"},
 		},
 		MetaFactory: DefaultMetaFactory,
 	}
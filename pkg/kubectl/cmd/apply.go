@@ -19,21 +19,48 @@ package cmd
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/kubectl"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/strategicpatch"
 )
 
 // ApplyOptions stores cmd.Flag values for apply.  As new fields are added,
 // add them here instead of referencing the cmd.Flags()
 type ApplyOptions struct {
-	Filenames []string
+	Filenames      []string
+	Selector       string
+	Prune          bool
+	PruneWhitelist []string
+	DryRun         bool
+	ServerDryRun   bool
+}
+
+// defaultPruneWhitelist lists the kinds considered by --prune unless
+// --prune-whitelist is given. Kinds in a group other than the legacy API
+// group are written as group/Kind.
+var defaultPruneWhitelist = []string{
+	"ConfigMap",
+	"Endpoints",
+	"PersistentVolumeClaim",
+	"Pod",
+	"ReplicationController",
+	"Secret",
+	"Service",
+	"extensions/DaemonSet",
+	"extensions/Deployment",
+	"extensions/Ingress",
+	"extensions/Job",
 }
 
 const (
@@ -45,7 +72,14 @@ JSON and YAML formats are accepted.`
 $ kubectl apply -f ./pod.json
 
 # Apply the JSON passed into stdin to a pod.
-$ cat pod.json | kubectl apply -f -`
+$ cat pod.json | kubectl apply -f -
+
+# Apply the configuration in the manifests directory, and delete any previously
+# applied objects labeled app=nginx that are no longer defined there.
+$ kubectl apply --prune -l app=nginx -f ./manifests/
+
+# List the objects that would be pruned, without changing anything.
+$ kubectl apply --prune -l app=nginx --dry-run -f ./manifests/`
 )
 
 func NewCmdApply(f *cmdutil.Factory, out io.Writer) *cobra.Command {
@@ -66,6 +100,11 @@ func NewCmdApply(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	usage := "Filename, directory, or URL to file that contains the configuration to apply"
 	kubectl.AddJsonFilenameFlag(cmd, &options.Filenames, usage)
 	cmd.MarkFlagRequired("filename")
+	cmd.Flags().BoolVar(&options.Prune, "prune", false, "If true, delete previously applied objects matching --selector that are not in the configuration, in every namespace the configuration applies to.")
+	cmd.Flags().StringVarP(&options.Selector, "selector", "l", "", "Selector (label query) to filter on, required with --prune.")
+	cmd.Flags().StringSliceVar(&options.PruneWhitelist, "prune-whitelist", defaultPruneWhitelist, "Kinds considered by --prune, as Kind or group/Kind.")
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", false, "If true, only print the objects that would be created, configured or pruned, without changing them.")
+	cmd.Flags().BoolVar(&options.ServerDryRun, "server-dry-run", false, "If true, send the create and patch requests to the server to be validated and admitted without persisting the resources. Pruning is only printed.")
 	cmdutil.AddValidateFlags(cmd)
 	cmdutil.AddOutputFlagsForMutation(cmd)
 	return cmd
@@ -81,6 +120,26 @@ func validateArgs(cmd *cobra.Command, args []string) error {
 
 func RunApply(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *ApplyOptions) error {
 	shortOutput := cmdutil.GetFlagString(cmd, "output") == "name"
+
+	var selector labels.Selector
+	if options.Prune {
+		if len(options.Selector) == 0 {
+			return cmdutil.UsageError(cmd, "--prune requires a --selector")
+		}
+		var err error
+		if selector, err = labels.Parse(options.Selector); err != nil {
+			return err
+		}
+	}
+
+	if options.DryRun && options.ServerDryRun {
+		return cmdutil.UsageError(cmd, "--dry-run and --server-dry-run are mutually exclusive")
+	}
+	dryRunSuffix := ""
+	if options.DryRun {
+		dryRunSuffix = " (dry run)"
+	}
+
 	schema, err := f.Validator(cmdutil.GetFlagBool(cmd, "validate"), cmdutil.GetFlagString(cmd, "schema-cache-dir"))
 	if err != nil {
 		return err
@@ -105,6 +164,10 @@ func RunApply(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *Ap
 	}
 
 	count := 0
+	// visited records every object in the configuration, so that pruning
+	// leaves them alone, and visitedNamespaces the namespaces to prune in.
+	visited := sets.NewString()
+	visitedNamespaces := sets.NewString()
 	err = r.Visit(func(info *resource.Info, err error) error {
 		// In this method, info.Object contains the object retrieved from the server
 		// and info.VersionedObject contains the object decoded from the input source.
@@ -120,21 +183,30 @@ func RunApply(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *Ap
 			return cmdutil.AddSourceToErr(fmt.Sprintf("retrieving modified configuration from:\n%v\nfor:", info), info.Source, err)
 		}
 
+		visited.Insert(pruneKey(info.Mapping.GroupVersionKind.GroupKind(), info.Namespace, info.Name))
+		if info.Namespaced() {
+			visitedNamespaces.Insert(info.Namespace)
+		}
+
 		if err := info.Get(); err != nil {
 			if !errors.IsNotFound(err) {
 				return cmdutil.AddSourceToErr(fmt.Sprintf("retrieving current configuration of:\n%v\nfrom server for:", info), info.Source, err)
 			}
+			count++
+			if options.DryRun {
+				cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "created"+dryRunSuffix)
+				return nil
+			}
 			// Create the resource if it doesn't exist
 			// First, update the annotation used by kubectl apply
 			if err := kubectl.CreateApplyAnnotation(info); err != nil {
 				return cmdutil.AddSourceToErr("creating", info.Source, err)
 			}
 			// Then create the resource and skip the three-way merge
-			if err := createAndRefresh(info); err != nil {
+			if err := createAndRefresh(info, options.ServerDryRun); err != nil {
 				return cmdutil.AddSourceToErr("creating", info.Source, err)
 			}
-			count++
-			cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "created")
+			cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "created"+serverDryRunSuffix(options.ServerDryRun))
 			return nil
 		}
 
@@ -157,14 +229,20 @@ func RunApply(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *Ap
 			return cmdutil.AddSourceToErr(fmt.Sprintf(format, original, modified, current, info), info.Source, err)
 		}
 
+		count++
+		if options.DryRun {
+			cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "configured"+dryRunSuffix)
+			return nil
+		}
+
 		helper := resource.NewHelper(info.Client, info.Mapping)
+		helper.ServerDryRun = options.ServerDryRun
 		_, err = helper.Patch(info.Namespace, info.Name, api.StrategicMergePatchType, patch)
 		if err != nil {
 			return cmdutil.AddSourceToErr(fmt.Sprintf("applying patch:\n%s\nto:\n%v\nfor:", patch, info), info.Source, err)
 		}
 
-		count++
-		cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "configured")
+		cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "configured"+serverDryRunSuffix(options.ServerDryRun))
 		return nil
 	})
 
@@ -176,5 +254,100 @@ func RunApply(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *Ap
 		return fmt.Errorf("no objects passed to apply")
 	}
 
+	if !options.Prune {
+		return nil
+	}
+
+	// Only report unknown kinds the user asked for explicitly; the default
+	// whitelist includes kinds from API groups that may not be enabled.
+	explicitWhitelist := cmd.Flags().Changed("prune-whitelist")
+	for _, kind := range options.PruneWhitelist {
+		gk := parsePruneKind(kind)
+		mapping, err := mapper.RESTMapping(gk)
+		if err != nil {
+			if explicitWhitelist {
+				return err
+			}
+			continue
+		}
+		namespaces := visitedNamespaces.List()
+		if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+			namespaces = []string{""}
+		}
+		for _, namespace := range namespaces {
+			if err := prune(f, mapping, namespace, selector, visited, options.DryRun || options.ServerDryRun, shortOutput, out); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// parsePruneKind parses a --prune-whitelist entry of the form Kind or group/Kind.
+func parsePruneKind(kind string) unversioned.GroupKind {
+	if i := strings.LastIndex(kind, "/"); i >= 0 {
+		return unversioned.GroupKind{Group: kind[:i], Kind: kind[i+1:]}
+	}
+	return unversioned.GroupKind{Kind: kind}
+}
+
+func pruneKey(gk unversioned.GroupKind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", gk.Group, gk.Kind, namespace, name)
+}
+
+// prune deletes the objects of the given mapping that match selector, carry the
+// last applied configuration annotation and were not part of this apply.
+func prune(f *cmdutil.Factory, mapping *meta.RESTMapping, namespace string, selector labels.Selector, visited sets.String, dryRun, shortOutput bool, out io.Writer) error {
+	mapper, _ := f.Object()
+	c, err := f.ClientMapperForCommand().ClientForMapping(mapping)
+	if err != nil {
+		return err
+	}
+	helper := resource.NewHelper(c, mapping)
+	list, err := helper.List(namespace, mapping.GroupVersionKind.GroupVersion().String(), selector)
+	if err != nil {
+		// The server may not serve this kind.
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	objs, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range objs {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+		if _, ok := accessor.Annotations()[kubectl.LastAppliedConfigAnnotation]; !ok {
+			// Never created with apply, so never ours to prune.
+			continue
+		}
+		if visited.Has(pruneKey(mapping.GroupVersionKind.GroupKind(), accessor.Namespace(), accessor.Name())) {
+			continue
+		}
+
+		if dryRun {
+			cmdutil.PrintSuccess(mapper, shortOutput, out, mapping.Resource, accessor.Name(), "pruned (dry run)")
+			continue
+		}
+		reaper, err := f.Reaper(mapping)
+		if err != nil {
+			if !kubectl.IsNoSuchReaperError(err) {
+				return err
+			}
+			err = helper.Delete(accessor.Namespace(), accessor.Name())
+		} else {
+			err = reaper.Stop(accessor.Namespace(), accessor.Name(), 0, nil)
+		}
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		cmdutil.PrintSuccess(mapper, shortOutput, out, mapping.Resource, accessor.Name(), "pruned")
+	}
 	return nil
 }
@@ -22,12 +22,17 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/ghodss/yaml"
 	"github.com/spf13/cobra"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/client/unversioned/fake"
 	"k8s.io/kubernetes/pkg/kubectl"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
@@ -295,3 +300,172 @@ func TestApplyMultipleObject(t *testing.T) {
 		t.Fatalf("unexpected output: %s\nexpected: %s", buf.String(), expect)
 	}
 }
+
+func appliedObjectMeta(name string, applied bool) api.ObjectMeta {
+	meta := api.ObjectMeta{Name: name, Namespace: "test", Labels: map[string]string{"app": "redis"}}
+	if applied {
+		meta.Annotations = map[string]string{kubectl.LastAppliedConfigAnnotation: "{}"}
+	}
+	return meta
+}
+
+func runApplyPrune(t *testing.T, dryRun bool) (string, []string) {
+	nameRC, currentRC := readAndAnnotateReplicationController(t, filenameRC)
+	pathRC := "/namespaces/test/replicationcontrollers/" + nameRC
+
+	// The applied controller is listed too, but must not be pruned.
+	controllers := &api.ReplicationControllerList{Items: []api.ReplicationController{
+		{ObjectMeta: appliedObjectMeta(nameRC, true)},
+	}}
+	services := &api.ServiceList{Items: []api.Service{
+		{ObjectMeta: appliedObjectMeta("old-svc", true)},
+	}}
+	configMaps := &api.ConfigMapList{Items: []api.ConfigMap{
+		{ObjectMeta: appliedObjectMeta("old-config", true)},
+		{ObjectMeta: appliedObjectMeta("manual-config", false)},
+	}}
+
+	deleted := []string{}
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	httpClient := fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+		switch p, m := strings.TrimPrefix(req.URL.Path, "/api/v1"), req.Method; {
+		case p == pathRC && m == "GET":
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(currentRC))}, nil
+		case p == pathRC && m == "PATCH" && !dryRun:
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(currentRC))}, nil
+		case p == "/namespaces/test/replicationcontrollers" && m == "GET":
+			return &http.Response{StatusCode: 200, Body: objBody(codec, controllers)}, nil
+		case p == "/namespaces/test/services" && m == "GET":
+			if selector := req.URL.Query().Get(unversioned.LabelSelectorQueryParam(testapi.Default.Version())); selector != "app=redis" {
+				t.Errorf("unexpected selector: %q", selector)
+			}
+			return &http.Response{StatusCode: 200, Body: objBody(codec, services)}, nil
+		case p == "/namespaces/test/services/old-svc" && m == "GET":
+			return &http.Response{StatusCode: 200, Body: objBody(codec, &services.Items[0])}, nil
+		case p == "/namespaces/test/configmaps" && m == "GET":
+			return &http.Response{StatusCode: 200, Body: objBody(codec, configMaps)}, nil
+		case strings.HasPrefix(p, "/namespaces/test/") && m == "DELETE" && !dryRun:
+			deleted = append(deleted, p)
+			return &http.Response{StatusCode: 200, Body: objBody(codec, &unversioned.Status{Status: unversioned.StatusSuccess})}, nil
+		default:
+			t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+			return nil, nil
+		}
+	})
+	tf.Client = &fake.RESTClient{Codec: codec, Client: httpClient}
+	tf.ClientConfig = &client.Config{GroupVersion: testapi.Default.GroupVersion(), Transport: httpClient.Transport}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdApply(f, buf)
+	cmd.Flags().Set("filename", filenameRC)
+	cmd.Flags().Set("output", "name")
+	cmd.Flags().Set("prune", "true")
+	cmd.Flags().Set("selector", "app=redis")
+	cmd.Flags().Set("prune-whitelist", "ReplicationController,Service,ConfigMap")
+	if dryRun {
+		cmd.Flags().Set("dry-run", "true")
+	}
+	cmd.Run(cmd, []string{})
+
+	return buf.String(), deleted
+}
+
+func TestApplyPrune(t *testing.T) {
+	out, deleted := runApplyPrune(t, false)
+
+	expect := "replicationcontroller/redis-master\nservice/old-svc\nconfigmap/old-config\n"
+	if out != expect {
+		t.Errorf("unexpected output: %s\nexpected: %s", out, expect)
+	}
+	expectDeleted := []string{"/namespaces/test/services/old-svc", "/namespaces/test/configmaps/old-config"}
+	if !reflect.DeepEqual(deleted, expectDeleted) {
+		t.Errorf("unexpected deletions: %v\nexpected: %v", deleted, expectDeleted)
+	}
+}
+
+func TestApplyPruneDryRun(t *testing.T) {
+	out, deleted := runApplyPrune(t, true)
+
+	if len(deleted) != 0 {
+		t.Errorf("unexpected deletions in dry run: %v", deleted)
+	}
+	expect := "replicationcontroller/redis-master\nservice/old-svc\nconfigmap/old-config\n"
+	if out != expect {
+		t.Errorf("unexpected output: %s\nexpected: %s", out, expect)
+	}
+}
+
+func TestApplyPruneMultipleNamespaces(t *testing.T) {
+	nameRC, currentRC := readAndAnnotateReplicationController(t, filenameRC)
+	pathRC := "/namespaces/test/replicationcontrollers/" + nameRC
+
+	// A second manifest places a config map outside the default namespace.
+	file, err := ioutil.TempFile("", "apply-prune")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.Remove(file.Name())
+	newConfig := &api.ConfigMap{ObjectMeta: api.ObjectMeta{Name: "new-config", Namespace: "other", Labels: map[string]string{"app": "redis"}}}
+	if _, err := file.Write([]byte(runtime.EncodeOrDie(testapi.Default.Codec(), newConfig))); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	file.Close()
+
+	configMaps := map[string]*api.ConfigMapList{
+		"test":  {Items: []api.ConfigMap{{ObjectMeta: appliedObjectMeta("old-config", true)}}},
+		"other": {Items: []api.ConfigMap{{ObjectMeta: api.ObjectMeta{Name: "old-config", Namespace: "other", Annotations: map[string]string{kubectl.LastAppliedConfigAnnotation: "{}"}}}}},
+	}
+
+	deleted := []string{}
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	httpClient := fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+		switch p, m := strings.TrimPrefix(req.URL.Path, "/api/v1"), req.Method; {
+		case p == pathRC && (m == "GET" || m == "PATCH"):
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(currentRC))}, nil
+		case p == "/namespaces/other/configmaps/new-config" && m == "GET":
+			return &http.Response{StatusCode: 404, Body: objBody(codec, &unversioned.Status{Status: unversioned.StatusFailure, Reason: unversioned.StatusReasonNotFound})}, nil
+		case p == "/namespaces/other/configmaps" && m == "POST":
+			return &http.Response{StatusCode: 201, Body: objBody(codec, newConfig)}, nil
+		case strings.HasSuffix(p, "/configmaps") && m == "GET":
+			namespace := strings.Split(p, "/")[2]
+			return &http.Response{StatusCode: 200, Body: objBody(codec, configMaps[namespace])}, nil
+		case strings.HasPrefix(p, "/namespaces/") && m == "DELETE":
+			deleted = append(deleted, p)
+			return &http.Response{StatusCode: 200, Body: objBody(codec, &unversioned.Status{Status: unversioned.StatusSuccess})}, nil
+		default:
+			t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+			return nil, nil
+		}
+	})
+	tf.Client = &fake.RESTClient{Codec: codec, Client: httpClient}
+	tf.ClientConfig = &client.Config{GroupVersion: testapi.Default.GroupVersion(), Transport: httpClient.Transport}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdApply(f, buf)
+	cmd.Flags().Set("filename", filenameRC)
+	cmd.Flags().Set("filename", file.Name())
+	cmd.Flags().Set("output", "name")
+	cmd.Flags().Set("prune", "true")
+	cmd.Flags().Set("selector", "app=redis")
+	cmd.Flags().Set("prune-whitelist", "ConfigMap")
+	cmd.Run(cmd, []string{})
+
+	expectDeleted := []string{"/namespaces/other/configmaps/old-config", "/namespaces/test/configmaps/old-config"}
+	if !reflect.DeepEqual(deleted, expectDeleted) {
+		t.Errorf("unexpected deletions: %v\nexpected: %v", deleted, expectDeleted)
+	}
+}
+
+func TestApplyPruneRequiresSelector(t *testing.T) {
+	f, _, _ := NewAPIFactory()
+	buf := bytes.NewBuffer([]byte{})
+	cmd := NewCmdApply(f, buf)
+	options := &ApplyOptions{Filenames: []string{filenameRC}, Prune: true}
+	if err := RunApply(f, cmd, buf, options); err == nil || !strings.Contains(err.Error(), "--selector") {
+		t.Errorf("expected a selector error, got %v", err)
+	}
+}
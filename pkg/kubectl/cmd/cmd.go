@@ -21,6 +21,7 @@ import (
 
 	"github.com/golang/glog"
 	cmdconfig "k8s.io/kubernetes/pkg/kubectl/cmd/config"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/rollout"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/util"
 
@@ -118,6 +119,7 @@ __custom_func() {
    * nodes (aka 'no')
    * namespaces (aka 'ns')
    * secrets
+   * configmaps
    * persistentvolumes (aka 'pv')
    * persistentvolumeclaims (aka 'pvc')
    * limitranges (aka 'limits')
@@ -126,6 +128,7 @@ __custom_func() {
    * endpoints (aka 'ep')
    * quota
    * horizontalpodautoscalers (aka 'hpa')
+   * poddisruptionbudgets (aka 'pdb')
 `
 )
 
@@ -155,14 +158,21 @@ Find more information at https://github.com/kubernetes/kubernetes.`,
 	cmds.AddCommand(NewCmdDelete(f, out))
 	cmds.AddCommand(NewCmdEdit(f, out))
 	cmds.AddCommand(NewCmdApply(f, out))
+	cmds.AddCommand(NewCmdDiff(f, out))
 
 	cmds.AddCommand(NewCmdNamespace(out))
 	cmds.AddCommand(NewCmdLogs(f, out))
 	cmds.AddCommand(NewCmdRollingUpdate(f, out))
+	cmds.AddCommand(rollout.NewCmdRollout(f, out))
 	cmds.AddCommand(NewCmdScale(f, out))
+	cmds.AddCommand(NewCmdCordon(f, out))
+	cmds.AddCommand(NewCmdDrain(f, out))
+	cmds.AddCommand(NewCmdUncordon(f, out))
+	cmds.AddCommand(NewCmdWait(f, out))
 
 	cmds.AddCommand(NewCmdAttach(f, in, out, err))
 	cmds.AddCommand(NewCmdExec(f, in, out, err))
+	cmds.AddCommand(NewCmdDebug(f, in, out, err))
 	cmds.AddCommand(NewCmdPortForward(f))
 	cmds.AddCommand(NewCmdProxy(f, out))
 
@@ -176,6 +186,7 @@ Find more information at https://github.com/kubernetes/kubernetes.`,
 
 	cmds.AddCommand(cmdconfig.NewCmdConfig(cmdconfig.NewDefaultPathOptions(), out))
 	cmds.AddCommand(NewCmdClusterInfo(f, out))
+	cmds.AddCommand(NewCmdTop(f, out))
 	cmds.AddCommand(NewCmdApiVersions(f, out))
 	cmds.AddCommand(NewCmdVersion(f, out))
 	cmds.AddCommand(NewCmdExplain(f, out))
@@ -201,11 +201,17 @@ func NewAPIFactory() (*cmdutil.Factory, *testFactory, runtime.Codec) {
 		Validator: validation.NullSchema{},
 	}
 	generators := map[string]kubectl.Generator{
-		"run/v1":       kubectl.BasicReplicationController{},
-		"run-pod/v1":   kubectl.BasicPod{},
-		"service/v1":   kubectl.ServiceGeneratorV1{},
-		"service/v2":   kubectl.ServiceGeneratorV2{},
-		"service/test": testServiceGenerator{},
+		"run/v1":                        kubectl.BasicReplicationController{},
+		"run-pod/v1":                    kubectl.BasicPod{},
+		"service/v1":                    kubectl.ServiceGeneratorV1{},
+		"service/v2":                    kubectl.ServiceGeneratorV2{},
+		"service/test":                  testServiceGenerator{},
+		"configmap/v1":                  kubectl.ConfigMapGeneratorV1{},
+		"secret/v1":                     kubectl.SecretGeneratorV1{},
+		"secret-for-docker-registry/v1": kubectl.SecretForDockerRegistryGeneratorV1{},
+		"namespace/v1":                  kubectl.NamespaceGeneratorV1{},
+		"serviceaccount/v1":             kubectl.ServiceAccountGeneratorV1{},
+		"resourcequota/v1":              kubectl.ResourceQuotaGeneratorV1{},
 	}
 	f := &cmdutil.Factory{
 		Object: func() (meta.RESTMapper, runtime.ObjectTyper) {
@@ -224,6 +230,12 @@ func NewAPIFactory() (*cmdutil.Factory, *testFactory, runtime.Codec) {
 		Describer: func(*meta.RESTMapping) (kubectl.Describer, error) {
 			return t.Describer, t.Err
 		},
+		Reaper: func(mapping *meta.RESTMapping) (kubectl.Reaper, error) {
+			fakeClient := t.Client.(*fake.RESTClient)
+			c := client.NewOrDie(t.ClientConfig)
+			c.Client = fakeClient.Client
+			return kubectl.ReaperFor(mapping.GroupVersionKind.Kind, c)
+		},
 		Printer: func(mapping *meta.RESTMapping, noHeaders, withNamespace bool, wide bool, showAll bool, columnLabels []string) (kubectl.ResourcePrinter, error) {
 			return t.Printer, t.Err
 		},
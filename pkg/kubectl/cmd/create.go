@@ -44,7 +44,10 @@ JSON and YAML formats are accepted.`
 $ kubectl create -f ./pod.json
 
 # Create a pod based on the JSON passed into stdin.
-$ cat pod.json | kubectl create -f -`
+$ cat pod.json | kubectl create -f -
+
+# Check that the server would admit the pod in pod.json, without creating it.
+$ kubectl create --server-dry-run -f ./pod.json`
 )
 
 func NewCmdCreate(f *cmdutil.Factory, out io.Writer) *cobra.Command {
@@ -66,8 +69,16 @@ func NewCmdCreate(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	kubectl.AddJsonFilenameFlag(cmd, &options.Filenames, usage)
 	cmd.MarkFlagRequired("filename")
 	cmdutil.AddValidateFlags(cmd)
+	cmdutil.AddServerDryRunFlag(cmd)
 	cmdutil.AddOutputFlagsForMutation(cmd)
 	cmdutil.AddApplyAnnotationFlags(cmd)
+
+	// create subcommands
+	cmd.AddCommand(NewCmdCreateConfigMap(f, out))
+	cmd.AddCommand(NewCmdCreateNamespace(f, out))
+	cmd.AddCommand(NewCmdCreateQuota(f, out))
+	cmd.AddCommand(NewCmdCreateSecret(f, out))
+	cmd.AddCommand(NewCmdCreateServiceAccount(f, out))
 	return cmd
 }
 
@@ -102,6 +113,7 @@ func RunCreate(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *C
 		return err
 	}
 
+	serverDryRun := cmdutil.GetFlagBool(cmd, "server-dry-run")
 	count := 0
 	err = r.Visit(func(info *resource.Info, err error) error {
 		if err != nil {
@@ -111,7 +123,7 @@ func RunCreate(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *C
 			return cmdutil.AddSourceToErr("creating", info.Source, err)
 		}
 
-		if err := createAndRefresh(info); err != nil {
+		if err := createAndRefresh(info, serverDryRun); err != nil {
 			return cmdutil.AddSourceToErr("creating", info.Source, err)
 		}
 
@@ -120,7 +132,7 @@ func RunCreate(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *C
 		if !shortOutput {
 			printObjectSpecificMessage(info.Object, out)
 		}
-		cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "created")
+		cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "created"+serverDryRunSuffix(serverDryRun))
 		return nil
 	})
 	if err != nil {
@@ -163,12 +175,69 @@ func makePortsString(ports []api.ServicePort, useNodePort bool) string {
 	return strings.Join(pieces, ",")
 }
 
-// createAndRefresh creates an object from input info and refreshes info with that object
-func createAndRefresh(info *resource.Info) error {
-	obj, err := resource.NewHelper(info.Client, info.Mapping).Create(info.Namespace, true, info.Object)
+// createAndRefresh creates an object from input info and refreshes info with that object.
+// If serverDryRun is true, the server admits the object without persisting it.
+func createAndRefresh(info *resource.Info, serverDryRun bool) error {
+	helper := resource.NewHelper(info.Client, info.Mapping)
+	helper.ServerDryRun = serverDryRun
+	obj, err := helper.Create(info.Namespace, true, info.Object)
 	if err != nil {
 		return err
 	}
 	info.Refresh(obj, true)
 	return nil
 }
+
+// serverDryRunSuffix returns the suffix appended to success messages for
+// requests sent with --server-dry-run.
+func serverDryRunSuffix(serverDryRun bool) string {
+	if serverDryRun {
+		return " (server dry run)"
+	}
+	return ""
+}
+
+// addCreateSubcommandFlags adds the flags shared by the generator backed
+// create subcommands, such as create configmap and create secret.
+func addCreateSubcommandFlags(cmd *cobra.Command, defaultGenerator string) {
+	cmdutil.AddPrinterFlags(cmd)
+	cmdutil.AddApplyAnnotationFlags(cmd)
+	cmd.Flags().String("generator", defaultGenerator, "The name of the API generator to use.")
+	cmd.Flags().Bool("dry-run", false, "If true, only print the object that would be sent, without sending it.")
+}
+
+// nameFromCommandArgs returns the single NAME argument of a create subcommand.
+func nameFromCommandArgs(cmd *cobra.Command, args []string) (string, error) {
+	if len(args) != 1 {
+		return "", cmdutil.UsageError(cmd, "exactly one NAME is required, got %d", len(args))
+	}
+	return args[0], nil
+}
+
+// RunCreateSubcommand generates an object from params with the generator named
+// by the --generator flag, creates it unless --dry-run is set, and prints the
+// result.
+func RunCreateSubcommand(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, name string, params map[string]interface{}) error {
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	generatorName := cmdutil.GetFlagString(cmd, "generator")
+	generator, found := f.Generator(generatorName)
+	if !found {
+		return cmdutil.UsageError(cmd, "generator %q not found.", generatorName)
+	}
+
+	obj, _, mapper, mapping, err := createGeneratedObject(f, cmd, generator, generator.ParamNames(), params, "", namespace)
+	if err != nil {
+		return err
+	}
+
+	outputFormat := cmdutil.GetFlagString(cmd, "output")
+	if outputFormat != "" && outputFormat != "name" {
+		return f.PrintObject(cmd, obj, out)
+	}
+	cmdutil.PrintSuccess(mapper, outputFormat == "name", out, mapping.Resource, name, "created")
+	return nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+)
+
+const (
+	configMapLong = `Create a configmap based on a file, directory, or specified literal value.
+
+A single configmap may package one or more key/value pairs.
+
+When creating a configmap based on a file, the key will default to the basename of the file, and the value will
+default to the file content.  If the basename is an invalid key, you may specify an alternate key.
+
+When creating a configmap based on a directory, each file whose basename is a valid key in the directory will be
+packaged into the configmap.  Any directory entries except regular files are ignored (e.g. subdirectories,
+symlinks, devices, pipes, etc).`
+
+	configMapExample = `# Create a new configmap named my-config with keys for each file in folder bar
+$ kubectl create configmap my-config --from-file=path/to/bar
+
+# Create a new configmap named my-config with specified keys instead of names on disk
+$ kubectl create configmap my-config --from-file=key1=/path/to/bar/file1.txt --from-file=key2=/path/to/bar/file2.txt
+
+# Create a new configmap named my-config with key1=config1 and key2=config2
+$ kubectl create configmap my-config --from-literal=key1=config1 --from-literal=key2=config2
+
+# Print the configmap that would be created without sending it to the server
+$ kubectl create configmap my-config --from-literal=key1=config1 --dry-run -o yaml`
+)
+
+// NewCmdCreateConfigMap is a command to create a configmap from files, directories or literal values.
+func NewCmdCreateConfigMap(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "configmap NAME [--from-file=[key=]source] [--from-literal=key1=value1] [--dry-run]",
+		Aliases: []string{"cm"},
+		Short:   "Create a configmap from a local file, directory or literal value.",
+		Long:    configMapLong,
+		Example: configMapExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunCreateConfigMap(f, cmd, out, args)
+			cmdutil.CheckErr(err)
+		},
+	}
+	addCreateSubcommandFlags(cmd, "configmap/v1")
+	cmd.Flags().StringSlice("from-file", []string{}, "Key file can be specified using its file path, in which case file basename will be used as configmap key, or optionally with a key and file path, in which case the given key will be used.  Specifying a directory will iterate each named file in the directory whose basename is a valid configmap key.")
+	cmd.Flags().StringSlice("from-literal", []string{}, "Specify a key and literal value to insert in configmap (i.e. mykey=somevalue)")
+	return cmd
+}
+
+// RunCreateConfigMap implements the behavior to run the create configmap command.
+func RunCreateConfigMap(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, args []string) error {
+	name, err := nameFromCommandArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	params := map[string]interface{}{
+		"name":         name,
+		"from-file":    cmdutil.GetFlagStringSlice(cmd, "from-file"),
+		"from-literal": cmdutil.GetFlagStringSlice(cmd, "from-literal"),
+	}
+	return RunCreateSubcommand(f, cmd, out, name, params)
+}
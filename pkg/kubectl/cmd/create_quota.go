@@ -0,0 +1,67 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+)
+
+const (
+	quotaLong = `Create a resource quota with the specified name and hard limits.`
+
+	quotaExample = `# Create a new resource quota named my-quota
+$ kubectl create quota my-quota --hard=cpu=1,memory=1G,pods=2,services=3,replicationcontrollers=2,resourcequotas=1,secrets=5,persistentvolumeclaims=10`
+)
+
+// NewCmdCreateQuota is a command to create a resource quota.
+func NewCmdCreateQuota(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quota NAME --hard=key1=value1,key2=value2 [--dry-run]",
+		Aliases: []string{"resourcequota"},
+		Short:   "Create a quota with the specified name.",
+		Long:    quotaLong,
+		Example: quotaExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunCreateQuota(f, cmd, out, args)
+			cmdutil.CheckErr(err)
+		},
+	}
+	addCreateSubcommandFlags(cmd, "resourcequota/v1")
+	cmd.Flags().String("hard", "", "A comma-delimited set of resource=quantity pairs that define a hard limit.")
+	cmd.MarkFlagRequired("hard")
+	return cmd
+}
+
+// RunCreateQuota implements the behavior to run the create quota command.
+func RunCreateQuota(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, args []string) error {
+	name, err := nameFromCommandArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(cmdutil.GetFlagString(cmd, "hard")) == 0 {
+		return cmdutil.UsageError(cmd, "flag hard is required")
+	}
+	params := map[string]interface{}{
+		"name": name,
+		"hard": cmdutil.GetFlagString(cmd, "hard"),
+	}
+	return RunCreateSubcommand(f, cmd, out, name, params)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+)
+
+// NewCmdCreateSecret groups the subcommands that create secrets.
+func NewCmdCreateSecret(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secret",
+		Short: "Create a secret using specified subcommand.",
+		Long:  "Create a secret using specified subcommand.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+	cmd.AddCommand(NewCmdCreateSecretGeneric(f, out))
+	cmd.AddCommand(NewCmdCreateSecretDockerRegistry(f, out))
+	cmd.AddCommand(NewCmdCreateSecretTLS(f, out))
+	return cmd
+}
+
+const (
+	secretLong = `Create a secret based on a file, directory, or specified literal value.
+
+A single secret may package one or more key/value pairs.
+
+When creating a secret based on a file, the key will default to the basename of the file, and the value will
+default to the file content.  If the basename is an invalid key, you may specify an alternate key.
+
+When creating a secret based on a directory, each file whose basename is a valid key in the directory will be
+packaged into the secret.  Any directory entries except regular files are ignored (e.g. subdirectories,
+symlinks, devices, pipes, etc).`
+
+	secretExample = `# Create a new secret named my-secret with keys for each file in folder bar
+$ kubectl create secret generic my-secret --from-file=path/to/bar
+
+# Create a new secret named my-secret with specified keys instead of names on disk
+$ kubectl create secret generic my-secret --from-file=ssh-privatekey=~/.ssh/id_rsa --from-file=ssh-publickey=~/.ssh/id_rsa.pub
+
+# Create a new secret named my-secret with key1=supersecret and key2=topsecret
+$ kubectl create secret generic my-secret --from-literal=key1=supersecret --from-literal=key2=topsecret`
+)
+
+// NewCmdCreateSecretGeneric is a command to create a generic secret from files, directories or literal values.
+func NewCmdCreateSecretGeneric(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "generic NAME [--type=string] [--from-file=[key=]source] [--from-literal=key1=value1] [--dry-run]",
+		Short:   "Create a secret from a local file, directory or literal value.",
+		Long:    secretLong,
+		Example: secretExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunCreateSecretGeneric(f, cmd, out, args)
+			cmdutil.CheckErr(err)
+		},
+	}
+	addCreateSubcommandFlags(cmd, "secret/v1")
+	cmd.Flags().StringSlice("from-file", []string{}, "Key file can be specified using its file path, in which case file basename will be used as secret key, or optionally with a key and file path, in which case the given key will be used.  Specifying a directory will iterate each named file in the directory whose basename is a valid secret key.")
+	cmd.Flags().StringSlice("from-literal", []string{}, "Specify a key and literal value to insert in secret (i.e. mykey=somevalue)")
+	cmd.Flags().String("type", "", "The type of secret to create. Defaults to Opaque.")
+	return cmd
+}
+
+// RunCreateSecretGeneric implements the behavior to run the create secret generic command.
+func RunCreateSecretGeneric(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, args []string) error {
+	name, err := nameFromCommandArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	params := map[string]interface{}{
+		"name":         name,
+		"type":         cmdutil.GetFlagString(cmd, "type"),
+		"from-file":    cmdutil.GetFlagStringSlice(cmd, "from-file"),
+		"from-literal": cmdutil.GetFlagStringSlice(cmd, "from-literal"),
+	}
+	return RunCreateSubcommand(f, cmd, out, name, params)
+}
+
+const (
+	secretForDockerRegistryLong = `Create a new secret for use with Docker registries.
+
+Dockercfg secrets are used to authenticate against Docker registries.
+
+When using the Docker command line to push images, you can authenticate to a given registry by running
+  'docker login DOCKER_REGISTRY_SERVER --username=DOCKER_USER --password=DOCKER_PASSWORD --email=DOCKER_EMAIL'.
+That produces a ~/.dockercfg file that is used by subsequent 'docker push' and 'docker pull' commands to
+authenticate to the registry.
+
+When creating applications, you may have a Docker registry that requires authentication.  In order for the
+nodes to pull images on your behalf, they have to have the credentials.  You can provide this information
+by creating a dockercfg secret and attaching it to your service account.`
+
+	secretForDockerRegistryExample = `# If you don't already have a .dockercfg file, you can create a dockercfg secret directly by using:
+$ kubectl create secret docker-registry my-secret --docker-server=DOCKER_REGISTRY_SERVER --docker-username=DOCKER_USER --docker-password=DOCKER_PASSWORD --docker-email=DOCKER_EMAIL`
+)
+
+// NewCmdCreateSecretDockerRegistry is a command to create a secret holding the credentials of a docker registry.
+func NewCmdCreateSecretDockerRegistry(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "docker-registry NAME --docker-username=user --docker-password=password --docker-email=email [--docker-server=string] [--dry-run]",
+		Short:   "Create a secret for use with a Docker registry.",
+		Long:    secretForDockerRegistryLong,
+		Example: secretForDockerRegistryExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunCreateSecretDockerRegistry(f, cmd, out, args)
+			cmdutil.CheckErr(err)
+		},
+	}
+	addCreateSubcommandFlags(cmd, "secret-for-docker-registry/v1")
+	cmd.Flags().String("docker-username", "", "Username for Docker registry authentication")
+	cmd.MarkFlagRequired("docker-username")
+	cmd.Flags().String("docker-password", "", "Password for Docker registry authentication")
+	cmd.MarkFlagRequired("docker-password")
+	cmd.Flags().String("docker-email", "", "Email for Docker registry")
+	cmd.MarkFlagRequired("docker-email")
+	cmd.Flags().String("docker-server", "https://index.docker.io/v1/", "Server location for Docker registry")
+	return cmd
+}
+
+// RunCreateSecretDockerRegistry implements the behavior to run the create secret docker-registry command.
+func RunCreateSecretDockerRegistry(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, args []string) error {
+	name, err := nameFromCommandArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	for _, flag := range []string{"docker-server", "docker-username", "docker-password", "docker-email"} {
+		if len(cmdutil.GetFlagString(cmd, flag)) == 0 {
+			return cmdutil.UsageError(cmd, "flag %s is required", flag)
+		}
+	}
+	params := map[string]interface{}{
+		"name":            name,
+		"docker-server":   cmdutil.GetFlagString(cmd, "docker-server"),
+		"docker-username": cmdutil.GetFlagString(cmd, "docker-username"),
+		"docker-password": cmdutil.GetFlagString(cmd, "docker-password"),
+		"docker-email":    cmdutil.GetFlagString(cmd, "docker-email"),
+	}
+	return RunCreateSubcommand(f, cmd, out, name, params)
+}
+
+const (
+	secretForTLSLong = `Create a TLS secret from the given public/private key pair.
+
+The public/private key pair must exist beforehand. The public key certificate must be .PEM encoded and match the
+given private key.`
+
+	secretForTLSExample = `# Create a new TLS secret named tls-secret with the given key pair:
+$ kubectl create secret tls tls-secret --cert=path/to/tls.cert --key=path/to/tls.key`
+)
+
+// NewCmdCreateSecretTLS is a command to create a TLS secret from a certificate and a private key.
+func NewCmdCreateSecretTLS(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tls NAME --cert=path/to/cert/file --key=path/to/key/file [--dry-run]",
+		Short:   "Create a TLS secret.",
+		Long:    secretForTLSLong,
+		Example: secretForTLSExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunCreateSecretTLS(f, cmd, out, args)
+			cmdutil.CheckErr(err)
+		},
+	}
+	addCreateSubcommandFlags(cmd, "secret-for-tls/v1")
+	cmd.Flags().String("cert", "", "Path to PEM encoded public key certificate.")
+	cmd.MarkFlagRequired("cert")
+	cmd.Flags().String("key", "", "Path to private key associated with given certificate.")
+	cmd.MarkFlagRequired("key")
+	return cmd
+}
+
+// RunCreateSecretTLS implements the behavior to run the create secret tls command.
+func RunCreateSecretTLS(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, args []string) error {
+	name, err := nameFromCommandArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	for _, flag := range []string{"cert", "key"} {
+		if len(cmdutil.GetFlagString(cmd, flag)) == 0 {
+			return cmdutil.UsageError(cmd, "flag %s is required", flag)
+		}
+	}
+	params := map[string]interface{}{
+		"name": name,
+		"cert": cmdutil.GetFlagString(cmd, "cert"),
+		"key":  cmdutil.GetFlagString(cmd, "key"),
+	}
+	return RunCreateSubcommand(f, cmd, out, name, params)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+)
+
+const (
+	serviceAccountLong = `Create a service account with the specified name.`
+
+	serviceAccountExample = `# Create a new service account named my-service-account
+$ kubectl create serviceaccount my-service-account`
+)
+
+// NewCmdCreateServiceAccount is a command to create a service account.
+func NewCmdCreateServiceAccount(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serviceaccount NAME [--dry-run]",
+		Aliases: []string{"sa"},
+		Short:   "Create a service account with the specified name.",
+		Long:    serviceAccountLong,
+		Example: serviceAccountExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunCreateServiceAccount(f, cmd, out, args)
+			cmdutil.CheckErr(err)
+		},
+	}
+	addCreateSubcommandFlags(cmd, "serviceaccount/v1")
+	return cmd
+}
+
+// RunCreateServiceAccount implements the behavior to run the create serviceaccount command.
+func RunCreateServiceAccount(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, args []string) error {
+	name, err := nameFromCommandArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	params := map[string]interface{}{
+		"name": name,
+	}
+	return RunCreateSubcommand(f, cmd, out, name, params)
+}
@@ -68,6 +68,40 @@ func TestCreateObject(t *testing.T) {
 	}
 }
 
+func TestCreateObjectServerDryRun(t *testing.T) {
+	_, _, rc := testData()
+	rc.Items[0].Name = "redis-master-controller"
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &fake.RESTClient{
+		Codec: codec,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/replicationcontrollers" && m == "POST":
+				if req.URL.Query().Get("dryRun") != "true" {
+					t.Errorf("expected dryRun=true, got %s", req.URL)
+				}
+				return &http.Response{StatusCode: 201, Body: objBody(codec, &rc.Items[0])}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdCreate(f, buf)
+	cmd.Flags().Set("filename", "../../../examples/guestbook/redis-master-controller.yaml")
+	cmd.Flags().Set("server-dry-run", "true")
+	cmd.Run(cmd, []string{})
+
+	if buf.String() != "replicationcontroller \"redis-master-controller\" created (server dry run)\n" {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
 func TestCreateMultipleObject(t *testing.T) {
 	_, svc, rc := testData()
 
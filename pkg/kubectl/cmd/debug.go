@@ -0,0 +1,246 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/docker/docker/pkg/term"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	"k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	utilrand "k8s.io/kubernetes/pkg/util/rand"
+)
+
+const (
+	debug_long = `Run a troubleshooting container in a running pod.
+
+The debug container shares the network namespace of the pod and the IPC and PID
+namespaces of a target container, so tools in its image can inspect the network
+of the pod and the processes of the target. The target defaults to the only
+container of a single container pod. The container is not added to the pod spec,
+the pod is not restarted, and the container is removed when the command exits.`
+	debug_example = `# Start an interactive shell in a busybox container inside pod 123456-7890
+$ kubectl debug 123456-7890 --image=busybox -i -t
+
+# Start a shell that can see the processes of container ruby-container of pod 123456-7890
+$ kubectl debug 123456-7890 --image=busybox --target=ruby-container -i -t
+
+# Run 'netstat -tlpn' in a debug container inside a running pod selected by the service frontend
+$ kubectl debug svc/frontend --image=busybox -- netstat -tlpn`
+)
+
+func NewCmdDebug(f *cmdutil.Factory, cmdIn io.Reader, cmdOut, cmdErr io.Writer) *cobra.Command {
+	options := &DebugOptions{
+		In:  cmdIn,
+		Out: cmdOut,
+		Err: cmdErr,
+
+		Executor: &DefaultRemoteExecutor{},
+	}
+	cmd := &cobra.Command{
+		Use:     "debug (POD | TYPE/NAME) --image=IMAGE [-c CONTAINER] [--target=CONTAINER] [-- COMMAND [args...]]",
+		Short:   "Run a troubleshooting container in a running pod.",
+		Long:    debug_long,
+		Example: debug_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			argsLenAtDash := cmd.ArgsLenAtDash()
+			cmdutil.CheckErr(options.Complete(f, cmd, args, argsLenAtDash))
+			cmdutil.CheckErr(options.Validate())
+			cmdutil.CheckErr(options.Run())
+		},
+	}
+	cmd.Flags().StringVar(&options.Image, "image", "", "The image for the debug container. Required.")
+	cmd.Flags().StringVarP(&options.ContainerName, "container", "c", "", "Name of the debug container. Must not be the name of a container of the pod. If omitted, a name is generated.")
+	cmd.Flags().StringVar(&options.Target, "target", "", "Name of the container of the pod whose processes the debug container can see. Defaults to the only container of a single container pod.")
+	cmd.Flags().BoolVarP(&options.Stdin, "stdin", "i", false, "Pass stdin to the container")
+	cmd.Flags().BoolVarP(&options.TTY, "tty", "t", false, "Stdin is a TTY")
+	return cmd
+}
+
+// DebugOptions declare the arguments accepted by the Debug command
+type DebugOptions struct {
+	Namespace     string
+	PodName       string
+	ContainerName string
+	Target        string
+	Image         string
+	Stdin         bool
+	TTY           bool
+	Command       []string
+
+	In  io.Reader
+	Out io.Writer
+	Err io.Writer
+
+	Executor RemoteExecutor
+	Client   *client.Client
+	Config   *client.Config
+}
+
+// Complete verifies command line arguments and loads data from the command environment
+func (p *DebugOptions) Complete(f *cmdutil.Factory, cmd *cobra.Command, argsIn []string, argsLenAtDash int) error {
+	if len(argsIn) == 0 || argsLenAtDash == 0 {
+		return cmdutil.UsageError(cmd, "POD is required for debug")
+	}
+	if argsLenAtDash == -1 && len(argsIn) > 1 {
+		return cmdutil.UsageError(cmd, "the command of the debug container must follow --")
+	}
+	p.PodName = argsIn[0]
+	p.Command = argsIn[1:]
+	if len(p.ContainerName) == 0 {
+		p.ContainerName = "debugger-" + utilrand.String(5)
+	}
+
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	p.Namespace = namespace
+
+	config, err := f.ClientConfig()
+	if err != nil {
+		return err
+	}
+	p.Config = config
+
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+	p.Client = client
+
+	pod, _, err := podForResource(f, client, namespace, p.PodName)
+	if err != nil {
+		return err
+	}
+	p.PodName = pod.Name
+	return nil
+}
+
+// Validate checks that the provided debug options are specified.
+func (p *DebugOptions) Validate() error {
+	if len(p.PodName) == 0 {
+		return fmt.Errorf("pod name must be specified")
+	}
+	if len(p.Image) == 0 {
+		return fmt.Errorf("--image must be specified for the debug container")
+	}
+	if p.Out == nil || p.Err == nil {
+		return fmt.Errorf("both output and error output must be provided")
+	}
+	if p.Executor == nil || p.Client == nil || p.Config == nil {
+		return fmt.Errorf("client, client config, and executor must be provided")
+	}
+	return nil
+}
+
+// Run starts the debug container in the pod and attaches to it.
+func (p *DebugOptions) Run() error {
+	pod, err := p.Client.Pods(p.Namespace).Get(p.PodName)
+	if err != nil {
+		return err
+	}
+
+	if pod.Status.Phase != api.PodRunning {
+		return fmt.Errorf("pod %s is not running and cannot be debugged; current phase is %s", p.PodName, pod.Status.Phase)
+	}
+	for _, c := range pod.Spec.Containers {
+		if c.Name == p.ContainerName {
+			return fmt.Errorf("container %s already exists in pod %s; choose another name with --container", p.ContainerName, p.PodName)
+		}
+	}
+	target := p.Target
+	if len(target) == 0 && len(pod.Spec.Containers) == 1 {
+		target = pod.Spec.Containers[0].Name
+	}
+	if len(target) == 0 {
+		fmt.Fprintf(p.Err, "No target container given, the debug container will not see the processes of pod %s; use --target to choose one.\n", p.PodName)
+	} else if !podHasContainer(pod, target) {
+		return fmt.Errorf("target container %s not found in pod %s", target, p.PodName)
+	}
+
+	var stdin io.Reader
+	tty := p.TTY
+	if p.Stdin {
+		stdin = p.In
+		if tty {
+			if file, ok := stdin.(*os.File); ok {
+				inFd := file.Fd()
+				if term.IsTerminal(inFd) {
+					oldState, err := term.SetRawTerminal(inFd)
+					if err != nil {
+						glog.Fatal(err)
+					}
+					// this handles a clean exit, where the command finished
+					defer term.RestoreTerminal(inFd, oldState)
+
+					// SIGINT is handled by term.SetRawTerminal (it runs a goroutine that listens
+					// for SIGINT and restores the terminal before exiting)
+
+					// this handles SIGTERM
+					sigChan := make(chan os.Signal, 1)
+					signal.Notify(sigChan, syscall.SIGTERM)
+					go func() {
+						<-sigChan
+						term.RestoreTerminal(inFd, oldState)
+						os.Exit(0)
+					}()
+				} else {
+					fmt.Fprintln(p.Err, "STDIN is not a terminal")
+				}
+			} else {
+				tty = false
+				fmt.Fprintln(p.Err, "Unable to use a TTY - input is not the right kind of file")
+			}
+		}
+	}
+
+	req := p.Client.RESTClient.Post().
+		Resource("pods").
+		Name(pod.Name).
+		Namespace(pod.Namespace).
+		SubResource("debug")
+	req.VersionedParams(&api.PodDebugOptions{
+		Container: p.ContainerName,
+		Target:    target,
+		Image:     p.Image,
+		Command:   p.Command,
+		Stdin:     stdin != nil,
+		Stdout:    p.Out != nil,
+		Stderr:    p.Err != nil,
+		TTY:       tty,
+	}, api.Scheme)
+
+	return p.Executor.Execute("POST", req.URL(), p.Config, stdin, p.Out, p.Err, tty)
+}
+
+// podHasContainer returns true if the pod spec has a container with the given name.
+func podHasContainer(pod *api.Pod, name string) bool {
+	for _, c := range pod.Spec.Containers {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/fake"
+)
+
+func TestDebug(t *testing.T) {
+	version := testapi.Default.Version()
+	podPath := "/api/" + version + "/namespaces/test/pods/foo"
+	tests := []struct {
+		name      string
+		args      []string
+		dashAt    int
+		container string
+		target    string
+		image     string
+		query     map[string][]string
+		expectErr bool
+	}{
+		{
+			name:      "debug with command",
+			args:      []string{"foo", "netstat", "-tlpn"},
+			dashAt:    1,
+			container: "debugger",
+			image:     "busybox",
+			query: map[string][]string{
+				"container": {"debugger"},
+				"target":    {"bar"},
+				"image":     {"busybox"},
+				"command":   {"netstat", "-tlpn"},
+				"stdout":    {"true"},
+				"stderr":    {"true"},
+			},
+		},
+		{
+			name:      "explicit target",
+			args:      []string{"foo"},
+			dashAt:    -1,
+			container: "debugger",
+			target:    "bar",
+			image:     "busybox",
+			query: map[string][]string{
+				"container": {"debugger"},
+				"target":    {"bar"},
+				"image":     {"busybox"},
+				"stdout":    {"true"},
+				"stderr":    {"true"},
+			},
+		},
+		{
+			name:      "unknown target",
+			args:      []string{"foo"},
+			dashAt:    -1,
+			container: "debugger",
+			target:    "missing",
+			image:     "busybox",
+			expectErr: true,
+		},
+		{
+			name:      "container name clashes with the pod",
+			args:      []string{"foo"},
+			dashAt:    -1,
+			container: "bar",
+			image:     "busybox",
+			expectErr: true,
+		},
+		{
+			name:      "missing image",
+			args:      []string{"foo"},
+			dashAt:    -1,
+			container: "debugger",
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		f, tf, codec := NewAPIFactory()
+		tf.Client = &fake.RESTClient{
+			Codec: codec,
+			Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+				switch p, m := req.URL.Path, req.Method; {
+				case p == podPath && m == "GET":
+					return &http.Response{StatusCode: 200, Body: objBody(codec, execPod())}, nil
+				default:
+					t.Errorf("%s: unexpected request: %s %#v\n%#v", test.name, req.Method, req.URL, req)
+					return nil, fmt.Errorf("unexpected request")
+				}
+			}),
+		}
+		tf.Namespace = "test"
+		tf.ClientConfig = &client.Config{GroupVersion: &unversioned.GroupVersion{Version: version}}
+		ex := &fakeRemoteExecutor{}
+		params := &DebugOptions{
+			ContainerName: test.container,
+			Target:        test.target,
+			Image:         test.image,
+			In:            bytes.NewBuffer([]byte{}),
+			Out:           bytes.NewBuffer([]byte{}),
+			Err:           bytes.NewBuffer([]byte{}),
+			Executor:      ex,
+		}
+		if err := params.Complete(f, &cobra.Command{}, test.args, test.dashAt); err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		err := params.Validate()
+		if err == nil {
+			err = params.Run()
+		}
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			if ex.url != nil {
+				t.Errorf("%s: unexpected debug request: %v", test.name, ex.url)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if ex.method != "POST" || ex.url.Path != podPath+"/debug" {
+			t.Errorf("%s: unexpected debug request: %s %v", test.name, ex.method, ex.url)
+			continue
+		}
+		if query := map[string][]string(ex.url.Query()); !reflect.DeepEqual(test.query, query) {
+			t.Errorf("%s: expected query %v, got %v", test.name, test.query, query)
+		}
+	}
+}
+
+func TestDebugGeneratesContainerName(t *testing.T) {
+	f, tf, codec := NewAPIFactory()
+	tf.Client = &fake.RESTClient{
+		Codec: codec,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: objBody(codec, execPod())}, nil
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{GroupVersion: &unversioned.GroupVersion{Version: testapi.Default.Version()}}
+	params := &DebugOptions{}
+	if err := params.Complete(f, &cobra.Command{}, []string{"foo"}, -1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(params.ContainerName) != len("debugger-")+5 {
+		t.Errorf("unexpected generated container name %q", params.ContainerName)
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/kubectl"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/util/strategicpatch"
+)
+
+// DiffOptions stores cmd.Flag values for diff.  As new fields are added,
+// add them here instead of referencing the cmd.Flags()
+type DiffOptions struct {
+	Filenames []string
+}
+
+const (
+	diff_long = `Diff a configuration against the live state of a resource by filename or stdin.
+
+The configuration is merged with the live object exactly as 'kubectl apply' would,
+and the difference between the live object and the result is printed as a unified
+YAML diff. Resources that do not exist yet are shown as fully added.
+
+Like diff(1), exits with status 0 if no resource would be changed, 1 if any
+resource would be changed, and greater than 1 if an error occurred.`
+	diff_example = `# Show what applying the configuration in pod.json would change.
+$ kubectl diff -f ./pod.json
+
+# Show what applying the JSON passed into stdin would change.
+$ cat pod.json | kubectl diff -f -`
+)
+
+func NewCmdDiff(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	options := &DiffOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "diff -f FILENAME",
+		Short:   "Diff a configuration against the live state of a resource by filename or stdin",
+		Long:    diff_long,
+		Example: diff_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := validateArgs(cmd, args); err != nil {
+				cmdutil.CheckErr(&cmdutil.ExitError{Code: diffErrorExitCode, Err: err})
+			}
+			cmdutil.CheckErr(RunDiff(f, cmd, out, options))
+		},
+	}
+
+	usage := "Filename, directory, or URL to file that contains the configuration to diff"
+	kubectl.AddJsonFilenameFlag(cmd, &options.Filenames, usage)
+	cmd.MarkFlagRequired("filename")
+	cmdutil.AddValidateFlags(cmd)
+	return cmd
+}
+
+const (
+	// diffChangedExitCode and diffErrorExitCode are the exit codes of diff(1)
+	// when the inputs differ and when it failed.
+	diffChangedExitCode = 1
+	diffErrorExitCode   = 2
+)
+
+// RunDiff prints the difference between the live state of each resource and
+// the state it would have after 'kubectl apply'. It returns nil if no resource
+// would be changed, and otherwise an *cmdutil.ExitError carrying the exit
+// code of diff(1): 1 if any resource would be changed, 2 if the diff failed.
+func RunDiff(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *DiffOptions) error {
+	changed, err := runDiff(f, cmd, out, options)
+	switch {
+	case err != nil:
+		return &cmdutil.ExitError{Code: diffErrorExitCode, Err: err}
+	case changed:
+		return &cmdutil.ExitError{Code: diffChangedExitCode}
+	}
+	return nil
+}
+
+// runDiff prints the differences and returns true if any resource would be
+// changed.
+func runDiff(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *DiffOptions) (bool, error) {
+	schema, err := f.Validator(cmdutil.GetFlagBool(cmd, "validate"), cmdutil.GetFlagString(cmd, "schema-cache-dir"))
+	if err != nil {
+		return false, err
+	}
+
+	cmdNamespace, enforceNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return false, err
+	}
+
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		Schema(schema).
+		ContinueOnError().
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		FilenameParam(enforceNamespace, options.Filenames...).
+		Flatten().
+		Do()
+	err = r.Err()
+	if err != nil {
+		return false, err
+	}
+
+	count := 0
+	changed := false
+	err = r.Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+
+		live, applied, err := getLiveAndAppliedConfiguration(info)
+		if err != nil {
+			return err
+		}
+
+		liveYAML, err := jsonToYAML(live)
+		if err != nil {
+			return cmdutil.AddSourceToErr("converting live configuration of", info.Source, err)
+		}
+		appliedYAML, err := jsonToYAML(applied)
+		if err != nil {
+			return cmdutil.AddSourceToErr("converting applied configuration of", info.Source, err)
+		}
+
+		name := fmt.Sprintf("%s/%s", info.Mapping.Resource, info.Name)
+		differs, err := kubectl.UnifiedDiff(out, liveYAML, appliedYAML, "live/"+name, "applied/"+name)
+		if err != nil {
+			return err
+		}
+		count++
+		changed = changed || differs
+		return nil
+	})
+
+	if err != nil {
+		return changed, err
+	}
+
+	if count == 0 {
+		return false, fmt.Errorf("no objects passed to diff")
+	}
+
+	return changed, nil
+}
+
+// getLiveAndAppliedConfiguration returns the serialized live object and the
+// object that 'kubectl apply' would produce from the same three-way merge. The
+// live configuration is empty if the resource does not exist yet.
+func getLiveAndAppliedConfiguration(info *resource.Info) ([]byte, []byte, error) {
+	modified, err := kubectl.GetModifiedConfiguration(info, true)
+	if err != nil {
+		return nil, nil, cmdutil.AddSourceToErr(fmt.Sprintf("retrieving modified configuration from:\n%v\nfor:", info), info.Source, err)
+	}
+
+	if err := info.Get(); err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, nil, cmdutil.AddSourceToErr(fmt.Sprintf("retrieving current configuration of:\n%v\nfrom server for:", info), info.Source, err)
+		}
+		// Apply would create the resource as-is.
+		return nil, modified, nil
+	}
+
+	current, err := info.Mapping.Codec.Encode(info.Object)
+	if err != nil {
+		return nil, nil, cmdutil.AddSourceToErr(fmt.Sprintf("serializing current configuration from:\n%v\nfor:", info), info.Source, err)
+	}
+
+	original, err := kubectl.GetOriginalConfiguration(info)
+	if err != nil {
+		return nil, nil, cmdutil.AddSourceToErr(fmt.Sprintf("retrieving original configuration from:\n%v\nfor:", info), info.Source, err)
+	}
+
+	patch, err := strategicpatch.CreateThreeWayMergePatch(original, modified, current, info.VersionedObject, false)
+	if err != nil {
+		format := "creating patch with:\noriginal:\n%s\nmodified:\n%s\ncurrent:\n%s\nfrom:\n%v\nfor:"
+		return nil, nil, cmdutil.AddSourceToErr(fmt.Sprintf(format, original, modified, current, info), info.Source, err)
+	}
+
+	applied, err := strategicpatch.StrategicMergePatch(current, patch, info.VersionedObject)
+	if err != nil {
+		return nil, nil, cmdutil.AddSourceToErr(fmt.Sprintf("applying patch:\n%s\nto:\n%v\nfor:", patch, info), info.Source, err)
+	}
+
+	return current, applied, nil
+}
+
+func jsonToYAML(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return yaml.JSONToYAML(data)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ghodss/yaml"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/fake"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+)
+
+func runDiffWithLiveObject(t *testing.T, live []byte) (bool, string) {
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &fake.RESTClient{
+		Codec: codec,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case strings.HasPrefix(p, "/namespaces/test/replicationcontrollers/") && m == "GET":
+				if live == nil {
+					return &http.Response{StatusCode: 404, Body: objBody(codec, &unversioned.Status{Status: unversioned.StatusFailure, Reason: unversioned.StatusReasonNotFound})}, nil
+				}
+				return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(live))}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdDiff(f, buf)
+	cmd.Flags().Set("filename", filenameRC)
+	err := RunDiff(f, cmd, buf, &DiffOptions{Filenames: []string{filenameRC}})
+	if err == nil {
+		return false, buf.String()
+	}
+	if exitErr, ok := err.(*cmdutil.ExitError); !ok || exitErr.Code != 1 || exitErr.Err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return true, buf.String()
+}
+
+func TestDiffNewObject(t *testing.T) {
+	changed, out := runDiffWithLiveObject(t, nil)
+	if !changed {
+		t.Fatalf("expected a creation to be reported as a change")
+	}
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	if lines[0] != "--- live/replicationcontrollers/redis-master" || lines[1] != "+++ applied/replicationcontrollers/redis-master" {
+		t.Errorf("unexpected diff header:\n%s", out)
+	}
+	if !strings.HasPrefix(lines[2], "@@ -0,0 +1,") {
+		t.Errorf("unexpected hunk header:\n%s", out)
+	}
+	for _, line := range lines[3:] {
+		if !strings.HasPrefix(line, "+") {
+			t.Errorf("expected only added lines, got %q", line)
+		}
+	}
+}
+
+func TestDiffObject(t *testing.T) {
+	// The live object carries an extra label that the last applied
+	// configuration claims kubectl owns, so apply would remove it.
+	_, live := readAndAnnotateReplicationController(t, filenameRC)
+	rc := &api.ReplicationController{}
+	if err := yaml.Unmarshal(live, rc); err != nil {
+		t.Fatal(err)
+	}
+	rc.Labels["DELETE_ME"] = "DELETE_ME"
+	live, err := testapi.Default.Codec().Encode(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changed, out := runDiffWithLiveObject(t, live)
+	if !changed {
+		t.Fatalf("expected a change, got none")
+	}
+	if !strings.Contains(out, "\n-    DELETE_ME: DELETE_ME\n") {
+		t.Errorf("expected the label to be removed:\n%s", out)
+	}
+}
+
+func TestDiffUnchangedObject(t *testing.T) {
+	// Serve exactly what apply would create, which apply would then leave alone.
+	_, created := runDiffWithLiveObject(t, nil)
+	applied := []string{}
+	for _, line := range strings.Split(created, "\n") {
+		if strings.HasPrefix(line, "+") && !strings.HasPrefix(line, "+++") {
+			applied = append(applied, line[1:])
+		}
+	}
+	live, err := yaml.YAMLToJSON([]byte(strings.Join(applied, "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changed, out := runDiffWithLiveObject(t, live)
+	if changed || len(out) != 0 {
+		t.Errorf("expected no changes, got:\n%s", out)
+	}
+}
+
+func TestDiffErrorExitCode(t *testing.T) {
+	f, tf, codec := NewAPIFactory()
+	tf.Client = &fake.RESTClient{
+		Codec: codec,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 500, Body: objBody(codec, &unversioned.Status{Status: unversioned.StatusFailure, Reason: unversioned.StatusReasonInternalError})}, nil
+		}),
+	}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdDiff(f, buf)
+	err := RunDiff(f, cmd, buf, &DiffOptions{Filenames: []string{filenameRC}})
+	if exitErr, ok := err.(*cmdutil.ExitError); !ok || exitErr.Code <= 1 || exitErr.Err == nil {
+		t.Errorf("expected an exit code greater than 1 with the failure, got %#v", err)
+	}
+}
+
+func TestDiffExtraArgsFail(t *testing.T) {
+	f, _, _ := NewAPIFactory()
+	c := NewCmdDiff(f, bytes.NewBuffer([]byte{}))
+	if validateArgs(c, []string{"rc"}) == nil {
+		t.Fatalf("unexpected non-error")
+	}
+}
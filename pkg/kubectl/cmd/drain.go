@@ -0,0 +1,356 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller"
+	"k8s.io/kubernetes/pkg/fields"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	kubelettypes "k8s.io/kubernetes/pkg/kubelet/types"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util/wait"
+)
+
+// DrainOptions holds the data required to cordon, uncordon or drain a node.
+type DrainOptions struct {
+	client      *client.Client
+	factory     *cmdutil.Factory
+	nodeName    string
+	force       bool
+	gracePeriod int
+	timeout     time.Duration
+	out         io.Writer
+}
+
+// drainPollInterval is how often drain checks whether evicted pods are gone and
+// retries evictions refused by a pod disruption budget.
+var drainPollInterval = time.Second
+
+const (
+	cordon_long = `Mark node as unschedulable.
+
+New pods will not be scheduled onto the node. Pods already running on it are
+left alone.`
+	cordon_example = `# Mark node "foo" as unschedulable.
+$ kubectl cordon foo`
+
+	uncordon_long    = `Mark node as schedulable.`
+	uncordon_example = `# Mark node "foo" as schedulable.
+$ kubectl uncordon foo`
+
+	drain_long = `Drain node in preparation for maintenance.
+
+The given node will be marked unschedulable to prevent new pods from arriving.
+Then every pod on the node is evicted, or deleted if the server does not
+support eviction, and drain waits until they are gone. Evictions respect pod
+disruption budgets: a pod whose removal would violate its budget is retried
+until the budget allows it or --timeout expires.
+
+Pods managed by a DaemonSet are skipped, since the DaemonSet would immediately
+replace them, and mirror pods cannot be removed through the API server. Drain
+refuses to remove pods that are not managed by a ReplicationController, Job or
+DaemonSet, because nothing would recreate them elsewhere; use --force to remove
+them anyway.
+
+When you are ready to put the node back into service, use kubectl uncordon.`
+	drain_example = `# Drain node "foo", even if there are pods not managed by a ReplicationController, Job or DaemonSet on it.
+$ kubectl drain foo --force
+
+# As above, but give every pod 15 minutes to terminate and abort if the node is not empty after an hour.
+$ kubectl drain foo --force --grace-period=900 --timeout=1h`
+)
+
+// NewCmdCordon returns a cobra command that marks a node unschedulable.
+func NewCmdCordon(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	options := &DrainOptions{factory: f, out: out}
+
+	cmd := &cobra.Command{
+		Use:     "cordon NODE",
+		Short:   "Mark node as unschedulable",
+		Long:    cordon_long,
+		Example: cordon_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(options.SetupDrain(cmd, args))
+			cmdutil.CheckErr(options.RunCordonOrUncordon(true))
+		},
+	}
+	return cmd
+}
+
+// NewCmdUncordon returns a cobra command that marks a node schedulable.
+func NewCmdUncordon(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	options := &DrainOptions{factory: f, out: out}
+
+	cmd := &cobra.Command{
+		Use:     "uncordon NODE",
+		Short:   "Mark node as schedulable",
+		Long:    uncordon_long,
+		Example: uncordon_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(options.SetupDrain(cmd, args))
+			cmdutil.CheckErr(options.RunCordonOrUncordon(false))
+		},
+	}
+	return cmd
+}
+
+// NewCmdDrain returns a cobra command that cordons a node and removes its pods.
+func NewCmdDrain(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	options := &DrainOptions{factory: f, out: out}
+
+	cmd := &cobra.Command{
+		Use:     "drain NODE [--force] [--grace-period=seconds] [--timeout=duration]",
+		Short:   "Drain node in preparation for maintenance",
+		Long:    drain_long,
+		Example: drain_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(options.SetupDrain(cmd, args))
+			cmdutil.CheckErr(options.RunDrain())
+		},
+	}
+	cmd.Flags().BoolVar(&options.force, "force", false, "Continue even if there are pods not managed by a ReplicationController, Job, or DaemonSet.")
+	cmd.Flags().IntVar(&options.gracePeriod, "grace-period", -1, "Period of time in seconds given to each pod to terminate gracefully. If negative, the default value specified in the pod will be used.")
+	cmd.Flags().DurationVar(&options.timeout, "timeout", 0, "The length of time to wait for the node to be drained before giving up, zero means wait forever.")
+	return cmd
+}
+
+// SetupDrain validates the command line arguments and creates the client
+// used to talk to the server.
+func (o *DrainOptions) SetupDrain(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return cmdutil.UsageError(cmd, "exactly one NODE name is required")
+	}
+	o.nodeName = args[0]
+
+	var err error
+	if o.client, err = o.factory.Client(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RunCordonOrUncordon sets the node's unschedulable field to the given value.
+func (o *DrainOptions) RunCordonOrUncordon(desired bool) error {
+	mapper, _ := o.factory.Object()
+	node, err := o.client.Nodes().Get(o.nodeName)
+	if err != nil {
+		return err
+	}
+	operation := "cordoned"
+	if !desired {
+		operation = "uncordoned"
+	}
+	if node.Spec.Unschedulable == desired {
+		cmdutil.PrintSuccess(mapper, false, o.out, "node", o.nodeName, "already "+operation)
+		return nil
+	}
+	node.Spec.Unschedulable = desired
+	if _, err := o.client.Nodes().Update(node); err != nil {
+		return err
+	}
+	cmdutil.PrintSuccess(mapper, false, o.out, "node", o.nodeName, operation)
+	return nil
+}
+
+// RunDrain cordons the node, then evicts or deletes the pods on it and waits
+// for them to go away.
+func (o *DrainOptions) RunDrain() error {
+	if err := o.RunCordonOrUncordon(true); err != nil {
+		return err
+	}
+
+	pods, err := o.getPodsForDeletion()
+	if err != nil {
+		return err
+	}
+
+	mapper, _ := o.factory.Object()
+	var deadline time.Time
+	if o.timeout > 0 {
+		deadline = time.Now().Add(o.timeout)
+	}
+	for i := range pods {
+		pod := &pods[i]
+		operation, err := o.removePod(pod, deadline)
+		if err != nil {
+			return err
+		}
+		cmdutil.PrintSuccess(mapper, false, o.out, "pod", pod.Name, operation)
+	}
+	if err := o.waitForDelete(pods, deadline); err != nil {
+		return err
+	}
+	cmdutil.PrintSuccess(mapper, false, o.out, "node", o.nodeName, "drained")
+	return nil
+}
+
+// getPodsForDeletion returns the pods on the node that drain should remove.
+// Mirror pods and DaemonSet pods are skipped. Pods without a live
+// ReplicationController, Job or DaemonSet are only returned with --force.
+func (o *DrainOptions) getPodsForDeletion() ([]api.Pod, error) {
+	podList, err := o.client.Pods(api.NamespaceAll).List(labels.Everything(), fields.SelectorFromSet(fields.Set{"spec.nodeName": o.nodeName}))
+	if err != nil {
+		return nil, err
+	}
+
+	pods := []api.Pod{}
+	unmanaged := []string{}
+	for _, pod := range podList.Items {
+		if _, found := pod.Annotations[kubelettypes.ConfigMirrorAnnotationKey]; found {
+			continue
+		}
+		creator, err := o.getPodCreator(&pod)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case creator == nil:
+			unmanaged = append(unmanaged, pod.Namespace+"/"+pod.Name)
+			if !o.force {
+				continue
+			}
+		case creator.Kind == "DaemonSet":
+			continue
+		}
+		pods = append(pods, pod)
+	}
+
+	if len(unmanaged) > 0 {
+		if !o.force {
+			return nil, fmt.Errorf("refusing to continue due to pods not managed by a ReplicationController, Job, or DaemonSet: %s (use --force to override)", strings.Join(unmanaged, ", "))
+		}
+		fmt.Fprintf(o.out, "WARNING: about to delete pods not managed by a ReplicationController, Job, or DaemonSet: %s\n", strings.Join(unmanaged, ", "))
+	}
+	return pods, nil
+}
+
+// getPodCreator returns a reference to the ReplicationController, Job or
+// DaemonSet that created the pod, or nil if there is none or it no longer exists.
+func (o *DrainOptions) getPodCreator(pod *api.Pod) (*api.ObjectReference, error) {
+	creatorRef, found := pod.Annotations[controller.CreatedByAnnotation]
+	if !found {
+		return nil, nil
+	}
+	sr := &api.SerializedReference{}
+	if err := api.Codec.DecodeInto([]byte(creatorRef), sr); err != nil {
+		return nil, err
+	}
+
+	var err error
+	ref := sr.Reference
+	switch ref.Kind {
+	case "ReplicationController":
+		_, err = o.client.ReplicationControllers(ref.Namespace).Get(ref.Name)
+	case "Job":
+		_, err = o.client.Extensions().Jobs(ref.Namespace).Get(ref.Name)
+	case "DaemonSet":
+		_, err = o.client.Extensions().DaemonSets(ref.Namespace).Get(ref.Name)
+	default:
+		return nil, nil
+	}
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ref, nil
+}
+
+// removePod evicts the pod, retrying while its disruption budget does not
+// allow it. If the server does not support eviction the pod is deleted
+// instead. It returns the operation performed.
+func (o *DrainOptions) removePod(pod *api.Pod, deadline time.Time) (string, error) {
+	var options *api.DeleteOptions
+	if o.gracePeriod >= 0 {
+		gracePeriodSeconds := int64(o.gracePeriod)
+		options = &api.DeleteOptions{GracePeriodSeconds: &gracePeriodSeconds}
+	}
+	eviction := &api.Eviction{
+		ObjectMeta:    api.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+		DeleteOptions: options,
+	}
+	for {
+		err := o.client.Pods(pod.Namespace).Evict(eviction)
+		switch {
+		case err == nil:
+			return "evicted", nil
+		case errors.IsTooManyRequests(err):
+			if !deadline.IsZero() && time.Now().After(deadline) {
+				return "", fmt.Errorf("timed out evicting pod %q: %v", pod.Name, err)
+			}
+			time.Sleep(drainPollInterval)
+		case errors.IsNotFound(err):
+			// Either the pod is already gone or the server has no eviction
+			// subresource; deleting tells the two apart.
+			if err := o.client.Pods(pod.Namespace).Delete(pod.Name, options); err != nil && !errors.IsNotFound(err) {
+				return "", err
+			}
+			return "deleted", nil
+		default:
+			return "", err
+		}
+	}
+}
+
+// waitForDelete waits until none of the given pods exist any more. A pod that
+// has been replaced by a new one with the same name counts as gone.
+func (o *DrainOptions) waitForDelete(pods []api.Pod, deadline time.Time) error {
+	remaining := pods
+	condition := func() (bool, error) {
+		pending := []api.Pod{}
+		for _, pod := range remaining {
+			current, err := o.client.Pods(pod.Namespace).Get(pod.Name)
+			if errors.IsNotFound(err) || (err == nil && current.UID != pod.UID) {
+				continue
+			}
+			if err != nil {
+				return false, err
+			}
+			pending = append(pending, pod)
+		}
+		remaining = pending
+		return len(remaining) == 0, nil
+	}
+	if deadline.IsZero() {
+		if done, err := condition(); done || err != nil {
+			return err
+		}
+		return wait.PollInfinite(drainPollInterval, condition)
+	}
+	timeout := deadline.Sub(time.Now())
+	if timeout <= 0 {
+		timeout = drainPollInterval
+	}
+	if err := wait.PollImmediate(drainPollInterval, timeout, condition); err != nil {
+		if err == wait.ErrWaitTimeout {
+			return fmt.Errorf("timed out waiting for %d pod(s) on node %q to terminate", len(remaining), o.nodeName)
+		}
+		return err
+	}
+	return nil
+}
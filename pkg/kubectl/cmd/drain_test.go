@@ -0,0 +1,248 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/fake"
+	"k8s.io/kubernetes/pkg/controller"
+	kubelettypes "k8s.io/kubernetes/pkg/kubelet/types"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/types"
+)
+
+const drainNodeName = "node"
+
+func drainTestNode(unschedulable bool) *api.Node {
+	return &api.Node{
+		ObjectMeta: api.ObjectMeta{Name: drainNodeName, ResourceVersion: "1"},
+		Spec:       api.NodeSpec{Unschedulable: unschedulable},
+	}
+}
+
+func drainTestPod(name, createdByKind string) api.Pod {
+	pod := api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			UID:         types.UID("uid-" + name),
+			Annotations: map[string]string{},
+		},
+		Spec: api.PodSpec{NodeName: drainNodeName},
+	}
+	if len(createdByKind) > 0 {
+		ref := api.SerializedReference{Reference: api.ObjectReference{Kind: createdByKind, Namespace: "default", Name: "creator"}}
+		pod.Annotations[controller.CreatedByAnnotation] = runtime.EncodeOrDie(testapi.Default.Codec(), &ref)
+	}
+	return pod
+}
+
+type drainTestServer struct {
+	t          *testing.T
+	codec      runtime.Codec
+	node       *api.Node
+	pods       []api.Pod
+	noEviction bool
+
+	updatedNode *api.Node
+	evicted     []string
+	deleted     []string
+}
+
+func (s *drainTestServer) handle(req *http.Request) (*http.Response, error) {
+	extCodec := testapi.Extensions.Codec()
+	notFound := func() (*http.Response, error) {
+		return &http.Response{StatusCode: 404, Body: objBody(s.codec, &errors.NewNotFound("", "").(*errors.StatusError).ErrStatus)}, nil
+	}
+	switch p, m := req.URL.Path, req.Method; {
+	case p == "/api/v1/nodes/"+drainNodeName && m == "GET":
+		return &http.Response{StatusCode: 200, Body: objBody(s.codec, s.node)}, nil
+	case p == "/api/v1/nodes/"+drainNodeName && m == "PUT":
+		node := &api.Node{}
+		data, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			s.t.Fatalf("unexpected error reading request: %v", err)
+		}
+		if err := s.codec.DecodeInto(data, node); err != nil {
+			s.t.Fatalf("unexpected error decoding node: %v", err)
+		}
+		s.updatedNode = node
+		return &http.Response{StatusCode: 200, Body: objBody(s.codec, node)}, nil
+	case p == "/api/v1/pods" && m == "GET":
+		if selector := req.URL.Query().Get(unversioned.FieldSelectorQueryParam(testapi.Default.Version())); selector != "spec.nodeName="+drainNodeName {
+			s.t.Errorf("unexpected field selector %q", selector)
+		}
+		return &http.Response{StatusCode: 200, Body: objBody(s.codec, &api.PodList{Items: s.pods})}, nil
+	case p == "/api/v1/namespaces/default/replicationcontrollers/creator" && m == "GET":
+		return &http.Response{StatusCode: 200, Body: objBody(s.codec, &api.ReplicationController{ObjectMeta: api.ObjectMeta{Name: "creator", Namespace: "default"}})}, nil
+	case p == "/apis/extensions/v1beta1/namespaces/default/daemonsets/creator" && m == "GET":
+		return &http.Response{StatusCode: 200, Body: objBody(extCodec, &extensions.DaemonSet{ObjectMeta: api.ObjectMeta{Name: "creator", Namespace: "default"}})}, nil
+	case p == "/apis/extensions/v1beta1/namespaces/default/jobs/creator" && m == "GET":
+		return notFound()
+	case strings.HasPrefix(p, "/api/v1/namespaces/default/pods/") && strings.HasSuffix(p, "/eviction") && m == "POST":
+		if s.noEviction {
+			return notFound()
+		}
+		s.evicted = append(s.evicted, strings.Split(p, "/")[6])
+		return &http.Response{StatusCode: 200, Body: objBody(s.codec, &unversioned.Status{Status: unversioned.StatusSuccess})}, nil
+	case strings.HasPrefix(p, "/api/v1/namespaces/default/pods/") && m == "DELETE":
+		s.deleted = append(s.deleted, strings.Split(p, "/")[6])
+		return &http.Response{StatusCode: 200, Body: objBody(s.codec, &unversioned.Status{Status: unversioned.StatusSuccess})}, nil
+	case strings.HasPrefix(p, "/api/v1/namespaces/default/pods/") && m == "GET":
+		return notFound()
+	default:
+		s.t.Fatalf("unexpected request: %s %#v", req.Method, req.URL)
+		return nil, nil
+	}
+}
+
+func newDrainTestOptions(t *testing.T, server *drainTestServer) (*DrainOptions, *bytes.Buffer) {
+	f, tf, codec := NewAPIFactory()
+	server.t = t
+	server.codec = codec
+	httpClient := fake.CreateHTTPClient(server.handle)
+	tf.Client = &fake.RESTClient{Codec: codec, Client: httpClient}
+	tf.ClientConfig = &client.Config{GroupVersion: testapi.Default.GroupVersion(), Transport: httpClient.Transport}
+	buf := bytes.NewBuffer([]byte{})
+	options := &DrainOptions{factory: f, out: buf, gracePeriod: -1}
+	if err := options.SetupDrain(NewCmdDrain(f, buf), []string{drainNodeName}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return options, buf
+}
+
+func TestCordonAndUncordon(t *testing.T) {
+	tests := []struct {
+		name          string
+		unschedulable bool
+		cordon        bool
+		expectUpdate  bool
+		expectOut     string
+	}{
+		{"cordon", false, true, true, "node \"node\" cordoned\n"},
+		{"cordon cordoned node", true, true, false, "node \"node\" already cordoned\n"},
+		{"uncordon", true, false, true, "node \"node\" uncordoned\n"},
+		{"uncordon schedulable node", false, false, false, "node \"node\" already uncordoned\n"},
+	}
+	for _, test := range tests {
+		server := &drainTestServer{node: drainTestNode(test.unschedulable)}
+		options, buf := newDrainTestOptions(t, server)
+		if err := options.RunCordonOrUncordon(test.cordon); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if test.expectUpdate != (server.updatedNode != nil) {
+			t.Errorf("%s: expected update %v, got %#v", test.name, test.expectUpdate, server.updatedNode)
+		}
+		if server.updatedNode != nil && server.updatedNode.Spec.Unschedulable != test.cordon {
+			t.Errorf("%s: expected unschedulable %v, got %v", test.name, test.cordon, server.updatedNode.Spec.Unschedulable)
+		}
+		if buf.String() != test.expectOut {
+			t.Errorf("%s: unexpected output %q", test.name, buf.String())
+		}
+	}
+}
+
+func TestDrain(t *testing.T) {
+	mirrorPod := drainTestPod("mirror", "")
+	mirrorPod.Annotations[kubelettypes.ConfigMirrorAnnotationKey] = "mirror"
+
+	tests := []struct {
+		name          string
+		pods          []api.Pod
+		force         bool
+		noEviction    bool
+		expectErr     bool
+		expectEvicted []string
+		expectDeleted []string
+	}{
+		{
+			name:          "managed pods are evicted",
+			pods:          []api.Pod{drainTestPod("rc-pod", "ReplicationController")},
+			expectEvicted: []string{"rc-pod"},
+		},
+		{
+			name:          "daemonset and mirror pods are skipped",
+			pods:          []api.Pod{drainTestPod("ds-pod", "DaemonSet"), mirrorPod, drainTestPod("rc-pod", "ReplicationController")},
+			expectEvicted: []string{"rc-pod"},
+		},
+		{
+			name:      "unmanaged pods are refused",
+			pods:      []api.Pod{drainTestPod("bare-pod", ""), drainTestPod("rc-pod", "ReplicationController")},
+			expectErr: true,
+		},
+		{
+			name:      "pods of a deleted controller are refused",
+			pods:      []api.Pod{drainTestPod("job-pod", "Job")},
+			expectErr: true,
+		},
+		{
+			name:          "unmanaged pods are removed with force",
+			pods:          []api.Pod{drainTestPod("bare-pod", "")},
+			force:         true,
+			expectEvicted: []string{"bare-pod"},
+		},
+		{
+			name:          "pods are deleted when eviction is not supported",
+			pods:          []api.Pod{drainTestPod("rc-pod", "ReplicationController")},
+			noEviction:    true,
+			expectDeleted: []string{"rc-pod"},
+		},
+	}
+	for _, test := range tests {
+		server := &drainTestServer{node: drainTestNode(false), pods: test.pods, noEviction: test.noEviction}
+		options, buf := newDrainTestOptions(t, server)
+		options.force = test.force
+		err := options.RunDrain()
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected error", test.name)
+			}
+			if len(server.evicted)+len(server.deleted) != 0 {
+				t.Errorf("%s: expected no pods to be removed, evicted %v deleted %v", test.name, server.evicted, server.deleted)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if server.updatedNode == nil || !server.updatedNode.Spec.Unschedulable {
+			t.Errorf("%s: expected node to be cordoned", test.name)
+		}
+		if strings.Join(server.evicted, ",") != strings.Join(test.expectEvicted, ",") {
+			t.Errorf("%s: expected evicted %v, got %v", test.name, test.expectEvicted, server.evicted)
+		}
+		if strings.Join(server.deleted, ",") != strings.Join(test.expectDeleted, ",") {
+			t.Errorf("%s: expected deleted %v, got %v", test.name, test.expectDeleted, server.deleted)
+		}
+		if !strings.HasSuffix(buf.String(), "node \"node\" drained\n") {
+			t.Errorf("%s: unexpected output %q", test.name, buf.String())
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/docker/docker/pkg/term"
@@ -30,7 +31,11 @@ import (
 	"k8s.io/kubernetes/pkg/api"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/client/unversioned/remotecommand"
+	"k8s.io/kubernetes/pkg/fields"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
 )
 
 const (
@@ -42,7 +47,10 @@ $ kubectl exec 123456-7890 -c ruby-container date
 
 # Switch to raw terminal mode, sends stdin to 'bash' in ruby-container from pod 123456-7890
 # and sends stdout/stderr from 'bash' back to the client
-$ kubectl exec 123456-7890 -c ruby-container -i -t -- bash -il`
+$ kubectl exec 123456-7890 -c ruby-container -i -t -- bash -il
+
+# Get output from running 'date' in a running pod selected by the service frontend
+$ kubectl exec svc/frontend date`
 )
 
 func NewCmdExec(f *cmdutil.Factory, cmdIn io.Reader, cmdOut, cmdErr io.Writer) *cobra.Command {
@@ -54,7 +62,7 @@ func NewCmdExec(f *cmdutil.Factory, cmdIn io.Reader, cmdOut, cmdErr io.Writer) *
 		Executor: &DefaultRemoteExecutor{},
 	}
 	cmd := &cobra.Command{
-		Use:     "exec POD [-c CONTAINER] -- COMMAND [args...]",
+		Use:     "exec (POD | TYPE/NAME) [-c CONTAINER] -- COMMAND [args...]",
 		Short:   "Execute a command in a container.",
 		Long:    "Execute a command in a container.",
 		Example: exec_example,
@@ -145,9 +153,60 @@ func (p *ExecOptions) Complete(f *cmdutil.Factory, cmd *cobra.Command, argsIn []
 	}
 	p.Client = client
 
+	if strings.Contains(p.PodName, "/") {
+		pod, _, err := podForResource(f, client, namespace, p.PodName)
+		if err != nil {
+			return err
+		}
+		p.PodName = pod.Name
+	}
+
 	return nil
 }
 
+// podForResource returns the pod identified by resourceArg, which is either
+// a pod name or TYPE/NAME of a resource with a pod selector, such as a service,
+// replication controller or deployment. In the latter case the first running
+// pod matching the selector is returned, together with the resource.
+func podForResource(f *cmdutil.Factory, c *client.Client, namespace, resourceArg string) (*api.Pod, runtime.Object, error) {
+	if !strings.Contains(resourceArg, "/") {
+		pod, err := c.Pods(namespace).Get(resourceArg)
+		return pod, pod, err
+	}
+
+	mapper, typer := f.Object()
+	obj, err := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		NamespaceParam(namespace).DefaultNamespace().
+		ResourceNames("pods", resourceArg).
+		SingleResourceType().
+		Do().Object()
+	if err != nil {
+		return nil, nil, err
+	}
+	if pod, ok := obj.(*api.Pod); ok {
+		return pod, pod, nil
+	}
+
+	selector, err := f.PodSelectorForObject(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	labelSelector, err := labels.Parse(selector)
+	if err != nil {
+		return nil, nil, err
+	}
+	pods, err := c.Pods(namespace).List(labelSelector, fields.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == api.PodRunning {
+			return &pods.Items[i], obj, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("no running pods found for %s", resourceArg)
+}
+
 // Validate checks that the provided exec options are specified.
 func (p *ExecOptions) Validate() error {
 	if len(p.PodName) == 0 {
@@ -156,6 +156,39 @@ func TestPodAndContainer(t *testing.T) {
 	}
 }
 
+func TestExecResource(t *testing.T) {
+	version := testapi.Default.Version()
+	rc := &api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{Name: "frontend", Namespace: "test", ResourceVersion: "10"},
+		Spec:       api.ReplicationControllerSpec{Selector: map[string]string{"app": "frontend"}},
+	}
+	f, tf, codec := NewAPIFactory()
+	tf.Client = &fake.RESTClient{
+		Codec: codec,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/replicationcontrollers/frontend" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, rc)}, nil
+			case p == "/api/"+version+"/namespaces/test/pods" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &api.PodList{Items: []api.Pod{*execPod()}})}, nil
+			default:
+				t.Errorf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{GroupVersion: &unversioned.GroupVersion{Version: version}}
+
+	options := &ExecOptions{}
+	if err := options.Complete(f, &cobra.Command{}, []string{"replicationcontrollers/frontend", "date"}, -1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if options.PodName != "foo" {
+		t.Errorf("expected pod foo, got %s", options.PodName)
+	}
+}
+
 func TestExec(t *testing.T) {
 	version := testapi.Default.Version()
 	tests := []struct {
@@ -42,7 +42,8 @@ Possible resource types include (case insensitive): pods (po), services (svc),
 replicationcontrollers (rc), nodes (no), events (ev), componentstatuses (cs),
 limitranges (limits), persistentvolumes (pv), persistentvolumeclaims (pvc),
 resourcequotas (quota), namespaces (ns), endpoints (ep),
-horizontalpodautoscalers (hpa), serviceaccounts or secrets.
+horizontalpodautoscalers (hpa), poddisruptionbudgets (pdb), serviceaccounts,
+configmaps or secrets.
 
 By specifying the output as 'template' and providing a Go template as the value
 of the --template flag, you can filter the attributes of the fetched resource(s).`
@@ -17,12 +17,16 @@ limitations under the License.
 package cmd
 
 import (
+	"bufio"
 	"errors"
+	"fmt"
 	"io"
 	"math"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/golang/glog"
 	"github.com/spf13/cobra"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/meta"
@@ -32,9 +36,18 @@ import (
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
 	"k8s.io/kubernetes/pkg/runtime"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/watch"
 )
 
 const (
+	logs_long = `Print the logs for a container in a pod.
+
+If the pod has only one container, the container name is optional. With a
+selector or --all-containers, the logs of every matching pod and container are
+printed concurrently, and each line is prefixed with [POD/CONTAINER]. When
+following with a selector, pods created later on are streamed too.`
 	logs_example = `# Return snapshot logs from pod nginx with only one container
 $ kubectl logs nginx
 
@@ -48,7 +61,11 @@ $ kubectl logs -f -c ruby web-1
 $ kubectl logs --tail=20 nginx
 
 # Show all logs from pod nginx written in the last hour
-$ kubectl logs --since=1h nginx`
+$ kubectl logs --since=1h nginx
+
+# Begin streaming the logs of all containers in the pods labelled app=frontend,
+# including pods created later on
+$ kubectl logs -f -l app=frontend --all-containers`
 )
 
 type LogsOptions struct {
@@ -56,6 +73,13 @@ type LogsOptions struct {
 	ResourceArg string
 	Options     runtime.Object
 
+	// Selector selects the pods to print the logs of, instead of ResourceArg.
+	Selector string
+	// AllContainers prints the logs of every container in the pods.
+	AllContainers bool
+	// MaxLogRequests caps the number of logs streamed concurrently.
+	MaxLogRequests int
+
 	Mapper       meta.RESTMapper
 	Typer        runtime.ObjectTyper
 	ClientMapper resource.ClientMapper
@@ -69,9 +93,9 @@ type LogsOptions struct {
 func NewCmdLogs(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	o := &LogsOptions{}
 	cmd := &cobra.Command{
-		Use:     "logs [-f] [-p] POD [-c CONTAINER]",
+		Use:     "logs [-f] [-p] (POD | -l label) [-c CONTAINER | --all-containers]",
 		Short:   "Print the logs for a container in a pod.",
-		Long:    "Print the logs for a container in a pod. If the pod has only one container, the container name is optional.",
+		Long:    logs_long,
 		Example: logs_example,
 		PreRun: func(cmd *cobra.Command, args []string) {
 			if len(os.Args) > 1 && os.Args[1] == "log" {
@@ -96,6 +120,9 @@ func NewCmdLogs(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().String("since-time", "", "Only return logs after a specific date (RFC3339). Defaults to all logs. Only one of since-time / since may be used.")
 	cmd.Flags().Duration("since", 0, "Only return logs newer than a relative duration like 5s, 2m, or 3h. Defaults to all logs. Only one of since-time / since may be used.")
 	cmd.Flags().StringP("container", "c", "", "Print the logs of this container")
+	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on. Prints the logs of every matching pod.")
+	cmd.Flags().Bool("all-containers", false, "If true, print the logs of all the containers in the pods.")
+	cmd.Flags().Int("max-log-requests", 5, "The maximum number of logs streamed concurrently when using a selector or --all-containers.")
 
 	cmd.Flags().Bool("interactive", false, "If true, prompt the user for input when required.")
 	cmd.Flags().MarkDeprecated("interactive", "This flag is no longer respected and there is no replacement.")
@@ -104,9 +131,14 @@ func NewCmdLogs(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 
 func (o *LogsOptions) Complete(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string) error {
 	containerName := cmdutil.GetFlagString(cmd, "container")
+	o.Selector = cmdutil.GetFlagString(cmd, "selector")
+	o.AllContainers = cmdutil.GetFlagBool(cmd, "all-containers")
+	o.MaxLogRequests = cmdutil.GetFlagInt(cmd, "max-log-requests")
 	switch len(args) {
 	case 0:
-		return cmdutil.UsageError(cmd, "POD is required for logs")
+		if len(o.Selector) == 0 {
+			return cmdutil.UsageError(cmd, "POD or a selector is required for logs")
+		}
 	case 1:
 		o.ResourceArg = args[0]
 	case 2:
@@ -160,13 +192,22 @@ func (o *LogsOptions) Complete(f *cmdutil.Factory, out io.Writer, cmd *cobra.Com
 }
 
 func (o LogsOptions) Validate() error {
-	if len(o.ResourceArg) == 0 {
+	if len(o.ResourceArg) == 0 && len(o.Selector) == 0 {
 		return errors.New("a pod must be specified")
 	}
+	if len(o.ResourceArg) > 0 && len(o.Selector) > 0 {
+		return errors.New("only one of a pod or a selector may be specified")
+	}
 	logsOptions, ok := o.Options.(*api.PodLogOptions)
 	if !ok {
 		return errors.New("unexpected logs options object")
 	}
+	if o.AllContainers && len(logsOptions.Container) > 0 {
+		return errors.New("--all-containers cannot be used with a container name")
+	}
+	if o.MaxLogRequests <= 0 {
+		return errors.New("--max-log-requests must be greater than zero")
+	}
 	if errs := validation.ValidatePodLogOptions(logsOptions); len(errs) > 0 {
 		return errs.ToAggregate()
 	}
@@ -176,6 +217,9 @@ func (o LogsOptions) Validate() error {
 
 // RunLogs retrieves a pod log
 func (o LogsOptions) RunLogs() (int64, error) {
+	if len(o.Selector) > 0 {
+		return o.runSelectorLogs()
+	}
 	infos, err := resource.NewBuilder(o.Mapper, o.Typer, o.ClientMapper).
 		NamespaceParam(o.Namespace).DefaultNamespace().
 		ResourceNames("pods", o.ResourceArg).
@@ -189,6 +233,14 @@ func (o LogsOptions) RunLogs() (int64, error) {
 	}
 	info := infos[0]
 
+	if o.AllContainers {
+		pod, ok := info.Object.(*api.Pod)
+		if !ok {
+			return 0, fmt.Errorf("--all-containers is only supported for pods")
+		}
+		return o.streamPods([]*api.Pod{pod})
+	}
+
 	req, err := o.LogsForObject(info.Object, o.Options)
 	if err != nil {
 		return 0, err
@@ -202,3 +254,232 @@ func (o LogsOptions) RunLogs() (int64, error) {
 
 	return io.Copy(o.Out, readCloser)
 }
+
+// runSelectorLogs prints the logs of the pods matching o.Selector. When
+// following, the pods are watched and new pods are streamed as they start,
+// until the watch is closed.
+func (o LogsOptions) runSelectorLogs() (int64, error) {
+	r := resource.NewBuilder(o.Mapper, o.Typer, o.ClientMapper).
+		NamespaceParam(o.Namespace).DefaultNamespace().
+		ResourceTypes("pods").
+		SelectorParam(o.Selector).
+		SingleResourceType().
+		Do()
+	obj, err := r.Object()
+	if err != nil {
+		return 0, err
+	}
+	items, err := meta.ExtractList(obj)
+	if err != nil {
+		return 0, err
+	}
+	pods := []*api.Pod{}
+	for _, item := range items {
+		if pod, ok := item.(*api.Pod); ok {
+			pods = append(pods, pod)
+		}
+	}
+
+	streamer := newLogStreamer(o)
+	if err := streamer.checkConcurrency(pods); err != nil {
+		return 0, err
+	}
+	if !streamer.options.Follow {
+		if len(pods) == 0 {
+			return 0, fmt.Errorf("no pods found for selector %q", o.Selector)
+		}
+		for _, pod := range pods {
+			streamer.start(pod)
+		}
+		return streamer.wait()
+	}
+
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return 0, err
+	}
+	w, err := r.Watch(accessor.ResourceVersion())
+	if err != nil {
+		return 0, err
+	}
+	for _, pod := range pods {
+		streamer.start(pod)
+	}
+	for event := range w.ResultChan() {
+		if pod, ok := event.Object.(*api.Pod); ok && event.Type != watch.Deleted {
+			streamer.start(pod)
+		}
+	}
+	return streamer.wait()
+}
+
+// streamPods prints the logs of the given pods concurrently.
+func (o LogsOptions) streamPods(pods []*api.Pod) (int64, error) {
+	streamer := newLogStreamer(o)
+	if err := streamer.checkConcurrency(pods); err != nil {
+		return 0, err
+	}
+	for _, pod := range pods {
+		streamer.start(pod)
+	}
+	return streamer.wait()
+}
+
+// logStreamer streams the logs of many containers to a single writer, line
+// by line, with at most MaxLogRequests streams open at a time.
+type logStreamer struct {
+	o       LogsOptions
+	options *api.PodLogOptions
+	// requests limits the number of concurrent streams.
+	requests chan struct{}
+	wg       sync.WaitGroup
+
+	// lock guards the fields below and writes to o.Out.
+	lock    sync.Mutex
+	started sets.String
+	// active is the number of streams started and not finished yet.
+	active  int
+	written int64
+	errs    []error
+}
+
+func newLogStreamer(o LogsOptions) *logStreamer {
+	return &logStreamer{
+		o:        o,
+		options:  o.Options.(*api.PodLogOptions),
+		requests: make(chan struct{}, o.MaxLogRequests),
+		started:  sets.NewString(),
+	}
+}
+
+// containers returns the names of the containers of pod to print the logs
+// of. An empty name lets the server pick the only container of the pod.
+func (s *logStreamer) containers(pod *api.Pod) []string {
+	switch {
+	case s.o.AllContainers:
+		names := []string{}
+		for _, container := range pod.Spec.Containers {
+			names = append(names, container.Name)
+		}
+		return names
+	case len(s.options.Container) > 0:
+		return []string{s.options.Container}
+	case len(pod.Spec.Containers) == 1:
+		return []string{pod.Spec.Containers[0].Name}
+	}
+	return []string{""}
+}
+
+// checkConcurrency returns an error if following the logs of pods would
+// need more streams than allowed, since followed streams never finish.
+func (s *logStreamer) checkConcurrency(pods []*api.Pod) error {
+	if !s.options.Follow {
+		return nil
+	}
+	count := 0
+	for _, pod := range pods {
+		if pod.Status.Phase != api.PodPending {
+			count += len(s.containers(pod))
+		}
+	}
+	if count > s.o.MaxLogRequests {
+		return fmt.Errorf("you are attempting to follow %d log streams, but the maximum is %d, use --max-log-requests to increase the limit", count, s.o.MaxLogRequests)
+	}
+	return nil
+}
+
+// start begins streaming the logs of the containers of pod which are not
+// streamed yet. Pending pods are skipped, since they have no logs. When
+// following, a container that would exceed MaxLogRequests is skipped with a
+// warning instead of waiting for a stream that may never finish; it is
+// picked up by a later call once another stream has ended.
+func (s *logStreamer) start(pod *api.Pod) {
+	if pod.Status.Phase == api.PodPending {
+		return
+	}
+	for _, container := range s.containers(pod) {
+		key := pod.Namespace + "/" + pod.Name + "/" + container
+		s.lock.Lock()
+		if s.started.Has(key) {
+			s.lock.Unlock()
+			continue
+		}
+		if s.options.Follow && s.active >= s.o.MaxLogRequests {
+			s.lock.Unlock()
+			glog.Warningf("Not following the logs of %s: already following %d log streams, use --max-log-requests to increase the limit", logPrefix(pod, container), s.o.MaxLogRequests)
+			continue
+		}
+		s.started.Insert(key)
+		s.active++
+		s.lock.Unlock()
+
+		s.wg.Add(1)
+		go func(pod *api.Pod, container string) {
+			defer s.wg.Done()
+			s.requests <- struct{}{}
+			defer func() { <-s.requests }()
+			err := s.stream(pod, container)
+			s.lock.Lock()
+			defer s.lock.Unlock()
+			s.active--
+			if err != nil {
+				s.errs = append(s.errs, fmt.Errorf("%s: %v", logPrefix(pod, container), err))
+			}
+		}(pod, container)
+	}
+}
+
+// stream copies the logs of a single container to the output, prefixing
+// each line with the pod and container name.
+func (s *logStreamer) stream(pod *api.Pod, container string) error {
+	options := *s.options
+	options.Container = container
+	req, err := s.o.LogsForObject(pod, &options)
+	if err != nil {
+		return err
+	}
+	readCloser, err := req.Stream()
+	if err != nil {
+		return err
+	}
+	defer readCloser.Close()
+
+	prefix := []byte("[" + logPrefix(pod, container) + "] ")
+	reader := bufio.NewReader(readCloser)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			if line[len(line)-1] != '\n' {
+				line = append(line, '\n')
+			}
+			s.lock.Lock()
+			n, writeErr := s.o.Out.Write(append(prefix, line...))
+			s.written += int64(n)
+			s.lock.Unlock()
+			if writeErr != nil {
+				return writeErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// wait blocks until all streams have finished.
+func (s *logStreamer) wait() (int64, error) {
+	s.wg.Wait()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.written, utilerrors.NewAggregate(s.errs)
+}
+
+func logPrefix(pod *api.Pod, container string) string {
+	if len(container) == 0 {
+		return pod.Name
+	}
+	return pod.Name + "/" + container
+}
@@ -21,15 +21,20 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/client/unversioned/fake"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
 )
 
 func TestLog(t *testing.T) {
@@ -94,6 +99,159 @@ func testPod() *api.Pod {
 	}
 }
 
+func logsTestPod(name string, phase api.PodPhase, containers ...string) *api.Pod {
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: "test", ResourceVersion: "10", Labels: map[string]string{"app": "frontend"}},
+		Spec: api.PodSpec{
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+		},
+		Status: api.PodStatus{Phase: phase},
+	}
+	for _, container := range containers {
+		pod.Spec.Containers = append(pod.Spec.Containers, api.Container{Name: container})
+	}
+	return pod
+}
+
+// logsSelectorClient serves a pod list, an optional pod watch and the logs
+// of every container, which are "<pod>/<container> 1" and "... 2".
+func logsSelectorClient(t *testing.T, codec runtime.Codec, pods []api.Pod, events []watch.Event) *fake.RESTClient {
+	return &fake.RESTClient{
+		Codec: codec,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/pods" && m == "GET":
+				if selector := req.URL.Query().Get(unversioned.LabelSelectorQueryParam(testapi.Default.Version())); selector != "app=frontend" {
+					t.Errorf("unexpected selector: %s", selector)
+				}
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &api.PodList{ListMeta: unversioned.ListMeta{ResourceVersion: "10"}, Items: pods})}, nil
+			case p == "/watch/namespaces/test/pods" && m == "GET" && events != nil:
+				return &http.Response{StatusCode: 200, Body: watchBody(codec, events)}, nil
+			case strings.HasPrefix(p, "/api/v1/namespaces/test/pods/") && strings.HasSuffix(p, "/log") && m == "GET":
+				name := strings.TrimSuffix(strings.TrimPrefix(p, "/api/v1/namespaces/test/pods/"), "/log")
+				source := name + "/" + req.URL.Query().Get("container")
+				body := ioutil.NopCloser(bytes.NewBufferString(source + " 1\n" + source + " 2"))
+				return &http.Response{StatusCode: 200, Body: body}, nil
+			default:
+				t.Errorf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+}
+
+func sortedLines(s string) []string {
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	sort.Strings(lines)
+	return lines
+}
+
+func TestLogsSelector(t *testing.T) {
+	pods := []api.Pod{
+		*logsTestPod("foo", api.PodRunning, "bar"),
+		*logsTestPod("baz", api.PodRunning, "a", "b"),
+	}
+	f, tf, codec := NewAPIFactory()
+	tf.Client = logsSelectorClient(t, codec, pods, nil)
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{GroupVersion: &unversioned.GroupVersion{Version: "v1"}}
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdLogs(f, buf)
+	cmd.Flags().Set("selector", "app=frontend")
+	cmd.Flags().Set("all-containers", "true")
+	cmd.Run(cmd, []string{})
+
+	expected := []string{
+		"[baz/a] baz/a 1",
+		"[baz/a] baz/a 2",
+		"[baz/b] baz/b 1",
+		"[baz/b] baz/b 2",
+		"[foo/bar] foo/bar 1",
+		"[foo/bar] foo/bar 2",
+	}
+	if lines := sortedLines(buf.String()); !reflect.DeepEqual(expected, lines) {
+		t.Errorf("expected:\n%v\ngot:\n%v", expected, lines)
+	}
+}
+
+func TestLogsSelectorFollow(t *testing.T) {
+	pods := []api.Pod{*logsTestPod("foo", api.PodRunning, "bar")}
+	events := []watch.Event{
+		{Type: watch.Added, Object: logsTestPod("baz", api.PodPending, "bar")},
+		{Type: watch.Modified, Object: logsTestPod("baz", api.PodRunning, "bar")},
+		{Type: watch.Modified, Object: logsTestPod("baz", api.PodRunning, "bar")},
+	}
+	f, tf, codec := NewAPIFactory()
+	tf.Client = logsSelectorClient(t, codec, pods, events)
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{GroupVersion: &unversioned.GroupVersion{Version: "v1"}}
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdLogs(f, buf)
+	cmd.Flags().Set("selector", "app=frontend")
+	cmd.Flags().Set("follow", "true")
+	cmd.Run(cmd, []string{})
+
+	expected := []string{
+		"[baz/bar] baz/bar 1",
+		"[baz/bar] baz/bar 2",
+		"[foo/bar] foo/bar 1",
+		"[foo/bar] foo/bar 2",
+	}
+	if lines := sortedLines(buf.String()); !reflect.DeepEqual(expected, lines) {
+		t.Errorf("expected:\n%v\ngot:\n%v", expected, lines)
+	}
+}
+
+func TestLogsFollowMaxLogRequests(t *testing.T) {
+	pods := []api.Pod{
+		*logsTestPod("foo", api.PodRunning, "bar"),
+		*logsTestPod("baz", api.PodRunning, "bar"),
+	}
+	f, tf, codec := NewAPIFactory()
+	tf.Client = logsSelectorClient(t, codec, pods, nil)
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdLogs(f, buf)
+	cmd.Flags().Set("selector", "app=frontend")
+	cmd.Flags().Set("follow", "true")
+	cmd.Flags().Set("max-log-requests", "1")
+	o := &LogsOptions{}
+	if err := o.Complete(f, buf, cmd, []string{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := o.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := o.RunLogs(); err == nil || !strings.Contains(err.Error(), "maximum is 1") {
+		t.Errorf("expected a concurrency error, got %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func TestLogsFollowSkipsStreamsOverLimit(t *testing.T) {
+	streamer := newLogStreamer(LogsOptions{
+		Options:        &api.PodLogOptions{Follow: true},
+		MaxLogRequests: 1,
+	})
+	// A stream is already being followed, e.g. of a pod listed initially.
+	streamer.active = 1
+
+	// A pod reported by the watch must not wait for a free stream.
+	streamer.start(logsTestPod("baz", api.PodRunning, "bar"))
+	if streamer.active != 1 || streamer.started.Len() != 0 {
+		t.Errorf("expected the new pod to be skipped, got %d active streams for %v", streamer.active, streamer.started.List())
+	}
+	if _, err := streamer.wait(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
 func TestValidateLogFlags(t *testing.T) {
 	f, _, _ := NewAPIFactory()
 
@@ -17,9 +17,12 @@ limitations under the License.
 package cmd
 
 import (
+	"fmt"
 	"net/url"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
@@ -28,9 +31,17 @@ import (
 	"k8s.io/kubernetes/pkg/client/unversioned/portforward"
 	"k8s.io/kubernetes/pkg/client/unversioned/remotecommand"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/intstr"
 )
 
 const (
+	portforward_long = `Forward one or more local ports to a pod.
+
+A service, replication controller or deployment may be given as TYPE/NAME
+instead of a pod, in which case a running pod matching its selector is used.
+The remote ports of a service are service ports, which are forwarded to their
+target ports. Remote ports may also be the names of container ports.`
 	portforward_example = `
 # Listen on ports 5000 and 6000 locally, forwarding data to/from ports 5000 and 6000 in the pod
 $ kubectl port-forward mypod 5000 6000
@@ -42,14 +53,22 @@ $ kubectl port-forward mypod 8888:5000
 $ kubectl port-forward mypod :5000
 
 # Listen on a random port locally, forwarding to 5000 in the pod
-$ kubectl port-forward  mypod 0:5000`
+$ kubectl port-forward  mypod 0:5000
+
+# Listen on port 5432 locally, forwarding to the target port of service port 5432
+# in a running pod selected by the service postgres
+$ kubectl port-forward svc/postgres 5432
+
+# Listen on port 8080 locally, forwarding to the container port named http
+# in a running pod of the deployment frontend
+$ kubectl port-forward deployment/frontend 8080:http`
 )
 
 func NewCmdPortForward(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "port-forward POD [LOCAL_PORT:]REMOTE_PORT [...[LOCAL_PORT_N:]REMOTE_PORT_N]",
+		Use:     "port-forward (POD | TYPE/NAME) [LOCAL_PORT:]REMOTE_PORT [...[LOCAL_PORT_N:]REMOTE_PORT_N]",
 		Short:   "Forward one or more local ports to a pod.",
-		Long:    "Forward one or more local ports to a pod.",
+		Long:    portforward_long,
 		Example: portforward_example,
 		Run: func(cmd *cobra.Command, args []string) {
 			err := RunPortForward(f, cmd, args, &defaultPortForwarder{})
@@ -106,7 +125,11 @@ func RunPortForward(f *cmdutil.Factory, cmd *cobra.Command, args []string, fw po
 		return err
 	}
 
-	pod, err := client.Pods(namespace).Get(podName)
+	pod, obj, err := podForResource(f, client, namespace, podName)
+	if err != nil {
+		return err
+	}
+	ports, err := translatePorts(obj, pod, args)
 	if err != nil {
 		return err
 	}
@@ -136,5 +159,73 @@ func RunPortForward(f *cmdutil.Factory, cmd *cobra.Command, args []string, fw po
 		Name(pod.Name).
 		SubResource("portforward")
 
-	return fw.ForwardPorts("POST", req.URL(), config, args, stopCh)
+	return fw.ForwardPorts("POST", req.URL(), config, ports, stopCh)
+}
+
+// translatePorts maps the remote ports of the [LOCAL_PORT:]REMOTE_PORT specs
+// in ports to container ports of pod. If obj is a service, the remote ports
+// are service ports, identified by number or name, and are mapped to their
+// target ports. Otherwise a remote port may be the name of a container port.
+// The local port defaults to the remote port given by the user.
+func translatePorts(obj runtime.Object, pod *api.Pod, ports []string) ([]string, error) {
+	translated := []string{}
+	for _, port := range ports {
+		local, remote := port, port
+		if parts := strings.SplitN(port, ":", 2); len(parts) == 2 {
+			local, remote = parts[0], parts[1]
+		}
+
+		var containerPort int
+		var err error
+		if svc, ok := obj.(*api.Service); ok {
+			containerPort, err = servicePortToContainerPort(svc, pod, remote)
+		} else {
+			containerPort, err = containerPortByNameOrNumber(pod, remote)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, err := strconv.Atoi(local); err != nil && len(local) > 0 {
+			// A named remote port used as the local port as well.
+			local = strconv.Itoa(containerPort)
+		}
+		translated = append(translated, fmt.Sprintf("%s:%d", local, containerPort))
+	}
+	return translated, nil
+}
+
+// servicePortToContainerPort returns the container port of pod that the
+// service port of svc identified by port forwards to.
+func servicePortToContainerPort(svc *api.Service, pod *api.Pod, port string) (int, error) {
+	for _, servicePort := range svc.Spec.Ports {
+		if strconv.Itoa(servicePort.Port) != port && servicePort.Name != port {
+			continue
+		}
+		switch servicePort.TargetPort.Type {
+		case intstr.String:
+			return containerPortByNameOrNumber(pod, servicePort.TargetPort.StrVal)
+		case intstr.Int:
+			if servicePort.TargetPort.IntValue() == 0 {
+				return servicePort.Port, nil
+			}
+			return servicePort.TargetPort.IntValue(), nil
+		}
+	}
+	return 0, fmt.Errorf("service %s does not have a port %s", svc.Name, port)
+}
+
+// containerPortByNameOrNumber returns port as a number, looking it up among
+// the named ports of the containers of pod if it is not a number.
+func containerPortByNameOrNumber(pod *api.Pod, port string) (int, error) {
+	if number, err := strconv.Atoi(port); err == nil {
+		return number, nil
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			if containerPort.Name == port {
+				return containerPort.ContainerPort, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("pod %s does not have a container port named %s", pod.Name, port)
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -29,17 +30,21 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/client/unversioned/fake"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/intstr"
 )
 
 type fakePortForwarder struct {
 	method string
 	url    *url.URL
+	ports  []string
 	pfErr  error
 }
 
 func (f *fakePortForwarder) ForwardPorts(method string, url *url.URL, config *client.Config, ports []string, stopChan <-chan struct{}) error {
 	f.method = method
 	f.url = url
+	f.ports = ports
 	return f.pfErr
 }
 
@@ -174,3 +179,120 @@ func TestPortForwardWithPFlag(t *testing.T) {
 		}
 	}
 }
+
+func portForwardPod() *api.Pod {
+	pod := execPod()
+	pod.Labels = map[string]string{"app": "postgres"}
+	pod.Spec.Containers[0].Ports = []api.ContainerPort{
+		{Name: "sql", ContainerPort: 5433},
+		{Name: "http", ContainerPort: 8080},
+	}
+	return pod
+}
+
+func portForwardService() *api.Service {
+	return &api.Service{
+		ObjectMeta: api.ObjectMeta{Name: "postgres", Namespace: "test", ResourceVersion: "10"},
+		Spec: api.ServiceSpec{
+			Selector: map[string]string{"app": "postgres"},
+			Ports: []api.ServicePort{
+				{Name: "db", Port: 5432, TargetPort: intstr.FromString("sql")},
+				{Name: "web", Port: 80, TargetPort: intstr.FromInt(8080)},
+				{Name: "same", Port: 9000},
+			},
+		},
+	}
+}
+
+func TestTranslatePorts(t *testing.T) {
+	pod := portForwardPod()
+	tests := []struct {
+		name     string
+		obj      runtime.Object
+		ports    []string
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "pod ports",
+			obj:      pod,
+			ports:    []string{"5000", "8888:5000", ":5000"},
+			expected: []string{"5000:5000", "8888:5000", ":5000"},
+		},
+		{
+			name:     "named container ports",
+			obj:      pod,
+			ports:    []string{"http", "9999:sql"},
+			expected: []string{"8080:8080", "9999:5433"},
+		},
+		{
+			name:     "service ports",
+			obj:      portForwardService(),
+			ports:    []string{"5432", "8000:80", "9000", "web"},
+			expected: []string{"5432:5433", "8000:8080", "9000:9000", "8080:8080"},
+		},
+		{
+			name:  "unknown service port",
+			obj:   portForwardService(),
+			ports: []string{"5433"},
+			err:   true,
+		},
+		{
+			name:  "unknown container port name",
+			obj:   pod,
+			ports: []string{"metrics"},
+			err:   true,
+		},
+	}
+	for _, test := range tests {
+		ports, err := translatePorts(test.obj, pod, test.ports)
+		if (err != nil) != test.err {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(test.expected, ports) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, ports)
+		}
+	}
+}
+
+func TestPortForwardService(t *testing.T) {
+	version := testapi.Default.Version()
+	pending := portForwardPod()
+	pending.Name = "pending"
+	pending.Status.Phase = api.PodPending
+
+	f, tf, codec := NewAPIFactory()
+	tf.Client = &fake.RESTClient{
+		Codec: codec,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/services/postgres" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, portForwardService())}, nil
+			case p == "/api/"+version+"/namespaces/test/pods" && m == "GET":
+				if selector := req.URL.Query().Get(unversioned.LabelSelectorQueryParam(version)); selector != "app=postgres" {
+					t.Errorf("unexpected selector: %s", selector)
+				}
+				pods := &api.PodList{Items: []api.Pod{*pending, *portForwardPod()}}
+				return &http.Response{StatusCode: 200, Body: objBody(codec, pods)}, nil
+			default:
+				t.Errorf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = &client.Config{GroupVersion: &unversioned.GroupVersion{Version: version}}
+	ff := &fakePortForwarder{}
+	cmd := &cobra.Command{}
+	cmd.Flags().StringP("pod", "p", "", "Pod name")
+	if err := RunPortForward(f, cmd, []string{"services/postgres", "5432"}, ff); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ff.url.Path != "/api/"+version+"/namespaces/test/pods/foo/portforward" {
+		t.Errorf("unexpected portforward path: %s", ff.url.Path)
+	}
+	if !reflect.DeepEqual([]string{"5432:5433"}, ff.ports) {
+		t.Errorf("unexpected ports: %v", ff.ports)
+	}
+}
@@ -82,6 +82,7 @@ func NewCmdReplace(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().Int("grace-period", -1, "Only relevant during a force replace. Period of time in seconds given to the old resource to terminate gracefully. Ignored if negative.")
 	cmd.Flags().Duration("timeout", 0, "Only relevant during a force replace. The length of time to wait before giving up on a delete of the old resource, zero means determine a timeout from the size of the object")
 	cmdutil.AddValidateFlags(cmd)
+	cmdutil.AddServerDryRunFlag(cmd)
 	cmdutil.AddOutputFlagsForMutation(cmd)
 	cmdutil.AddApplyAnnotationFlags(cmd)
 	return cmd
@@ -106,8 +107,12 @@ func RunReplace(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []st
 		return cmdutil.UsageError(cmd, "Must specify --filename to replace")
 	}
 
+	serverDryRun := cmdutil.GetFlagBool(cmd, "server-dry-run")
 	shortOutput := cmdutil.GetFlagString(cmd, "output") == "name"
 	if force {
+		if serverDryRun {
+			return cmdutil.UsageError(cmd, "--server-dry-run cannot be used with --force")
+		}
 		return forceReplace(f, out, cmd, args, shortOutput, options)
 	}
 
@@ -134,14 +139,16 @@ func RunReplace(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []st
 		}
 
 		// Serialize the object with the annotation applied.
-		obj, err := resource.NewHelper(info.Client, info.Mapping).Replace(info.Namespace, info.Name, true, info.Object)
+		helper := resource.NewHelper(info.Client, info.Mapping)
+		helper.ServerDryRun = serverDryRun
+		obj, err := helper.Replace(info.Namespace, info.Name, true, info.Object)
 		if err != nil {
 			return cmdutil.AddSourceToErr("replacing", info.Source, err)
 		}
 
 		info.Refresh(obj, true)
 		printObjectSpecificMessage(obj, out)
-		cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "replaced")
+		cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "replaced"+serverDryRunSuffix(serverDryRun))
 		return nil
 	})
 }
@@ -0,0 +1,111 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/kubectl"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+)
+
+// PauseOptions is the start of the data required to perform the operation.  As new fields are added, add them here instead of
+// referencing the cmd.Flags()
+type PauseOptions struct {
+	Filenames []string
+}
+
+const (
+	pause_long = `pause marks the provided resource as paused.
+
+The controller keeps scaling a paused resource and updating its status, but
+does not roll out changes to its pod template until it is resumed.
+Use "kubectl rollout resume" to resume a paused resource.
+Currently only deployments support being paused.`
+	pause_example = `# Mark the nginx deployment as paused. Any current state of
+# the deployment will continue its function, new updates to its pod template will not
+# be rolled out as long as the deployment is paused.
+$ kubectl rollout pause deployment/nginx`
+)
+
+func NewCmdRolloutPause(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	options := &PauseOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "pause RESOURCE",
+		Short:   "Mark the provided resource as paused",
+		Long:    pause_long,
+		Example: pause_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(RunPause(f, cmd, out, args, options))
+		},
+	}
+
+	usage := "Filename, directory, or URL to a file identifying the resource to get from a server."
+	kubectl.AddJsonFilenameFlag(cmd, &options.Filenames, usage)
+	return cmd
+}
+
+func RunPause(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, args []string, options *PauseOptions) error {
+	if len(args) == 0 && len(options.Filenames) == 0 {
+		return cmdutil.UsageError(cmd, "Required resource not specified.")
+	}
+
+	cmdNamespace, enforceNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		FilenameParam(enforceNamespace, options.Filenames...).
+		ResourceTypeOrNameArgs(true, args...).
+		Latest().
+		Flatten().
+		Do()
+	err = r.Err()
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	err = r.Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+		unchanged, err := f.Pauser(info)
+		if err != nil {
+			errs = append(errs, err)
+			return nil
+		}
+		if unchanged {
+			cmdutil.PrintSuccess(mapper, false, out, info.Mapping.Resource, info.Name, "already paused")
+			return nil
+		}
+		cmdutil.PrintSuccess(mapper, false, out, info.Mapping.Resource, info.Name, "paused")
+		return nil
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
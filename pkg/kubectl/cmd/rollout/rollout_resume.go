@@ -0,0 +1,108 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/kubectl"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+)
+
+// ResumeOptions is the start of the data required to perform the operation.  As new fields are added, add them here instead of
+// referencing the cmd.Flags()
+type ResumeOptions struct {
+	Filenames []string
+}
+
+const (
+	resume_long = `resume resumes a paused resource.
+
+The controller does not roll out changes to the pod template of a paused
+resource. By resuming a resource, we allow pending changes to be rolled out.
+Currently only deployments support being resumed.`
+	resume_example = `# Resume an already paused deployment
+$ kubectl rollout resume deployment/nginx`
+)
+
+func NewCmdRolloutResume(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	options := &ResumeOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "resume RESOURCE",
+		Short:   "Resume a paused resource",
+		Long:    resume_long,
+		Example: resume_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(RunResume(f, cmd, out, args, options))
+		},
+	}
+
+	usage := "Filename, directory, or URL to a file identifying the resource to get from a server."
+	kubectl.AddJsonFilenameFlag(cmd, &options.Filenames, usage)
+	return cmd
+}
+
+func RunResume(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, args []string, options *ResumeOptions) error {
+	if len(args) == 0 && len(options.Filenames) == 0 {
+		return cmdutil.UsageError(cmd, "Required resource not specified.")
+	}
+
+	cmdNamespace, enforceNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		FilenameParam(enforceNamespace, options.Filenames...).
+		ResourceTypeOrNameArgs(true, args...).
+		Latest().
+		Flatten().
+		Do()
+	err = r.Err()
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	err = r.Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+		unchanged, err := f.Resumer(info)
+		if err != nil {
+			errs = append(errs, err)
+			return nil
+		}
+		if unchanged {
+			cmdutil.PrintSuccess(mapper, false, out, info.Mapping.Resource, info.Name, "not paused")
+			return nil
+		}
+		cmdutil.PrintSuccess(mapper, false, out, info.Mapping.Resource, info.Name, "resumed")
+		return nil
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
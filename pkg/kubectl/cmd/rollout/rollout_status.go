@@ -0,0 +1,140 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/kubectl"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// StatusOptions is the start of the data required to perform the operation.  As new fields are added, add them here instead of
+// referencing the cmd.Flags()
+type StatusOptions struct {
+	Filenames []string
+}
+
+const (
+	status_long = `watch the status of current rollout, until it's done.
+
+The command exits with a non-zero code if the rollout fails, e.g. when a
+deployment exceeds its progress deadline.`
+	status_example = `# Watch the rollout status of a deployment
+$ kubectl rollout status deployment/nginx`
+)
+
+func NewCmdRolloutStatus(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	options := &StatusOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "status (TYPE NAME | TYPE/NAME) [flags]",
+		Short:   "watch rollout status until it's done",
+		Long:    status_long,
+		Example: status_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(RunStatus(f, cmd, out, args, options))
+		},
+	}
+
+	usage := "Filename, directory, or URL to a file identifying the resource to get from a server."
+	kubectl.AddJsonFilenameFlag(cmd, &options.Filenames, usage)
+	return cmd
+}
+
+func RunStatus(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, args []string, options *StatusOptions) error {
+	if len(args) == 0 && len(options.Filenames) == 0 {
+		return cmdutil.UsageError(cmd, "Required resource not specified.")
+	}
+
+	cmdNamespace, enforceNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		FilenameParam(enforceNamespace, options.Filenames...).
+		ResourceTypeOrNameArgs(true, args...).
+		SingleResourceType().
+		Latest().
+		Do()
+	infos, err := r.Infos()
+	if err != nil {
+		return err
+	}
+	if len(infos) != 1 {
+		return fmt.Errorf("rollout status is only supported on individual resources and resource collections are not supported yet")
+	}
+	info := infos[0]
+
+	statusViewer, err := f.StatusViewer(info.ResourceMapping())
+	if err != nil {
+		return err
+	}
+
+	// Print the current status, and return if the rollout is already done.
+	status, done, err := statusViewer.Status(info.Namespace, info.Name)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(out, status)
+	if done {
+		return nil
+	}
+
+	resourceVersion := info.ResourceVersion
+	for {
+		w, err := info.Watch(resourceVersion)
+		if err != nil {
+			return err
+		}
+		for event := range w.ResultChan() {
+			switch event.Type {
+			case watch.Deleted:
+				w.Stop()
+				return fmt.Errorf("%s %q was deleted before the rollout finished", info.Mapping.Resource, info.Name)
+			case watch.Error:
+				w.Stop()
+				return fmt.Errorf("error watching %s %q: %v", info.Mapping.Resource, info.Name, event.Object)
+			}
+			if version, err := info.Mapping.MetadataAccessor.ResourceVersion(event.Object); err == nil {
+				resourceVersion = version
+			}
+			newStatus, done, err := statusViewer.Status(info.Namespace, info.Name)
+			if err != nil {
+				w.Stop()
+				return err
+			}
+			if newStatus != status {
+				fmt.Fprint(out, newStatus)
+				status = newStatus
+			}
+			if done {
+				w.Stop()
+				return nil
+			}
+		}
+		// The server closed the watch; start a new one from where it stopped.
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/kubectl"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+)
+
+// UndoOptions is the start of the data required to perform the operation.  As new fields are added, add them here instead of
+// referencing the cmd.Flags()
+type UndoOptions struct {
+	Filenames []string
+}
+
+const (
+	undo_long    = `undo rolls back to a previous rollout.`
+	undo_example = `# Rollback to the previous deployment
+$ kubectl rollout undo deployment/abc
+
+# Rollback to deployment revision 3
+$ kubectl rollout undo deployment/abc --to-revision=3`
+)
+
+func NewCmdRolloutUndo(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	options := &UndoOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "undo (TYPE NAME | TYPE/NAME) [flags]",
+		Short:   "undoes a previous rollout",
+		Long:    undo_long,
+		Example: undo_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(RunUndo(f, cmd, out, args, options))
+		},
+	}
+
+	cmd.Flags().Int64("to-revision", 0, "The revision to rollback to. Default to 0 (last revision).")
+	usage := "Filename, directory, or URL to a file identifying the resource to get from a server."
+	kubectl.AddJsonFilenameFlag(cmd, &options.Filenames, usage)
+	return cmd
+}
+
+func RunUndo(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, args []string, options *UndoOptions) error {
+	if len(args) == 0 && len(options.Filenames) == 0 {
+		return cmdutil.UsageError(cmd, "Required resource not specified.")
+	}
+	toRevision := cmdutil.GetFlagInt64(cmd, "to-revision")
+	if toRevision < 0 {
+		return cmdutil.UsageError(cmd, "--to-revision must be a non-negative integer")
+	}
+
+	cmdNamespace, enforceNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		FilenameParam(enforceNamespace, options.Filenames...).
+		ResourceTypeOrNameArgs(true, args...).
+		Latest().
+		Flatten().
+		Do()
+	err = r.Err()
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	err = r.Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+		rollbacker, err := f.Rollbacker(info.ResourceMapping())
+		if err != nil {
+			errs = append(errs, err)
+			return nil
+		}
+		if err := rollbacker.Rollback(info.Namespace, info.Name, nil, toRevision); err != nil {
+			errs = append(errs, err)
+			return nil
+		}
+		cmdutil.PrintSuccess(mapper, false, out, info.Mapping.Resource, info.Name, "rolled back")
+		return nil
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
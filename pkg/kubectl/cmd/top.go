@@ -0,0 +1,121 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller/podautoscaler/metrics"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+)
+
+const (
+	topLong = `Display resource (CPU/memory) usage.
+
+The top command shows the current CPU and memory usage of nodes or pods, next to
+their requests and limits. Usage is read from Heapster through the API server
+service proxy, so Heapster must be running in the cluster.`
+)
+
+// NewCmdTop groups the commands that show resource usage.
+func NewCmdTop(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "Display resource (CPU/memory) usage of nodes or pods",
+		Long:  topLong,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+	cmd.AddCommand(NewCmdTopNode(f, out))
+	cmd.AddCommand(NewCmdTopPod(f, out))
+	return cmd
+}
+
+func addHeapsterFlags(cmd *cobra.Command) {
+	cmd.Flags().String("heapster-namespace", metrics.DefaultHeapsterNamespace, "Namespace of the Heapster service.")
+	cmd.Flags().String("heapster-service", metrics.DefaultHeapsterService, "Name of the Heapster service.")
+	cmd.Flags().String("heapster-scheme", metrics.DefaultHeapsterScheme, "Scheme (http or https) used to reach the Heapster service.")
+	cmd.Flags().String("heapster-port", metrics.DefaultHeapsterPort, "Port of the Heapster service, defaults to the first port of the service.")
+}
+
+func newHeapsterMetricsClient(cmd *cobra.Command, c client.Interface) *metrics.HeapsterMetricsClient {
+	return metrics.NewHeapsterMetricsClient(c,
+		cmdutil.GetFlagString(cmd, "heapster-namespace"),
+		cmdutil.GetFlagString(cmd, "heapster-scheme"),
+		cmdutil.GetFlagString(cmd, "heapster-service"),
+		cmdutil.GetFlagString(cmd, "heapster-port"))
+}
+
+// isPodTerminated returns true if the pod can no longer use any resources.
+func isPodTerminated(pod *api.Pod) bool {
+	return pod.Status.Phase == api.PodSucceeded || pod.Status.Phase == api.PodFailed
+}
+
+// podRequestsAndLimits sums the requests and limits of all containers of a pod.
+func podRequestsAndLimits(pod *api.Pod) (api.ResourceList, api.ResourceList) {
+	reqs, limits := api.ResourceList{}, api.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addResourceList(reqs, container.Resources.Requests)
+		addResourceList(limits, container.Resources.Limits)
+	}
+	return reqs, limits
+}
+
+func addResourceList(list, add api.ResourceList) {
+	for name, quantity := range add {
+		if value, ok := list[name]; !ok {
+			list[name] = *quantity.Copy()
+		} else {
+			value.Add(quantity)
+			list[name] = value
+		}
+	}
+}
+
+// formatUsage formats a usage like formatResource, or as <unknown> if no
+// metrics have been reported for it.
+func formatUsage(usage api.ResourceList, name api.ResourceName, capacity api.ResourceList) string {
+	if _, found := usage[name]; !found {
+		return "<unknown>"
+	}
+	return formatResource(usage, name, capacity)
+}
+
+// formatResource formats a CPU quantity in millicores and a memory quantity in
+// mebibytes. If capacity is not nil, the share of capacity is appended.
+func formatResource(list api.ResourceList, name api.ResourceName, capacity api.ResourceList) string {
+	q, total := list[name], capacity[name]
+	var s string
+	var value, max int64
+	if name == api.ResourceCPU {
+		value, max = q.MilliValue(), total.MilliValue()
+		s = fmt.Sprintf("%dm", value)
+	} else {
+		value, max = q.Value(), total.Value()
+		s = fmt.Sprintf("%dMi", value/(1024*1024))
+	}
+	if max > 0 {
+		s += fmt.Sprintf(" (%d%%)", value*100/max)
+	}
+	return s
+}
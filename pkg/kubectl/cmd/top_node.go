@@ -0,0 +1,133 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/kubectl"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+const (
+	topNodeLong = `Display resource (CPU/memory) usage of nodes.
+
+Usage, and the requests and limits of the pods running on each node, are shown
+together with their share of the node's capacity.`
+
+	topNodeExample = `# Show metrics for all nodes
+$ kubectl top node
+
+# Show metrics for a given node
+$ kubectl top node NODE_NAME`
+)
+
+// NewCmdTopNode is a command to show the resource usage of nodes.
+func NewCmdTopNode(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "node [NAME | -l label]",
+		Aliases: []string{"nodes"},
+		Short:   "Display resource (CPU/memory) usage of nodes",
+		Long:    topNodeLong,
+		Example: topNodeExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunTopNode(f, cmd, out, args)
+			cmdutil.CheckErr(err)
+		},
+	}
+	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on")
+	addHeapsterFlags(cmd)
+	return cmd
+}
+
+// RunTopNode implements the behavior to run the top node command.
+func RunTopNode(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, args []string) error {
+	if len(args) > 1 {
+		return cmdutil.UsageError(cmd, "at most one NAME may be given, got %d", len(args))
+	}
+	selector, err := labels.Parse(cmdutil.GetFlagString(cmd, "selector"))
+	if err != nil {
+		return err
+	}
+	c, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	var nodes []api.Node
+	if len(args) == 1 {
+		node, err := c.Nodes().Get(args[0])
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, *node)
+	} else {
+		nodeList, err := c.Nodes().List(selector, fields.Everything())
+		if err != nil {
+			return err
+		}
+		nodes = nodeList.Items
+	}
+
+	pods, err := c.Pods(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	reqs, limits := map[string]api.ResourceList{}, map[string]api.ResourceList{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if len(pod.Spec.NodeName) == 0 || isPodTerminated(pod) {
+			continue
+		}
+		if reqs[pod.Spec.NodeName] == nil {
+			reqs[pod.Spec.NodeName], limits[pod.Spec.NodeName] = api.ResourceList{}, api.ResourceList{}
+		}
+		podReqs, podLimits := podRequestsAndLimits(pod)
+		addResourceList(reqs[pod.Spec.NodeName], podReqs)
+		addResourceList(limits[pod.Spec.NodeName], podLimits)
+	}
+
+	metricsClient := newHeapsterMetricsClient(cmd, c)
+	w := kubectl.GetNewTabWriter(out)
+	defer w.Flush()
+	fmt.Fprint(w, "NAME\tCPU\tCPU REQUESTS\tCPU LIMITS\tMEMORY\tMEMORY REQUESTS\tMEMORY LIMITS\n")
+	for _, node := range nodes {
+		// A node without metrics, e.g. one that just joined, is shown with
+		// unknown usage rather than failing the whole listing.
+		usage, err := metricsClient.GetNodeResourceUsage(node.Name)
+		if err != nil {
+			glog.V(2).Infof("Unable to get metrics of node %s: %v", node.Name, err)
+		}
+		capacity := node.Status.Capacity
+		nodeReqs, nodeLimits := reqs[node.Name], limits[node.Name]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", node.Name,
+			formatUsage(usage, api.ResourceCPU, capacity),
+			formatResource(nodeReqs, api.ResourceCPU, capacity),
+			formatResource(nodeLimits, api.ResourceCPU, capacity),
+			formatUsage(usage, api.ResourceMemory, capacity),
+			formatResource(nodeReqs, api.ResourceMemory, capacity),
+			formatResource(nodeLimits, api.ResourceMemory, capacity))
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	heapster "k8s.io/heapster/api/v1/types"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/fake"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+const heapsterModelPath = "/api/v1/proxy/namespaces/kube-system/services/http:heapster:/api/v1/model"
+
+func heapsterResponse(t *testing.T, obj interface{}) *http.Response {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(data))}
+}
+
+func heapsterSample(value uint64) heapster.MetricResult {
+	return heapster.MetricResult{Metrics: []heapster.MetricPoint{{Timestamp: time.Now(), Value: value}}}
+}
+
+func newTopTestFactory(handler func(req *http.Request) (*http.Response, error)) (*cmdutil.Factory, runtime.Codec) {
+	f, tf, codec := NewAPIFactory()
+	httpClient := fake.CreateHTTPClient(handler)
+	tf.Client = &fake.RESTClient{Codec: codec, Client: httpClient}
+	tf.ClientConfig = &client.Config{GroupVersion: testapi.Default.GroupVersion(), Transport: httpClient.Transport}
+	tf.Namespace = "test"
+	return f, codec
+}
+
+func topTestContainer(name, cpu, memory string) api.Container {
+	return api.Container{
+		Name: name,
+		Resources: api.ResourceRequirements{
+			Requests: api.ResourceList{api.ResourceCPU: resource.MustParse(cpu), api.ResourceMemory: resource.MustParse(memory)},
+		},
+	}
+}
+
+func TestTopNode(t *testing.T) {
+	node := api.Node{
+		ObjectMeta: api.ObjectMeta{Name: "node-1"},
+		Status: api.NodeStatus{
+			Capacity: api.ResourceList{api.ResourceCPU: resource.MustParse("2"), api.ResourceMemory: resource.MustParse("1Gi")},
+		},
+	}
+	// Heapster has no metrics for a node that just joined.
+	newNode := api.Node{ObjectMeta: api.ObjectMeta{Name: "node-2"}}
+	pods := []api.Pod{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "a", Namespace: "test"},
+			Spec:       api.PodSpec{NodeName: "node-1", Containers: []api.Container{topTestContainer("c", "500m", "256Mi")}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "done", Namespace: "test"},
+			Spec:       api.PodSpec{NodeName: "node-1", Containers: []api.Container{topTestContainer("c", "1", "512Mi")}},
+			Status:     api.PodStatus{Phase: api.PodSucceeded},
+		},
+	}
+
+	var codec runtime.Codec
+	f, codec := newTopTestFactory(func(req *http.Request) (*http.Response, error) {
+		switch p, m := req.URL.Path, req.Method; {
+		case p == "/api/v1/nodes" && m == "GET":
+			return &http.Response{StatusCode: 200, Body: objBody(codec, &api.NodeList{Items: []api.Node{node, newNode}})}, nil
+		case p == "/api/v1/pods" && m == "GET":
+			return &http.Response{StatusCode: 200, Body: objBody(codec, &api.PodList{Items: pods})}, nil
+		case p == heapsterModelPath+"/nodes/node-1/metrics/cpu-usage" && m == "GET":
+			return heapsterResponse(t, heapsterSample(1000)), nil
+		case p == heapsterModelPath+"/nodes/node-1/metrics/memory-usage" && m == "GET":
+			return heapsterResponse(t, heapsterSample(512*1024*1024)), nil
+		case strings.HasPrefix(p, heapsterModelPath+"/nodes/node-2/") && m == "GET":
+			return &http.Response{StatusCode: 404, Body: ioutil.NopCloser(bytes.NewBufferString("not found"))}, nil
+		default:
+			t.Fatalf("unexpected request: %s %#v", req.Method, req.URL)
+			return nil, nil
+		}
+	})
+	buf := bytes.NewBuffer([]byte{})
+	cmd := NewCmdTopNode(f, buf)
+	if err := RunTopNode(f, cmd, buf, []string{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and two nodes, got:\n%s", buf.String())
+	}
+	expected := []string{
+		"node-1 1000m (50%) 500m (25%) 0m (0%) 512Mi (50%) 256Mi (25%) 0Mi (0%)",
+		"node-2 <unknown> 0m 0m <unknown> 0Mi 0Mi",
+	}
+	for i, line := range lines[1:] {
+		if fields := strings.Join(strings.Fields(line), " "); fields != expected[i] {
+			t.Errorf("unexpected output:\n%s", buf.String())
+		}
+	}
+}
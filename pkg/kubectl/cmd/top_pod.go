@@ -0,0 +1,149 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/kubectl"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+const (
+	topPodLong = `Display resource (CPU/memory) usage of pods.
+
+Usage is shown next to the sum of the requests and limits of the pod's containers.
+Pods for which Heapster has not reported any usage yet are shown as <unknown>.`
+
+	topPodExample = `# Show metrics for all pods in the default namespace
+$ kubectl top pod
+
+# Show metrics for a given pod and its containers
+$ kubectl top pod POD_NAME --containers
+
+# Show metrics for the pods defined by label name=myLabel
+$ kubectl top pod -l name=myLabel`
+)
+
+// NewCmdTopPod is a command to show the resource usage of pods.
+func NewCmdTopPod(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pod [NAME | -l label]",
+		Aliases: []string{"pods"},
+		Short:   "Display resource (CPU/memory) usage of pods",
+		Long:    topPodLong,
+		Example: topPodExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunTopPod(f, cmd, out, args)
+			cmdutil.CheckErr(err)
+		},
+	}
+	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on")
+	cmd.Flags().Bool("containers", false, "If true, show the usage of each container of the pods.")
+	addHeapsterFlags(cmd)
+	return cmd
+}
+
+// RunTopPod implements the behavior to run the top pod command.
+func RunTopPod(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, args []string) error {
+	if len(args) > 1 {
+		return cmdutil.UsageError(cmd, "at most one NAME may be given, got %d", len(args))
+	}
+	selector, err := labels.Parse(cmdutil.GetFlagString(cmd, "selector"))
+	if err != nil {
+		return err
+	}
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	c, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	var pods []api.Pod
+	if len(args) == 1 {
+		pod, err := c.Pods(namespace).Get(args[0])
+		if err != nil {
+			return err
+		}
+		pods = append(pods, *pod)
+	} else {
+		podList, err := c.Pods(namespace).List(selector, fields.Everything())
+		if err != nil {
+			return err
+		}
+		for _, pod := range podList.Items {
+			if !isPodTerminated(&pod) {
+				pods = append(pods, pod)
+			}
+		}
+	}
+
+	metricsClient := newHeapsterMetricsClient(cmd, c)
+	w := kubectl.GetNewTabWriter(out)
+	defer w.Flush()
+
+	if cmdutil.GetFlagBool(cmd, "containers") {
+		fmt.Fprint(w, "POD\tNAME\tCPU\tCPU REQUESTS\tCPU LIMITS\tMEMORY\tMEMORY REQUESTS\tMEMORY LIMITS\n")
+		for _, pod := range pods {
+			for _, container := range pod.Spec.Containers {
+				usage, err := metricsClient.GetContainerResourceUsage(namespace, pod.Name, container.Name)
+				if err != nil {
+					glog.V(2).Infof("Unable to get metrics of container %s of pod %s: %v", container.Name, pod.Name, err)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\n", pod.Name, container.Name, formatPodUsage(usage, container.Resources.Requests, container.Resources.Limits))
+			}
+		}
+		return nil
+	}
+
+	names := []string{}
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	usage, err := metricsClient.GetPodResourceUsage(namespace, names)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(w, "NAME\tCPU\tCPU REQUESTS\tCPU LIMITS\tMEMORY\tMEMORY REQUESTS\tMEMORY LIMITS\n")
+	for i := range pods {
+		reqs, limits := podRequestsAndLimits(&pods[i])
+		fmt.Fprintf(w, "%s\t%s\n", pods[i].Name, formatPodUsage(usage[pods[i].Name], reqs, limits))
+	}
+	return nil
+}
+
+// formatPodUsage formats the usage, requests and limits of a pod or container.
+// A nil usage means no metrics have been reported yet.
+func formatPodUsage(usage, reqs, limits api.ResourceList) string {
+	return fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s",
+		formatUsage(usage, api.ResourceCPU, nil),
+		formatResource(reqs, api.ResourceCPU, nil),
+		formatResource(limits, api.ResourceCPU, nil),
+		formatUsage(usage, api.ResourceMemory, nil),
+		formatResource(reqs, api.ResourceMemory, nil),
+		formatResource(limits, api.ResourceMemory, nil))
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	heapster "k8s.io/heapster/api/v1/types"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+func TestTopPod(t *testing.T) {
+	pods := []api.Pod{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "a", Namespace: "test"},
+			Spec:       api.PodSpec{Containers: []api.Container{topTestContainer("c1", "100m", "64Mi"), topTestContainer("c2", "200m", "64Mi")}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "b", Namespace: "test"},
+			Spec:       api.PodSpec{Containers: []api.Container{{Name: "c"}}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		containers bool
+		expected   []string
+	}{
+		{
+			name: "pods",
+			expected: []string{
+				"a 150m 300m 0m 32Mi 128Mi 0Mi",
+				"b <unknown> 0m 0m <unknown> 0Mi 0Mi",
+			},
+		},
+		{
+			name:       "containers",
+			containers: true,
+			expected: []string{
+				"a c1 50m 100m 0m 50Mi 64Mi 0Mi",
+				"a c2 100m 200m 0m 100Mi 64Mi 0Mi",
+				"b c <unknown> 0m 0m <unknown> 0Mi 0Mi",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		var codec runtime.Codec
+		f, codec := newTopTestFactory(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/api/v1/namespaces/test/pods" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &api.PodList{Items: pods})}, nil
+			case p == heapsterModelPath+"/namespaces/test/pod-list/a,b/metrics/cpu-usage" && m == "GET":
+				return heapsterResponse(t, heapster.MetricResultList{Items: []heapster.MetricResult{heapsterSample(150), {}}}), nil
+			case p == heapsterModelPath+"/namespaces/test/pod-list/a,b/metrics/memory-usage" && m == "GET":
+				return heapsterResponse(t, heapster.MetricResultList{Items: []heapster.MetricResult{heapsterSample(32 * 1024 * 1024), {}}}), nil
+			case strings.HasPrefix(p, heapsterModelPath+"/namespaces/test/pods/") && m == "GET":
+				// /namespaces/test/pods/POD/containers/CONTAINER/metrics/METRIC
+				parts := strings.Split(strings.TrimPrefix(p, heapsterModelPath), "/")
+				values := map[string]uint64{"c1": 50, "c2": 100}
+				value, found := values[parts[6]]
+				if !found {
+					// No samples reported for this container yet.
+					return heapsterResponse(t, heapster.MetricResult{}), nil
+				}
+				if parts[8] == "memory-usage" {
+					value = value * 1024 * 1024
+				}
+				return heapsterResponse(t, heapsterSample(value)), nil
+			default:
+				t.Fatalf("%s: unexpected request: %s %#v", test.name, req.Method, req.URL)
+				return nil, nil
+			}
+		})
+		buf := bytes.NewBuffer([]byte{})
+		cmd := NewCmdTopPod(f, buf)
+		if test.containers {
+			cmd.Flags().Set("containers", "true")
+		}
+		if err := RunTopPod(f, cmd, buf, []string{}); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")[1:]
+		actual := []string{}
+		for _, line := range lines {
+			actual = append(actual, strings.Join(strings.Fields(line), " "))
+		}
+		if strings.Join(actual, "\n") != strings.Join(test.expected, "\n") {
+			t.Errorf("%s: unexpected output:\n%s", test.name, buf.String())
+		}
+	}
+}
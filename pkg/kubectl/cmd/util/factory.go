@@ -79,6 +79,18 @@ type Factory struct {
 	Scaler func(mapping *meta.RESTMapping) (kubectl.Scaler, error)
 	// Returns a Reaper for gracefully shutting down resources.
 	Reaper func(mapping *meta.RESTMapping) (kubectl.Reaper, error)
+	// Returns a HistoryViewer for viewing the rollout history of the specified RESTMapping type or an error.
+	HistoryViewer func(mapping *meta.RESTMapping) (kubectl.HistoryViewer, error)
+	// Returns a Rollbacker for rolling back the specified RESTMapping type or an error.
+	Rollbacker func(mapping *meta.RESTMapping) (kubectl.Rollbacker, error)
+	// Returns a StatusViewer for printing rollout status.
+	StatusViewer func(mapping *meta.RESTMapping) (kubectl.StatusViewer, error)
+	// Pauser marks the object in the info as paused, so that its controller stops rolling it out.
+	// Returns true if the object was already paused.
+	Pauser func(info *resource.Info) (bool, error)
+	// Resumer resumes the rollout of the paused object in the info.
+	// Returns true if the object was not paused.
+	Resumer func(info *resource.Info) (bool, error)
 	// PodSelectorForObject returns the pod selector associated with the provided object
 	PodSelectorForObject func(object runtime.Object) (string, error)
 	// PortsForObject returns the ports associated with the provided object
@@ -124,6 +136,13 @@ func NewFactory(optionalClientConfig clientcmd.ClientConfig) *Factory {
 		"horizontalpodautoscaler/v1beta1": kubectl.HorizontalPodAutoscalerV1Beta1{},
 		"deployment/v1beta1":              kubectl.DeploymentV1Beta1{},
 		"job/v1beta1":                     kubectl.JobV1Beta1{},
+		"configmap/v1":                    kubectl.ConfigMapGeneratorV1{},
+		"secret/v1":                       kubectl.SecretGeneratorV1{},
+		"secret-for-docker-registry/v1":   kubectl.SecretForDockerRegistryGeneratorV1{},
+		"secret-for-tls/v1":               kubectl.SecretForTLSGeneratorV1{},
+		"namespace/v1":                    kubectl.NamespaceGeneratorV1{},
+		"serviceaccount/v1":               kubectl.ServiceAccountGeneratorV1{},
+		"resourcequota/v1":                kubectl.ResourceQuotaGeneratorV1{},
 	}
 
 	clientConfig := optionalClientConfig
@@ -193,13 +212,18 @@ func NewFactory(optionalClientConfig clientcmd.ClientConfig) *Factory {
 			switch t := object.(type) {
 			case *api.ReplicationController:
 				return kubectl.MakeLabels(t.Spec.Selector), nil
+			case *extensions.Deployment:
+				if len(t.Spec.Selector) == 0 {
+					return "", fmt.Errorf("the deployment has no pod selector set")
+				}
+				return kubectl.MakeLabels(t.Spec.Selector), nil
 			case *api.Pod:
 				if len(t.Labels) == 0 {
 					return "", fmt.Errorf("the pod has no labels and cannot be exposed")
 				}
 				return kubectl.MakeLabels(t.Labels), nil
 			case *api.Service:
-				if t.Spec.Selector == nil {
+				if len(t.Spec.Selector) == 0 {
 					return "", fmt.Errorf("the service has no pod selector set")
 				}
 				return kubectl.MakeLabels(t.Spec.Selector), nil
@@ -266,6 +290,61 @@ func NewFactory(optionalClientConfig clientcmd.ClientConfig) *Factory {
 			}
 			return kubectl.ReaperFor(mapping.GroupVersionKind.Kind, client)
 		},
+		HistoryViewer: func(mapping *meta.RESTMapping) (kubectl.HistoryViewer, error) {
+			client, err := clients.ClientForVersion(mapping.GroupVersionKind.GroupVersion().String())
+			if err != nil {
+				return nil, err
+			}
+			return kubectl.HistoryViewerFor(mapping.GroupVersionKind.Kind, client)
+		},
+		Rollbacker: func(mapping *meta.RESTMapping) (kubectl.Rollbacker, error) {
+			client, err := clients.ClientForVersion(mapping.GroupVersionKind.GroupVersion().String())
+			if err != nil {
+				return nil, err
+			}
+			return kubectl.RollbackerFor(mapping.GroupVersionKind.Kind, client)
+		},
+		StatusViewer: func(mapping *meta.RESTMapping) (kubectl.StatusViewer, error) {
+			client, err := clients.ClientForVersion(mapping.GroupVersionKind.GroupVersion().String())
+			if err != nil {
+				return nil, err
+			}
+			return kubectl.StatusViewerFor(mapping.GroupVersionKind.Kind, client)
+		},
+		Pauser: func(info *resource.Info) (bool, error) {
+			switch obj := info.Object.(type) {
+			case *extensions.Deployment:
+				if obj.Spec.Paused {
+					return true, nil
+				}
+				obj.Spec.Paused = true
+				client, err := clients.ClientForVersion(info.Mapping.GroupVersionKind.GroupVersion().String())
+				if err != nil {
+					return false, err
+				}
+				_, err = client.Extensions().Deployments(obj.Namespace).Update(obj)
+				return false, err
+			default:
+				return false, fmt.Errorf("cannot pause %s", info.Mapping.GroupVersionKind.Kind)
+			}
+		},
+		Resumer: func(info *resource.Info) (bool, error) {
+			switch obj := info.Object.(type) {
+			case *extensions.Deployment:
+				if !obj.Spec.Paused {
+					return true, nil
+				}
+				obj.Spec.Paused = false
+				client, err := clients.ClientForVersion(info.Mapping.GroupVersionKind.GroupVersion().String())
+				if err != nil {
+					return false, err
+				}
+				_, err = client.Extensions().Deployments(obj.Namespace).Update(obj)
+				return false, err
+			default:
+				return false, fmt.Errorf("cannot resume %s", info.Mapping.GroupVersionKind.Kind)
+			}
+		},
 		Validator: func(validate bool, cacheDir string) (validation.Schema, error) {
 			if validate {
 				client, err := clients.ClientForVersion("")
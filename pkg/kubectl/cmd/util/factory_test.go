@@ -77,6 +77,14 @@ func TestPodSelectorForObject(t *testing.T) {
 	if expected != got {
 		t.Fatalf("Selector mismatch! Expected %s, got %s", expected, got)
 	}
+
+	// A service without a selector, whether nil or empty, selects no pods.
+	for _, selector := range []map[string]string{nil, {}} {
+		svc.Spec.Selector = selector
+		if _, err := f.PodSelectorForObject(svc); err == nil {
+			t.Errorf("Expected an error for selector %#v", selector)
+		}
+	}
 }
 
 func TestPortsForObject(t *testing.T) {
@@ -67,28 +67,49 @@ func AddSourceToErr(verb string, source string, err error) error {
 	return err
 }
 
+// DefaultErrorExitCode is the exit code CheckErr uses unless the error is an
+// *ExitError.
+const DefaultErrorExitCode = 1
+
+// ExitError is an error that makes CheckErr exit with Code. It lets a command
+// report a result through its exit code, like diff(1) does. If Err is nil,
+// nothing is printed.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("exit status %d", e.Code)
+	}
+	return e.Err.Error()
+}
+
 var fatalErrHandler = fatal
 
 // BehaviorOnFatal allows you to override the default behavior when a fatal
-// error occurs, which is call os.Exit(1). You can pass 'panic' as a function
-// here if you prefer the panic() over os.Exit(1).
-func BehaviorOnFatal(f func(string)) {
+// error occurs, which is call os.Exit with the given code. You can pass a
+// function calling 'panic' here if you prefer the panic() over os.Exit.
+func BehaviorOnFatal(f func(string, int)) {
 	fatalErrHandler = f
 }
 
-// fatal prints the message and then exits. If V(2) or greater, glog.Fatal
-// is invoked for extended information.
-func fatal(msg string) {
-	// add newline if needed
-	if !strings.HasSuffix(msg, "\n") {
-		msg += "\n"
-	}
+// fatal prints the message, if any, and then exits with code. If V(2) or
+// greater, glog.Fatal is invoked for extended information.
+func fatal(msg string, code int) {
+	if len(msg) > 0 {
+		// add newline if needed
+		if !strings.HasSuffix(msg, "\n") {
+			msg += "\n"
+		}
 
-	if glog.V(2) {
-		glog.FatalDepth(2, msg)
+		if glog.V(2) {
+			glog.FatalDepth(2, msg)
+		}
+		fmt.Fprint(os.Stderr, msg)
 	}
-	fmt.Fprint(os.Stderr, msg)
-	os.Exit(1)
+	os.Exit(code)
 }
 
 // CheckErr prints a user friendly error to STDERR and exits with a non-zero
@@ -100,31 +121,40 @@ func CheckErr(err error) {
 	checkErr(err, fatalErrHandler)
 }
 
-func checkErr(err error, handleErr func(string)) {
+func checkErr(err error, handleErr func(string, int)) {
 	if err == nil {
 		return
 	}
 
+	code := DefaultErrorExitCode
+	if exitErr, ok := err.(*ExitError); ok {
+		if exitErr.Err == nil {
+			handleErr("", exitErr.Code)
+			return
+		}
+		code, err = exitErr.Code, exitErr.Err
+	}
+
 	if errors.IsInvalid(err) {
 		details := err.(*errors.StatusError).Status().Details
 		prefix := fmt.Sprintf("The %s %q is invalid.\n", details.Kind, details.Name)
 		errs := statusCausesToAggrError(details.Causes)
-		handleErr(MultilineError(prefix, errs))
+		handleErr(MultilineError(prefix, errs), code)
 	}
 
 	// handle multiline errors
 	if clientcmd.IsConfigurationInvalid(err) {
-		handleErr(MultilineError("Error in configuration: ", err))
+		handleErr(MultilineError("Error in configuration: ", err), code)
 	}
 	if agg, ok := err.(utilerrors.Aggregate); ok && len(agg.Errors()) > 0 {
-		handleErr(MultipleErrors("", agg.Errors()))
+		handleErr(MultipleErrors("", agg.Errors()), code)
 	}
 
 	msg, ok := StandardErrorMessage(err)
 	if !ok {
 		msg = fmt.Sprintf("error: %s", err.Error())
 	}
-	handleErr(msg)
+	handleErr(msg, code)
 }
 
 func statusCausesToAggrError(scs []unversioned.StatusCause) utilerrors.Aggregate {
@@ -287,6 +317,10 @@ func AddValidateFlags(cmd *cobra.Command) {
 	cmd.Flags().String("schema-cache-dir", fmt.Sprintf("~/%s/%s", clientcmd.RecommendedHomeDir, clientcmd.RecommendedSchemaName), fmt.Sprintf("If non-empty, load/store cached API schemas in this directory, default is '$HOME/%s/%s'", clientcmd.RecommendedHomeDir, clientcmd.RecommendedSchemaName))
 }
 
+func AddServerDryRunFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("server-dry-run", false, "If true, send the request to the server to be validated and admitted without persisting the resource.")
+}
+
 func AddApplyAnnotationFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool(ApplyAnnotationsFlag, false, "If true, the configuration of current object will be saved in its annotation. This is useful when you want to perform kubectl apply on this object in the future.")
 }
@@ -288,7 +288,7 @@ func TestCheckInvalidErr(t *testing.T) {
 	}
 
 	var errReturned string
-	errHandle := func(err string) {
+	errHandle := func(err string, code int) {
 		errReturned = err
 	}
 
@@ -301,6 +301,42 @@ func TestCheckInvalidErr(t *testing.T) {
 	}
 }
 
+func TestCheckExitErr(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected string
+		code     int
+	}{
+		{
+			fmt.Errorf("plain"),
+			"error: plain",
+			DefaultErrorExitCode,
+		},
+		{
+			&ExitError{Code: 1},
+			"",
+			1,
+		},
+		{
+			&ExitError{Code: 2, Err: fmt.Errorf("failed")},
+			"error: failed",
+			2,
+		},
+	}
+
+	for _, test := range tests {
+		var errReturned string
+		var codeReturned int
+		checkErr(test.err, func(err string, code int) {
+			errReturned, codeReturned = err, code
+		})
+
+		if errReturned != test.expected || codeReturned != test.code {
+			t.Errorf("Got: %q (exit %d), expected: %q (exit %d)", errReturned, codeReturned, test.expected, test.code)
+		}
+	}
+}
+
 func TestDumpReaderToFile(t *testing.T) {
 	testString := "TEST STRING"
 	tempFile, err := ioutil.TempFile("", "hlpers_test_dump_")
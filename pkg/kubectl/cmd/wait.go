@@ -0,0 +1,243 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/kubectl"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// WaitOptions is the start of the data required to perform the operation.
+// As new fields are added, add them here instead of referencing the cmd.Flags()
+type WaitOptions struct {
+	Filenames []string
+	Selector  string
+	For       string
+	Timeout   time.Duration
+}
+
+const (
+	wait_long = `Wait for a specific condition on one or many resources.
+
+The command takes multiple resources and waits until the specified condition
+is seen in the Status.Conditions field of every given resource, or until each
+resource is deleted when --for=delete is given. Resources are watched rather
+than polled. A non-zero exit code is returned if the timeout is reached first.`
+	wait_example = `# Wait for the pod "busybox1" to contain the status condition of type "Ready".
+$ kubectl wait --for=condition=Ready pod/busybox1
+
+# Wait for all pods labelled app=nginx to be ready, for up to five minutes.
+$ kubectl wait --for=condition=Ready pod -l app=nginx --timeout=5m
+
+# Wait for the job "pi" to have a "Failed" condition with status "False".
+$ kubectl wait --for=condition=Failed=False job/pi
+
+# Wait for the pod "busybox1" to be deleted.
+$ kubectl delete pod/busybox1
+$ kubectl wait --for=delete pod/busybox1 --timeout=60s`
+)
+
+func NewCmdWait(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	options := &WaitOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "wait --for=[delete|condition=CONDITION[=STATUS]] (-f FILENAME | TYPE NAME | TYPE/NAME | TYPE -l label)",
+		Short:   "Wait for a condition on one or many resources",
+		Long:    wait_long,
+		Example: wait_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(RunWait(f, cmd, out, args, options))
+		},
+	}
+	usage := "Filename, directory, or URL to a file identifying the resources to wait for."
+	kubectl.AddJsonFilenameFlag(cmd, &options.Filenames, usage)
+	cmd.Flags().StringVarP(&options.Selector, "selector", "l", "", "Selector (label query) to filter on")
+	cmd.Flags().StringVar(&options.For, "for", "", "The condition to wait on: 'delete' or 'condition=CONDITION[=STATUS]'. The status defaults to True.")
+	cmd.Flags().DurationVar(&options.Timeout, "timeout", 30*time.Second, "The length of time to wait before giving up.")
+	cmdutil.AddOutputFlagsForMutation(cmd)
+	return cmd
+}
+
+// waitCondition describes what RunWait waits for on each resource.
+type waitCondition struct {
+	// deletion is true if the resource is expected to be deleted.
+	deletion bool
+	// conditionType and conditionStatus describe the status condition to
+	// wait for, unless deletion is true.
+	conditionType   string
+	conditionStatus string
+}
+
+// parseWaitCondition parses the value of the --for flag.
+func parseWaitCondition(value string) (*waitCondition, error) {
+	if value == "delete" {
+		return &waitCondition{deletion: true}, nil
+	}
+	if strings.HasPrefix(value, "condition=") {
+		parts := strings.SplitN(strings.TrimPrefix(value, "condition="), "=", 2)
+		if len(parts[0]) == 0 {
+			return nil, fmt.Errorf("a condition type is required in --for=%s", value)
+		}
+		condition := &waitCondition{conditionType: parts[0], conditionStatus: string(api.ConditionTrue)}
+		if len(parts) == 2 {
+			condition.conditionStatus = parts[1]
+		}
+		return condition, nil
+	}
+	return nil, fmt.Errorf("unrecognized condition %q, expected 'delete' or 'condition=CONDITION[=STATUS]'", value)
+}
+
+// met returns true if obj satisfies a status condition wait.
+func (c *waitCondition) met(info *resource.Info, obj runtime.Object) (bool, error) {
+	status, found, err := kubectl.ConditionStatus(info.Mapping.Codec, obj, c.conditionType)
+	if err != nil {
+		return false, err
+	}
+	return found && strings.EqualFold(status, c.conditionStatus), nil
+}
+
+func RunWait(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, args []string, options *WaitOptions) error {
+	if len(options.For) == 0 {
+		return cmdutil.UsageError(cmd, "--for is required")
+	}
+	condition, err := parseWaitCondition(options.For)
+	if err != nil {
+		return cmdutil.UsageError(cmd, "%v", err)
+	}
+	if len(args) == 0 && len(options.Filenames) == 0 {
+		return cmdutil.UsageError(cmd, "Must specify the resources to wait for")
+	}
+
+	cmdNamespace, enforceNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		ContinueOnError().
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		FilenameParam(enforceNamespace, options.Filenames...).
+		SelectorParam(options.Selector).
+		ResourceTypeOrNameArgs(false, args...).
+		// waitForInfo fetches each object itself, so that resources
+		// which are already gone satisfy --for=delete.
+		RequireObject(false).
+		Flatten().
+		Do()
+	infos, err := r.Infos()
+	if err != nil {
+		return err
+	}
+	if len(infos) == 0 {
+		return fmt.Errorf("no matching resources found")
+	}
+
+	shortOutput := cmdutil.GetFlagString(cmd, "output") == "name"
+	timeout := time.After(options.Timeout)
+	for _, info := range infos {
+		if err := waitForInfo(info, condition, timeout); err != nil {
+			return err
+		}
+		operation := "condition met"
+		if condition.deletion {
+			operation = "deleted"
+		}
+		cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, operation)
+	}
+	return nil
+}
+
+// waitForInfo watches the resource described by info until condition is
+// satisfied or timeout fires. The watch is restarted from the latest state
+// of the resource if the server closes it.
+func waitForInfo(info *resource.Info, condition *waitCondition, timeout <-chan time.Time) error {
+	for {
+		if err := info.Get(); err != nil {
+			if errors.IsNotFound(err) && condition.deletion {
+				return nil
+			}
+			return err
+		}
+		if !condition.deletion {
+			ok, err := condition.met(info, info.Object)
+			if err != nil || ok {
+				return err
+			}
+		}
+
+		w, err := info.Watch(info.ResourceVersion)
+		if err != nil {
+			return err
+		}
+		done, err := waitForEvent(w, info, condition, timeout)
+		if done || err != nil {
+			return err
+		}
+	}
+}
+
+// waitForEvent consumes the events of w. It returns true once condition is
+// satisfied, and false if the watch was closed before that.
+func waitForEvent(w watch.Interface, info *resource.Info, condition *waitCondition, timeout <-chan time.Time) (bool, error) {
+	defer w.Stop()
+	for {
+		select {
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return false, nil
+			}
+			switch event.Type {
+			case watch.Deleted:
+				if condition.deletion {
+					return true, nil
+				}
+				return false, fmt.Errorf("%s %q was deleted while waiting for condition %s", info.Mapping.Resource, info.Name, condition.conditionType)
+			case watch.Added, watch.Modified:
+				if condition.deletion {
+					continue
+				}
+				ok, err := condition.met(info, event.Object)
+				if err != nil || ok {
+					return ok, err
+				}
+			case watch.Error:
+				err := errors.FromObject(event.Object)
+				if status, ok := err.(*errors.StatusError); ok && status.ErrStatus.Code == http.StatusGone {
+					// The resource version is too old, start over.
+					return false, nil
+				}
+				return false, err
+			}
+		case <-timeout:
+			return false, fmt.Errorf("timed out waiting for %s %q", info.Mapping.Resource, info.Name)
+		}
+	}
+}
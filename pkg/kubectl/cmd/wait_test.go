@@ -0,0 +1,174 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/fake"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+func waitTestPod(ready api.ConditionStatus) *api.Pod {
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test", ResourceVersion: "10"},
+		Status: api.PodStatus{
+			Conditions: []api.PodCondition{{Type: api.PodReady, Status: ready}},
+		},
+	}
+}
+
+func TestParseWaitCondition(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected *waitCondition
+		err      bool
+	}{
+		{value: "delete", expected: &waitCondition{deletion: true}},
+		{value: "condition=Ready", expected: &waitCondition{conditionType: "Ready", conditionStatus: "True"}},
+		{value: "condition=Failed=False", expected: &waitCondition{conditionType: "Failed", conditionStatus: "False"}},
+		{value: "condition=", err: true},
+		{value: "Ready", err: true},
+	}
+	for _, test := range tests {
+		condition, err := parseWaitCondition(test.value)
+		if (err != nil) != test.err {
+			t.Errorf("%s: unexpected error: %v", test.value, err)
+			continue
+		}
+		if err == nil && *condition != *test.expected {
+			t.Errorf("%s: expected %#v, got %#v", test.value, test.expected, condition)
+		}
+	}
+}
+
+func TestWaitForCondition(t *testing.T) {
+	events := []watch.Event{
+		{Type: watch.Modified, Object: waitTestPod(api.ConditionFalse)},
+		{Type: watch.Modified, Object: waitTestPod(api.ConditionTrue)},
+	}
+
+	f, tf, codec := NewAPIFactory()
+	tf.Client = &fake.RESTClient{
+		Codec: codec,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/pods/foo" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, waitTestPod(api.ConditionFalse))}, nil
+			case p == "/watch/namespaces/test/pods/foo" && m == "GET":
+				if rv := req.URL.Query().Get("resourceVersion"); rv != "10" {
+					t.Errorf("unexpected resource version: %s", rv)
+				}
+				return &http.Response{StatusCode: 200, Body: watchBody(codec, events)}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdWait(f, buf)
+	options := &WaitOptions{For: "condition=Ready", Timeout: time.Minute}
+	if err := RunWait(f, cmd, buf, []string{"pods", "foo"}, options); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "pod \"foo\" condition met\n" {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func TestWaitForDelete(t *testing.T) {
+	tests := map[string]func(codec runtime.Codec) (int, io.ReadCloser){
+		"already deleted": func(codec runtime.Codec) (int, io.ReadCloser) {
+			return 404, objBody(codec, &unversioned.Status{Status: unversioned.StatusFailure, Reason: unversioned.StatusReasonNotFound, Code: 404})
+		},
+		"deleted while watching": func(codec runtime.Codec) (int, io.ReadCloser) {
+			return 200, objBody(codec, waitTestPod(api.ConditionTrue))
+		},
+	}
+	for name, getResponse := range tests {
+		f, tf, codec := NewAPIFactory()
+		tf.Client = &fake.RESTClient{
+			Codec: codec,
+			Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+				switch p, m := req.URL.Path, req.Method; {
+				case p == "/namespaces/test/pods/foo" && m == "GET":
+					code, body := getResponse(codec)
+					return &http.Response{StatusCode: code, Body: body}, nil
+				case p == "/watch/namespaces/test/pods/foo" && m == "GET":
+					events := []watch.Event{{Type: watch.Deleted, Object: waitTestPod(api.ConditionTrue)}}
+					return &http.Response{StatusCode: 200, Body: watchBody(codec, events)}, nil
+				default:
+					t.Fatalf("%s: unexpected request: %#v\n%#v", name, req.URL, req)
+					return nil, nil
+				}
+			}),
+		}
+		tf.Namespace = "test"
+		buf := bytes.NewBuffer([]byte{})
+
+		cmd := NewCmdWait(f, buf)
+		options := &WaitOptions{For: "delete", Timeout: time.Minute}
+		if err := RunWait(f, cmd, buf, []string{"pods/foo"}, options); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if buf.String() != "pod \"foo\" deleted\n" {
+			t.Errorf("%s: unexpected output: %s", name, buf.String())
+		}
+	}
+}
+
+func TestWaitTimeout(t *testing.T) {
+	f, tf, codec := NewAPIFactory()
+	reader, writer := io.Pipe()
+	defer writer.Close()
+	tf.Client = &fake.RESTClient{
+		Codec: codec,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/pods/foo" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, waitTestPod(api.ConditionFalse))}, nil
+			case p == "/watch/namespaces/test/pods/foo" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: reader}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdWait(f, buf)
+	options := &WaitOptions{For: "condition=Ready", Timeout: 10 * time.Millisecond}
+	if err := RunWait(f, cmd, buf, []string{"pods", "foo"}, options); err == nil {
+		t.Fatalf("expected a timeout error")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// ConfigMapGeneratorV1 generates a ConfigMap from files and literal values.
+type ConfigMapGeneratorV1 struct{}
+
+func (ConfigMapGeneratorV1) ParamNames() []GeneratorParam {
+	return []GeneratorParam{
+		{"name", true},
+		{"from-file", false},
+		{"from-literal", false},
+	}
+}
+
+func (ConfigMapGeneratorV1) Generate(genericParams map[string]interface{}) (runtime.Object, error) {
+	name, isString := genericParams["name"].(string)
+	if !isString {
+		return nil, fmt.Errorf("expected string, saw %v for 'name'", genericParams["name"])
+	}
+	fileSources, err := stringSliceParam(genericParams, "from-file")
+	if err != nil {
+		return nil, err
+	}
+	literalSources, err := stringSliceParam(genericParams, "from-literal")
+	if err != nil {
+		return nil, err
+	}
+
+	configMap := &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Data: map[string]string{},
+	}
+	for _, source := range fileSources {
+		if err := addFromFileSource(configMap.Data, source); err != nil {
+			return nil, err
+		}
+	}
+	for _, source := range literalSources {
+		if err := addFromLiteralSource(configMap.Data, source); err != nil {
+			return nil, err
+		}
+	}
+	return configMap, nil
+}
+
+// stringSliceParam returns the named parameter as a slice of strings. A missing
+// parameter yields an empty slice.
+func stringSliceParam(params map[string]interface{}, key string) ([]string, error) {
+	value, found := params[key]
+	if !found || value == nil {
+		return []string{}, nil
+	}
+	slice, isSlice := value.([]string)
+	if !isSlice {
+		return nil, fmt.Errorf("expected []string, saw %v for '%s'", value, key)
+	}
+	return slice, nil
+}
+
+// addFromFileSource adds the content of a file, or of every regular file in a
+// directory, to data. The source has the form [key=]path; when the key is
+// omitted the base name of each file is used.
+func addFromFileSource(data map[string]string, source string) error {
+	key, filePath := "", source
+	if parts := strings.SplitN(source, "=", 2); len(parts) == 2 {
+		key, filePath = parts[0], parts[1]
+		if len(key) == 0 || len(filePath) == 0 {
+			return fmt.Errorf("invalid file source %q, expected [key=]path", source)
+		}
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		if len(key) == 0 {
+			key = path.Base(filePath)
+		}
+		return addKeyFromFile(data, key, filePath)
+	}
+
+	if len(key) != 0 {
+		return fmt.Errorf("cannot give a key name for a directory path %q", filePath)
+	}
+	items, err := ioutil.ReadDir(filePath)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if !item.Mode().IsRegular() {
+			continue
+		}
+		if err := addKeyFromFile(data, item.Name(), path.Join(filePath, item.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addKeyFromFile(data map[string]string, key, filePath string) error {
+	content, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	return addKeyValue(data, key, string(content))
+}
+
+// addFromLiteralSource adds a key=value pair to data.
+func addFromLiteralSource(data map[string]string, source string) error {
+	parts := strings.SplitN(source, "=", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 {
+		return fmt.Errorf("invalid literal source %q, expected key=value", source)
+	}
+	return addKeyValue(data, parts[0], parts[1])
+}
+
+func addKeyValue(data map[string]string, key, value string) error {
+	if !validation.IsSecretKey(key) {
+		return fmt.Errorf("%q is not a valid key name", key)
+	}
+	if _, found := data[key]; found {
+		return fmt.Errorf("cannot add key %q, another key by that name already exists", key)
+	}
+	data[key] = value
+	return nil
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestConfigMapGenerate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "configmap-generator")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(path.Join(dir, "game.properties"), []byte("lives=3"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ioutil.WriteFile(path.Join(dir, "ui.properties"), []byte("color=red"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := os.Mkdir(path.Join(dir, "subdir"), 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		params    map[string]interface{}
+		expected  *api.ConfigMap
+		expectErr bool
+	}{
+		{
+			params: map[string]interface{}{
+				"name": "foo",
+			},
+			expected: &api.ConfigMap{
+				ObjectMeta: api.ObjectMeta{Name: "foo"},
+				Data:       map[string]string{},
+			},
+		},
+		{
+			params: map[string]interface{}{
+				"name":         "foo",
+				"from-literal": []string{"key1=value1", "key2=value=2"},
+			},
+			expected: &api.ConfigMap{
+				ObjectMeta: api.ObjectMeta{Name: "foo"},
+				Data: map[string]string{
+					"key1": "value1",
+					"key2": "value=2",
+				},
+			},
+		},
+		{
+			params: map[string]interface{}{
+				"name":      "foo",
+				"from-file": []string{dir},
+			},
+			expected: &api.ConfigMap{
+				ObjectMeta: api.ObjectMeta{Name: "foo"},
+				Data: map[string]string{
+					"game.properties": "lives=3",
+					"ui.properties":   "color=red",
+				},
+			},
+		},
+		{
+			params: map[string]interface{}{
+				"name":      "foo",
+				"from-file": []string{"game=" + path.Join(dir, "game.properties")},
+			},
+			expected: &api.ConfigMap{
+				ObjectMeta: api.ObjectMeta{Name: "foo"},
+				Data: map[string]string{
+					"game": "lives=3",
+				},
+			},
+		},
+		{
+			params: map[string]interface{}{
+				"name":      "foo",
+				"from-file": []string{"key=" + dir},
+			},
+			expectErr: true,
+		},
+		{
+			params: map[string]interface{}{
+				"name":      "foo",
+				"from-file": []string{path.Join(dir, "missing")},
+			},
+			expectErr: true,
+		},
+		{
+			params: map[string]interface{}{
+				"name":         "foo",
+				"from-literal": []string{"key1"},
+			},
+			expectErr: true,
+		},
+		{
+			params: map[string]interface{}{
+				"name":         "foo",
+				"from-literal": []string{"key1=value1", "key1=value2"},
+			},
+			expectErr: true,
+		},
+		{
+			params: map[string]interface{}{
+				"name":         "foo",
+				"from-literal": []string{"bad key=value"},
+			},
+			expectErr: true,
+		},
+		{
+			params: map[string]interface{}{
+				"name":         "foo",
+				"from-literal": "key1=value1",
+			},
+			expectErr: true,
+		},
+	}
+	generator := ConfigMapGeneratorV1{}
+	for i, test := range tests {
+		obj, err := generator.Generate(test.params)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%d: expected error, got none", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(obj.(*api.ConfigMap), test.expected) {
+			t.Errorf("%d: expected:\n%#v\ngot:\n%#v", i, test.expected, obj.(*api.ConfigMap))
+		}
+	}
+}
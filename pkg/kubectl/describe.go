@@ -71,6 +71,7 @@ func describerMap(c *client.Client) map[string]Describer {
 		"Pod": &PodDescriber{c},
 		"ReplicationController": &ReplicationControllerDescriber{c},
 		"Secret":                &SecretDescriber{c},
+		"ConfigMap":             &ConfigMapDescriber{c},
 		"Service":               &ServiceDescriber{c},
 		"ServiceAccount":        &ServiceAccountDescriber{c},
 		"Node":                  &NodeDescriber{c},
@@ -91,6 +92,7 @@ func expDescriberMap(c *client.Client) map[string]Describer {
 		"Job":                     &JobDescriber{c},
 		"Deployment":              &DeploymentDescriber{c},
 		"Ingress":                 &IngressDescriber{c},
+		"PodDisruptionBudget":     &PodDisruptionBudgetDescriber{c},
 	}
 }
 
@@ -525,6 +527,8 @@ func describeVolumes(volumes []api.Volume, out io.Writer) {
 			printGitRepoVolumeSource(volume.VolumeSource.GitRepo, out)
 		case volume.VolumeSource.Secret != nil:
 			printSecretVolumeSource(volume.VolumeSource.Secret, out)
+		case volume.VolumeSource.ConfigMap != nil:
+			printConfigMapVolumeSource(volume.VolumeSource.ConfigMap, out)
 		case volume.VolumeSource.NFS != nil:
 			printNFSVolumeSource(volume.VolumeSource.NFS, out)
 		case volume.VolumeSource.ISCSI != nil:
@@ -581,6 +585,11 @@ func printSecretVolumeSource(secret *api.SecretVolumeSource, out io.Writer) {
 		"    SecretName:\t%v\n", secret.SecretName)
 }
 
+func printConfigMapVolumeSource(configMap *api.ConfigMapVolumeSource, out io.Writer) {
+	fmt.Fprintf(out, "    Type:\tConfigMap (a volume populated by a ConfigMap)\n"+
+		"    Name:\t%v\n", configMap.Name)
+}
+
 func printNFSVolumeSource(nfs *api.NFSVolumeSource, out io.Writer) {
 	fmt.Fprintf(out, "    Type:\tNFS (an NFS mount that lasts the lifetime of a pod)\n"+
 		"    Server:\t%v\n"+
@@ -769,10 +778,15 @@ func describeContainers(pod *api.Pod, out io.Writer) {
 		fmt.Fprintf(out, "    Restart Count:\t%d\n", status.RestartCount)
 		fmt.Fprintf(out, "    Environment Variables:\n")
 		for _, e := range container.Env {
-			if e.ValueFrom != nil && e.ValueFrom.FieldRef != nil {
+			switch {
+			case e.ValueFrom != nil && e.ValueFrom.FieldRef != nil:
 				valueFrom := envValueFrom(pod, e)
 				fmt.Fprintf(out, "      %s:\t%s (%s:%s)\n", e.Name, valueFrom, e.ValueFrom.FieldRef.APIVersion, e.ValueFrom.FieldRef.FieldPath)
-			} else {
+			case e.ValueFrom != nil && e.ValueFrom.ConfigMapKeyRef != nil:
+				fmt.Fprintf(out, "      %s:\t<set to the key '%s' of config map '%s'>\n", e.Name, e.ValueFrom.ConfigMapKeyRef.Key, e.ValueFrom.ConfigMapKeyRef.Name)
+			case e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil:
+				fmt.Fprintf(out, "      %s:\t<set to the key '%s' in secret '%s'>\n", e.Name, e.ValueFrom.SecretKeyRef.Key, e.ValueFrom.SecretKeyRef.Name)
+			default:
 				fmt.Fprintf(out, "      %s:\t%s\n", e.Name, e.Value)
 			}
 		}
@@ -913,6 +927,40 @@ func describeJob(job *extensions.Job, events *api.EventList) (string, error) {
 	})
 }
 
+// PodDisruptionBudgetDescriber generates information about a pod disruption budget.
+type PodDisruptionBudgetDescriber struct {
+	client.Interface
+}
+
+func (d *PodDisruptionBudgetDescriber) Describe(namespace, name string) (string, error) {
+	pdb, err := d.Extensions().PodDisruptionBudgets(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+	events, _ := d.Events(namespace).Search(pdb)
+	return describePodDisruptionBudget(pdb, events)
+}
+
+func describePodDisruptionBudget(pdb *extensions.PodDisruptionBudget, events *api.EventList) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", pdb.Name)
+		fmt.Fprintf(out, "Namespace:\t%s\n", pdb.Namespace)
+		fmt.Fprintf(out, "Min available:\t%s\n", pdb.Spec.MinAvailable.String())
+		selector, _ := extensions.PodSelectorAsSelector(pdb.Spec.Selector)
+		fmt.Fprintf(out, "Selector:\t%s\n", selector)
+		fmt.Fprintf(out, "Labels:\t%s\n", labels.FormatLabels(pdb.Labels))
+		fmt.Fprintf(out, "Status:\n")
+		fmt.Fprintf(out, "    Allowed disruptions:\t%d\n", pdb.Status.PodDisruptionsAllowed)
+		fmt.Fprintf(out, "    Current:\t%d\n", pdb.Status.CurrentHealthy)
+		fmt.Fprintf(out, "    Desired:\t%d\n", pdb.Status.DesiredHealthy)
+		fmt.Fprintf(out, "    Total:\t%d\n", pdb.Status.ExpectedPods)
+		if events != nil {
+			DescribeEvents(events, out)
+		}
+		return nil
+	})
+}
+
 // DaemonSetDescriber generates information about a daemon set and the pods it has created.
 type DaemonSetDescriber struct {
 	client.Interface
@@ -1007,6 +1055,38 @@ func describeSecret(secret *api.Secret) (string, error) {
 	})
 }
 
+// ConfigMapDescriber generates information about a ConfigMap
+type ConfigMapDescriber struct {
+	client.Interface
+}
+
+func (d *ConfigMapDescriber) Describe(namespace, name string) (string, error) {
+	c := d.ConfigMaps(namespace)
+
+	configMap, err := c.Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return describeConfigMap(configMap)
+}
+
+func describeConfigMap(configMap *api.ConfigMap) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", configMap.Name)
+		fmt.Fprintf(out, "Namespace:\t%s\n", configMap.Namespace)
+		fmt.Fprintf(out, "Labels:\t%s\n", labels.FormatLabels(configMap.Labels))
+		fmt.Fprintf(out, "Annotations:\t%s\n", labels.FormatLabels(configMap.Annotations))
+
+		fmt.Fprintf(out, "\nData\n====\n")
+		for k, v := range configMap.Data {
+			fmt.Fprintf(out, "%s:\t%d bytes\n", k, len(v))
+		}
+
+		return nil
+	})
+}
+
 type IngressDescriber struct {
 	client.Interface
 }
@@ -1549,6 +1629,13 @@ func (dd *DeploymentDescriber) Describe(namespace, name string) (string, error)
 			ru := d.Spec.Strategy.RollingUpdate
 			fmt.Fprintf(out, "RollingUpdateStrategy:\t%s max unavailable, %s max surge, %d min ready seconds\n", ru.MaxUnavailable.String(), ru.MaxSurge.String(), ru.MinReadySeconds)
 		}
+		if len(d.Status.Conditions) > 0 {
+			fmt.Fprint(out, "Conditions:\n  Type\tStatus\tReason\n")
+			fmt.Fprint(out, "  ----\t------\t------\n")
+			for _, c := range d.Status.Conditions {
+				fmt.Fprintf(out, "  %v \t%v\t%v\n", c.Type, c.Status, c.Reason)
+			}
+		}
 		oldRCs, err := deploymentutil.GetOldRCs(*d, dd)
 		if err == nil {
 			fmt.Fprintf(out, "OldReplicationControllers:\t%s\n", printReplicationControllersByLabels(oldRCs))
@@ -30,6 +30,7 @@ import (
 	"k8s.io/kubernetes/pkg/apis/extensions"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/util/intstr"
 )
 
 type describeClient struct {
@@ -519,3 +520,26 @@ func TestDescribeDeployment(t *testing.T) {
 		t.Errorf("unexpected out: %s", out)
 	}
 }
+
+func TestDescribePodDisruptionBudget(t *testing.T) {
+	fake := testclient.NewSimpleFake(&extensions.PodDisruptionBudget{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "bar",
+			Namespace: "foo",
+		},
+		Spec: extensions.PodDisruptionBudgetSpec{
+			MinAvailable: intstr.FromString("50%"),
+			Selector:     &extensions.PodSelector{MatchLabels: map[string]string{"app": "quorum"}},
+		},
+		Status: extensions.PodDisruptionBudgetStatus{PodDisruptionsAllowed: 2},
+	})
+	c := &describeClient{T: t, Namespace: "foo", Interface: fake}
+	d := PodDisruptionBudgetDescriber{c}
+	out, err := d.Describe("foo", "bar")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "50%") || !strings.Contains(out, "quorum") || !strings.Contains(out, "Allowed disruptions:") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
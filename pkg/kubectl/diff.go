@@ -0,0 +1,220 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// diffContextLines is the number of unchanged lines printed around each change.
+const diffContextLines = 3
+
+// diffLine is a single line of an edit script. Kind is ' ' for a line present
+// in both inputs, '-' for a line only in the first and '+' for a line only in
+// the second.
+type diffLine struct {
+	kind byte
+	text string
+}
+
+// UnifiedDiff writes the differences between from and to to w in unified diff
+// format, labeling the inputs with fromName and toName. It returns true if the
+// inputs differ. Nothing is written when they are identical.
+func UnifiedDiff(w io.Writer, from, to []byte, fromName, toName string) (bool, error) {
+	lines := diffLines(splitLines(string(from)), splitLines(string(to)))
+	changed := false
+	for _, l := range lines {
+		if l.kind != ' ' {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+
+	if _, err := fmt.Fprintf(w, "--- %s\n+++ %s\n", fromName, toName); err != nil {
+		return true, err
+	}
+
+	// fromLine[i] and toLine[i] hold the number of lines of each input that
+	// precede lines[i].
+	fromLine := make([]int, len(lines)+1)
+	toLine := make([]int, len(lines)+1)
+	for i, l := range lines {
+		fromLine[i+1], toLine[i+1] = fromLine[i], toLine[i]
+		if l.kind != '+' {
+			fromLine[i+1]++
+		}
+		if l.kind != '-' {
+			toLine[i+1]++
+		}
+	}
+
+	for i := 0; i < len(lines); {
+		for i < len(lines) && lines[i].kind == ' ' {
+			i++
+		}
+		if i == len(lines) {
+			break
+		}
+		start := i - diffContextLines
+		if start < 0 {
+			start = 0
+		}
+		// Extend the hunk until the gap to the next change is too wide to be
+		// covered by the context of both changes.
+		last := i
+		for j := i; j < len(lines); j++ {
+			if lines[j].kind != ' ' {
+				last = j
+			} else if j-last > 2*diffContextLines {
+				break
+			}
+		}
+		end := last + diffContextLines + 1
+		if end > len(lines) {
+			end = len(lines)
+		}
+
+		header := fmt.Sprintf("@@ -%s +%s @@\n",
+			hunkRange(fromLine[start], fromLine[end]-fromLine[start]),
+			hunkRange(toLine[start], toLine[end]-toLine[start]))
+		if _, err := io.WriteString(w, header); err != nil {
+			return true, err
+		}
+		for _, l := range lines[start:end] {
+			if _, err := fmt.Fprintf(w, "%c%s\n", l.kind, l.text); err != nil {
+				return true, err
+			}
+		}
+		i = end
+	}
+	return true, nil
+}
+
+// hunkRange formats the start and length of a hunk given the number of lines
+// preceding it. An empty range refers to the line before the hunk.
+func hunkRange(before, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", before)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", before+1)
+	}
+	return fmt.Sprintf("%d,%d", before+1, count)
+}
+
+func splitLines(s string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines computes an edit script turning a into b from the longest common
+// subsequence of their lines. Lines shared at the start and end of the inputs
+// are matched directly, and the rest is diffed in linear space so that large
+// inputs with few changes stay cheap.
+func diffLines(a, b []string) []diffLine {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]diffLine, 0, len(a)+len(b)-prefix-suffix)
+	lines = appendLines(lines, ' ', a[:prefix])
+	lines = appendEdits(lines, a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])
+	return appendLines(lines, ' ', a[len(a)-suffix:])
+}
+
+// appendEdits appends an edit script turning a into b to lines. It uses
+// Hirschberg's algorithm: a is split in half and b is split where the longest
+// common subsequences of the two halves add up to the longest overall, which
+// needs only a single row of lengths for each half instead of a full table.
+func appendEdits(lines []diffLine, a, b []string) []diffLine {
+	switch {
+	case len(a) == 0:
+		return appendLines(lines, '+', b)
+	case len(b) == 0:
+		return appendLines(lines, '-', a)
+	case len(a) == 1:
+		for j := range b {
+			if b[j] == a[0] {
+				lines = appendLines(lines, '+', b[:j])
+				lines = appendLines(lines, ' ', a)
+				return appendLines(lines, '+', b[j+1:])
+			}
+		}
+		lines = appendLines(lines, '-', a)
+		return appendLines(lines, '+', b)
+	}
+
+	mid := len(a) / 2
+	forward := lcsLengths(a[:mid], b, false)
+	backward := lcsLengths(a[mid:], b, true)
+	split, best := 0, -1
+	for j := 0; j <= len(b); j++ {
+		if n := forward[j] + backward[len(b)-j]; n > best {
+			split, best = j, n
+		}
+	}
+	lines = appendEdits(lines, a[:mid], b[:split])
+	return appendEdits(lines, a[mid:], b[split:])
+}
+
+// lcsLengths returns the lengths of the longest common subsequences of a and
+// every prefix of b, indexed by the length of the prefix. If reverse is true
+// both inputs are read back to front, so the result is indexed by the length
+// of the suffix of b instead.
+func lcsLengths(a, b []string, reverse bool) []int {
+	at := func(s []string, i int) string {
+		if reverse {
+			return s[len(s)-1-i]
+		}
+		return s[i]
+	}
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for i := range a {
+		for j := range b {
+			switch {
+			case at(a, i) == at(b, j):
+				cur[j+1] = prev[j] + 1
+			case prev[j+1] >= cur[j]:
+				cur[j+1] = prev[j+1]
+			default:
+				cur[j+1] = cur[j]
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev
+}
+
+func appendLines(lines []diffLine, kind byte, text []string) []diffLine {
+	for _, t := range text {
+		lines = append(lines, diffLine{kind, t})
+	}
+	return lines
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		changed  bool
+		expected string
+	}{
+		{
+			name: "identical",
+			from: "a\nb\nc\n",
+			to:   "a\nb\nc\n",
+		},
+		{
+			name:    "changed line",
+			from:    "a\nb\nc\n",
+			to:      "a\nB\nc\n",
+			changed: true,
+			expected: `--- from
++++ to
+@@ -1,3 +1,3 @@
+ a
+-b
++B
+ c
+`,
+		},
+		{
+			name:    "created",
+			from:    "",
+			to:      "a\nb\n",
+			changed: true,
+			expected: `--- from
++++ to
+@@ -0,0 +1,2 @@
++a
++b
+`,
+		},
+		{
+			name:    "separate hunks",
+			from:    "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			to:      "0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n",
+			changed: true,
+			expected: `--- from
++++ to
+@@ -1,3 +1,4 @@
++0
+ 1
+ 2
+ 3
+@@ -9,4 +10,3 @@
+ 9
+ 10
+ 11
+-12
+`,
+		},
+	}
+
+	for _, test := range tests {
+		buf := &bytes.Buffer{}
+		changed, err := UnifiedDiff(buf, []byte(test.from), []byte(test.to), "from", "to")
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if changed != test.changed {
+			t.Errorf("%s: expected changed=%t, got %t", test.name, test.changed, changed)
+		}
+		if buf.String() != test.expected {
+			t.Errorf("%s: expected:\n%s\ngot:\n%s", test.name, test.expected, buf.String())
+		}
+	}
+}
+
+func TestUnifiedDiffLargeInput(t *testing.T) {
+	const n = 5000
+	from := make([]string, n)
+	to := make([]string, n)
+	for i := range from {
+		from[i] = fmt.Sprintf("line %d", i)
+		to[i] = from[i]
+	}
+	// Change the first, a middle and the last line so that the common prefix
+	// and suffix do not cover the whole input.
+	changed := []int{0, n / 2, n - 1}
+	for _, i := range changed {
+		to[i] = fmt.Sprintf("changed %d", i)
+	}
+
+	expected := &bytes.Buffer{}
+	fmt.Fprintf(expected, "--- from\n+++ to\n")
+	fmt.Fprintf(expected, "@@ -1,4 +1,4 @@\n-line 0\n+changed 0\n line 1\n line 2\n line 3\n")
+	fmt.Fprintf(expected, "@@ -%d,7 +%d,7 @@\n", n/2-2, n/2-2)
+	for i := n/2 - 3; i < n/2; i++ {
+		fmt.Fprintf(expected, " line %d\n", i)
+	}
+	fmt.Fprintf(expected, "-line %d\n+changed %d\n", n/2, n/2)
+	for i := n/2 + 1; i <= n/2+3; i++ {
+		fmt.Fprintf(expected, " line %d\n", i)
+	}
+	fmt.Fprintf(expected, "@@ -%d,4 +%d,4 @@\n", n-3, n-3)
+	for i := n - 4; i < n-1; i++ {
+		fmt.Fprintf(expected, " line %d\n", i)
+	}
+	fmt.Fprintf(expected, "-line %d\n+changed %d\n", n-1, n-1)
+
+	fromData := []byte(strings.Join(from, "\n") + "\n")
+	toData := []byte(strings.Join(to, "\n") + "\n")
+	buf := &bytes.Buffer{}
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	if _, err := UnifiedDiff(buf, fromData, toData, "from", "to"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	runtime.ReadMemStats(&after)
+
+	if buf.String() != expected.String() {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected.String(), buf.String())
+	}
+	// A full table of common subsequence lengths for these inputs would take
+	// about 200MB.
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 16<<20 {
+		t.Errorf("expected diffing %d lines to allocate less than 16MB, allocated %d bytes", n, allocated)
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/labels"
+	deploymentutil "k8s.io/kubernetes/pkg/util/deployment"
+)
+
+// HistoryViewer provides an interface for resources that can be rolled back.
+type HistoryViewer interface {
+	// ViewHistory returns the rollout history of the named resource. If
+	// revision is non-zero, only the details of that revision are returned.
+	ViewHistory(namespace, name string, revision int64) (string, error)
+}
+
+func HistoryViewerFor(kind string, c client.Interface) (HistoryViewer, error) {
+	switch kind {
+	case "Deployment":
+		return &DeploymentHistoryViewer{c}, nil
+	}
+	return nil, fmt.Errorf("no history viewer has been implemented for %q", kind)
+}
+
+type DeploymentHistoryViewer struct {
+	c client.Interface
+}
+
+// ViewHistory returns the revisions of the given deployment, together with
+// the replication controller and images of each revision.
+func (h *DeploymentHistoryViewer) ViewHistory(namespace, name string, revision int64) (string, error) {
+	deployment, err := h.c.Extensions().Deployments(namespace).Get(name)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve deployment %s: %v", name, err)
+	}
+	allRCs, err := deploymentutil.GetAllOldRCs(*deployment, h.c)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve replication controllers of deployment %s: %v", name, err)
+	}
+	newRC, err := deploymentutil.GetNewRC(*deployment, h.c)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve new replication controller of deployment %s: %v", name, err)
+	}
+	if newRC != nil {
+		allRCs = append(allRCs, newRC)
+	}
+
+	historyInfo := map[int64]*api.ReplicationController{}
+	for _, rc := range allRCs {
+		v, err := deploymentutil.Revision(rc)
+		if err != nil || v == 0 {
+			continue
+		}
+		historyInfo[v] = rc
+	}
+
+	if revision > 0 {
+		rc, ok := historyInfo[revision]
+		if !ok {
+			return "", fmt.Errorf("unable to find the specified revision %d", revision)
+		}
+		return tabbedString(func(out io.Writer) error {
+			printRevisionDetails(deployment.Name, revision, rc, deployment.Spec.UniqueLabelKey, out)
+			return nil
+		})
+	}
+
+	// Sort the revisions.
+	revisions := make([]int64, 0, len(historyInfo))
+	for r := range historyInfo {
+		revisions = append(revisions, r)
+	}
+	sort.Sort(revisionList(revisions))
+
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "%s %q:\n", "deployments", name)
+		fmt.Fprintf(out, "REVISION\tREPLICATION CONTROLLER\tIMAGE(S)\n")
+		for _, r := range revisions {
+			rc := historyInfo[r]
+			images := ""
+			if rc.Spec.Template != nil {
+				images = makeImageList(&rc.Spec.Template.Spec)
+			}
+			fmt.Fprintf(out, "%s\t%s\t%s\n", strconv.FormatInt(r, 10), rc.Name, images)
+		}
+		return nil
+	})
+}
+
+// printRevisionDetails prints the pod template of a single revision of a deployment.
+func printRevisionDetails(name string, revision int64, rc *api.ReplicationController, uniqueLabelKey string, out io.Writer) {
+	fmt.Fprintf(out, "%s %q revision %d\n", "deployments", name, revision)
+	fmt.Fprintf(out, "ReplicationController:\t%s\n", rc.Name)
+	if rc.Spec.Template == nil {
+		return
+	}
+	fmt.Fprintf(out, "Labels:\t%s\n", labels.FormatLabels(deploymentutil.CloneAndRemoveLabel(rc.Spec.Template.Labels, uniqueLabelKey)))
+	fmt.Fprintf(out, "Containers:\n")
+	for _, container := range rc.Spec.Template.Spec.Containers {
+		fmt.Fprintf(out, "  %s:\n", container.Name)
+		fmt.Fprintf(out, "    Image:\t%s\n", container.Image)
+		if len(container.Command) > 0 {
+			fmt.Fprintf(out, "    Command:\t%v\n", container.Command)
+		}
+		if len(container.Args) > 0 {
+			fmt.Fprintf(out, "    Args:\t%v\n", container.Args)
+		}
+	}
+}
+
+type revisionList []int64
+
+func (r revisionList) Len() int           { return len(r) }
+func (r revisionList) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r revisionList) Less(i, j int) bool { return r[i] < r[j] }
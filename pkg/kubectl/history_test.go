@@ -0,0 +1,89 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	deploymentutil "k8s.io/kubernetes/pkg/util/deployment"
+)
+
+func TestDeploymentHistoryViewer(t *testing.T) {
+	deployment := &extensions.Deployment{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
+		Spec: extensions.DeploymentSpec{
+			Selector:       map[string]string{"name": "foo"},
+			UniqueLabelKey: "hash",
+		},
+	}
+	rcList := &api.ReplicationControllerList{}
+	for i, image := range []string{"nginx:1.7", "nginx:1.8", "nginx:1.9"} {
+		deployment.Spec.Template = api.PodTemplateSpec{
+			ObjectMeta: api.ObjectMeta{Labels: map[string]string{"name": "foo"}},
+			Spec: api.PodSpec{
+				Containers: []api.Container{{Name: "nginx", Image: image}},
+			},
+		}
+		template := deploymentutil.GetNewRCTemplate(*deployment)
+		rcList.Items = append(rcList.Items, api.ReplicationController{
+			ObjectMeta: api.ObjectMeta{
+				Name:        fmt.Sprintf("foo-%d", i+1),
+				Namespace:   api.NamespaceDefault,
+				Annotations: map[string]string{deploymentutil.RevisionAnnotation: fmt.Sprintf("%d", i+1)},
+			},
+			Spec: api.ReplicationControllerSpec{Template: &template},
+		})
+	}
+	fake := testclient.NewSimpleFake(deployment, rcList)
+	viewer, err := HistoryViewerFor("Deployment", fake)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	history, err := viewer.ViewHistory(api.NamespaceDefault, "foo", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, expected := range []string{"1", "foo-1", "nginx:1.7", "2", "foo-2", "nginx:1.8", "3", "foo-3", "nginx:1.9"} {
+		if !strings.Contains(history, expected) {
+			t.Errorf("expected history to contain %q, got:\n%s", expected, history)
+		}
+	}
+	if strings.Index(history, "foo-1") > strings.Index(history, "foo-3") {
+		t.Errorf("expected revisions to be sorted, got:\n%s", history)
+	}
+
+	details, err := viewer.ViewHistory(api.NamespaceDefault, "foo", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(details, "nginx:1.8") || strings.Contains(details, "nginx:1.9") {
+		t.Errorf("unexpected details of revision 2:\n%s", details)
+	}
+	if strings.Contains(details, "hash=") {
+		t.Errorf("expected the unique label to be hidden, got:\n%s", details)
+	}
+
+	if _, err := viewer.ViewHistory(api.NamespaceDefault, "foo", 5); err == nil {
+		t.Errorf("expected an error for an unknown revision")
+	}
+}
@@ -102,6 +102,7 @@ func expandResourceShortcut(resource string) string {
 		"ds":     "daemonsets",
 		"svc":    "services",
 		"ing":    "ingresses",
+		"pdb":    "poddisruptionbudgets",
 	}
 	if expanded, ok := shortForms[resource]; ok {
 		return expanded
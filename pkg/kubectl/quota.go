@@ -0,0 +1,82 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// ResourceQuotaGeneratorV1 generates a resource quota from a comma separated
+// list of resource=quantity pairs.
+type ResourceQuotaGeneratorV1 struct{}
+
+func (ResourceQuotaGeneratorV1) ParamNames() []GeneratorParam {
+	return []GeneratorParam{
+		{"name", true},
+		{"hard", true},
+	}
+}
+
+func (ResourceQuotaGeneratorV1) Generate(genericParams map[string]interface{}) (runtime.Object, error) {
+	params, err := stringParams(genericParams)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range []string{"name", "hard"} {
+		if len(params[key]) == 0 {
+			return nil, fmt.Errorf("'%s' is a required parameter.", key)
+		}
+	}
+	hard, err := parseResourceList(params["hard"])
+	if err != nil {
+		return nil, err
+	}
+	return &api.ResourceQuota{
+		ObjectMeta: api.ObjectMeta{
+			Name: params["name"],
+		},
+		Spec: api.ResourceQuotaSpec{
+			Hard: hard,
+		},
+	}, nil
+}
+
+// parseResourceList parses a list of the form "cpu=1,memory=1Gi,pods=10".
+func parseResourceList(spec string) (api.ResourceList, error) {
+	list := api.ResourceList{}
+	for _, pair := range strings.Split(spec, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 {
+			return nil, fmt.Errorf("invalid resource %q, expected resource=quantity", pair)
+		}
+		name := api.ResourceName(parts[0])
+		if _, found := list[name]; found {
+			return nil, fmt.Errorf("resource %q is specified more than once", parts[0])
+		}
+		quantity, err := resource.ParseQuantity(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity for resource %q: %v", parts[0], err)
+		}
+		list[name] = *quantity
+	}
+	return list, nil
+}
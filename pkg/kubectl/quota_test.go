@@ -0,0 +1,83 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+)
+
+func TestResourceQuotaGenerate(t *testing.T) {
+	tests := []struct {
+		params    map[string]interface{}
+		expected  *api.ResourceQuota
+		expectErr bool
+	}{
+		{
+			params: map[string]interface{}{
+				"name": "foo",
+				"hard": "cpu=1,memory=1Gi,pods=10",
+			},
+			expected: &api.ResourceQuota{
+				ObjectMeta: api.ObjectMeta{Name: "foo"},
+				Spec: api.ResourceQuotaSpec{
+					Hard: api.ResourceList{
+						api.ResourceCPU:    resource.MustParse("1"),
+						api.ResourceMemory: resource.MustParse("1Gi"),
+						api.ResourcePods:   resource.MustParse("10"),
+					},
+				},
+			},
+		},
+		{
+			params:    map[string]interface{}{"name": "foo"},
+			expectErr: true,
+		},
+		{
+			params:    map[string]interface{}{"name": "foo", "hard": "cpu"},
+			expectErr: true,
+		},
+		{
+			params:    map[string]interface{}{"name": "foo", "hard": "cpu=one"},
+			expectErr: true,
+		},
+		{
+			params:    map[string]interface{}{"name": "foo", "hard": "pods=1,pods=2"},
+			expectErr: true,
+		},
+	}
+	generator := ResourceQuotaGeneratorV1{}
+	for i, test := range tests {
+		obj, err := generator.Generate(test.params)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%d: expected error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(obj, test.expected) {
+			t.Errorf("%d: expected:\n%#v\nsaw:\n%#v", i, test.expected, obj)
+		}
+	}
+}
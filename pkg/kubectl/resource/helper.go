@@ -19,6 +19,7 @@ package resource
 import (
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/meta"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/watch"
@@ -38,6 +39,9 @@ type Helper struct {
 	Versioner runtime.ResourceVersioner
 	// True if the resource type is scoped to namespaces
 	NamespaceScoped bool
+	// If true, create, patch and replace requests ask the server to run
+	// admission and validation without persisting the result.
+	ServerDryRun bool
 }
 
 // NewHelper creates a Helper from a ResourceMapping
@@ -118,16 +122,16 @@ func (m *Helper) Create(namespace string, modify bool, obj runtime.Object) (runt
 }
 
 func (m *Helper) createResource(c RESTClient, resource, namespace string, obj runtime.Object) (runtime.Object, error) {
-	return c.Post().NamespaceIfScoped(namespace, m.NamespaceScoped).Resource(resource).Body(obj).Do().Get()
+	req := c.Post().NamespaceIfScoped(namespace, m.NamespaceScoped).Resource(resource).Body(obj)
+	return m.dryRunParam(req).Do().Get()
 }
 func (m *Helper) Patch(namespace, name string, pt api.PatchType, data []byte) (runtime.Object, error) {
-	return m.RESTClient.Patch(pt).
+	req := m.RESTClient.Patch(pt).
 		NamespaceIfScoped(namespace, m.NamespaceScoped).
 		Resource(m.Resource).
 		Name(name).
-		Body(data).
-		Do().
-		Get()
+		Body(data)
+	return m.dryRunParam(req).Do().Get()
 }
 
 func (m *Helper) Replace(namespace, name string, overwrite bool, obj runtime.Object) (runtime.Object, error) {
@@ -159,5 +163,15 @@ func (m *Helper) Replace(namespace, name string, overwrite bool, obj runtime.Obj
 }
 
 func (m *Helper) replaceResource(c RESTClient, resource, namespace, name string, obj runtime.Object) (runtime.Object, error) {
-	return c.Put().NamespaceIfScoped(namespace, m.NamespaceScoped).Resource(resource).Name(name).Body(obj).Do().Get()
+	req := c.Put().NamespaceIfScoped(namespace, m.NamespaceScoped).Resource(resource).Name(name).Body(obj)
+	return m.dryRunParam(req).Do().Get()
+}
+
+// dryRunParam adds the dryRun query parameter to req when the helper is
+// in server dry run mode.
+func (m *Helper) dryRunParam(req *client.Request) *client.Request {
+	if m.ServerDryRun {
+		return req.Param("dryRun", "true")
+	}
+	return req
 }
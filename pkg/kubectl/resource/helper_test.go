@@ -469,3 +469,43 @@ func TestHelperReplace(t *testing.T) {
 		}
 	}
 }
+
+func TestHelperServerDryRun(t *testing.T) {
+	for _, dryRun := range []bool{false, true} {
+		client := &fake.RESTClient{
+			Codec: testapi.Default.Codec(),
+			Resp:  &http.Response{StatusCode: http.StatusOK, Body: objBody(&api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}})},
+		}
+		helper := &Helper{
+			RESTClient:      client,
+			Codec:           testapi.Default.Codec(),
+			Versioner:       testapi.Default.MetadataAccessor(),
+			NamespaceScoped: true,
+			ServerDryRun:    dryRun,
+		}
+		requests := map[string]func() error{
+			"create": func() error {
+				_, err := helper.Create("bar", false, &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}})
+				return err
+			},
+			"patch": func() error {
+				_, err := helper.Patch("bar", "foo", api.StrategicMergePatchType, []byte("{}"))
+				return err
+			},
+			"replace": func() error {
+				_, err := helper.Replace("bar", "foo", false, &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "10"}})
+				return err
+			},
+		}
+		for name, fn := range requests {
+			client.Resp.Body = objBody(&api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}})
+			if err := fn(); err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if got := client.Req.URL.Query().Get("dryRun") == "true"; got != dryRun {
+				t.Errorf("%s: expected dryRun parameter %t, got %t (%s)", name, dryRun, got, client.Req.URL)
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+// Rollbacker provides an interface for resources that can be rolled back.
+type Rollbacker interface {
+	// Rollback asks the server to roll the named resource back to the given
+	// revision. A revision of 0 rolls back to the previous revision.
+	Rollback(namespace, name string, updatedAnnotations map[string]string, toRevision int64) error
+}
+
+func RollbackerFor(kind string, c client.Interface) (Rollbacker, error) {
+	switch kind {
+	case "Deployment":
+		return &DeploymentRollbacker{c}, nil
+	}
+	return nil, fmt.Errorf("no rollbacker has been implemented for %q", kind)
+}
+
+type DeploymentRollbacker struct {
+	c client.Interface
+}
+
+func (r *DeploymentRollbacker) Rollback(namespace, name string, updatedAnnotations map[string]string, toRevision int64) error {
+	deploymentRollback := &extensions.DeploymentRollback{
+		Name:               name,
+		UpdatedAnnotations: updatedAnnotations,
+		RollbackTo: extensions.RollbackConfig{
+			Revision: toRevision,
+		},
+	}
+	return r.c.Extensions().Deployments(namespace).Rollback(deploymentRollback)
+}
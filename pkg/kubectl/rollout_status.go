@@ -0,0 +1,69 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	deploymentutil "k8s.io/kubernetes/pkg/util/deployment"
+)
+
+// StatusViewer provides an interface for resources that have rollout status.
+type StatusViewer interface {
+	// Status returns a message describing the rollout of the named resource, and
+	// whether the rollout is done. It returns an error if the rollout failed.
+	Status(namespace, name string) (string, bool, error)
+}
+
+func StatusViewerFor(kind string, c client.Interface) (StatusViewer, error) {
+	switch kind {
+	case "Deployment":
+		return &DeploymentStatusViewer{c.Extensions()}, nil
+	}
+	return nil, fmt.Errorf("no status viewer has been implemented for %q", kind)
+}
+
+type DeploymentStatusViewer struct {
+	c client.ExtensionsInterface
+}
+
+// Status returns a message describing the rollout status of the given
+// deployment, and whether the rollout is done.
+func (s *DeploymentStatusViewer) Status(namespace, name string) (string, bool, error) {
+	deployment, err := s.c.Deployments(namespace).Get(name)
+	if err != nil {
+		return "", false, err
+	}
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return "Waiting for deployment spec update to be observed...\n", false, nil
+	}
+	if cond := deploymentutil.GetDeploymentCondition(deployment.Status, extensions.DeploymentProgressing); cond != nil && cond.Reason == deploymentutil.TimedOutReason {
+		return "", false, fmt.Errorf("deployment %q exceeded its progress deadline", name)
+	}
+	if deployment.Status.UpdatedReplicas < deployment.Spec.Replicas {
+		return fmt.Sprintf("Waiting for rollout to finish: %d out of %d new replicas have been updated...\n", deployment.Status.UpdatedReplicas, deployment.Spec.Replicas), false, nil
+	}
+	if deployment.Status.Replicas > deployment.Status.UpdatedReplicas {
+		return fmt.Sprintf("Waiting for rollout to finish: %d old replicas are pending termination...\n", deployment.Status.Replicas-deployment.Status.UpdatedReplicas), false, nil
+	}
+	if deployment.Status.AvailableReplicas < deployment.Status.UpdatedReplicas {
+		return fmt.Sprintf("Waiting for rollout to finish: %d of %d updated replicas are available...\n", deployment.Status.AvailableReplicas, deployment.Status.UpdatedReplicas), false, nil
+	}
+	return fmt.Sprintf("deployment %q successfully rolled out\n", name), true, nil
+}
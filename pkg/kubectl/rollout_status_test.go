@@ -0,0 +1,116 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	deploymentutil "k8s.io/kubernetes/pkg/util/deployment"
+)
+
+func TestDeploymentStatusViewerStatus(t *testing.T) {
+	tests := []struct {
+		generation int64
+		replicas   int
+		status     extensions.DeploymentStatus
+
+		msg  string
+		done bool
+		err  bool
+	}{
+		{
+			generation: 2,
+			replicas:   3,
+			status:     extensions.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			msg:        "Waiting for deployment spec update to be observed...\n",
+		},
+		{
+			generation: 1,
+			replicas:   3,
+			status:     extensions.DeploymentStatus{ObservedGeneration: 1, Replicas: 4, UpdatedReplicas: 1, AvailableReplicas: 3},
+			msg:        "Waiting for rollout to finish: 1 out of 3 new replicas have been updated...\n",
+		},
+		{
+			generation: 1,
+			replicas:   3,
+			status:     extensions.DeploymentStatus{ObservedGeneration: 1, Replicas: 4, UpdatedReplicas: 3, AvailableReplicas: 3},
+			msg:        "Waiting for rollout to finish: 1 old replicas are pending termination...\n",
+		},
+		{
+			generation: 1,
+			replicas:   3,
+			status:     extensions.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 2},
+			msg:        "Waiting for rollout to finish: 2 of 3 updated replicas are available...\n",
+		},
+		{
+			generation: 1,
+			replicas:   3,
+			status:     extensions.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			msg:        "deployment \"foo\" successfully rolled out\n",
+			done:       true,
+		},
+		{
+			generation: 1,
+			replicas:   3,
+			status: extensions.DeploymentStatus{
+				ObservedGeneration: 1,
+				Replicas:           3,
+				UpdatedReplicas:    1,
+				Conditions: []extensions.DeploymentCondition{
+					{
+						Type:   extensions.DeploymentProgressing,
+						Status: api.ConditionFalse,
+						Reason: deploymentutil.TimedOutReason,
+					},
+				},
+			},
+			err: true,
+		},
+	}
+
+	for i, test := range tests {
+		d := &extensions.Deployment{
+			ObjectMeta: api.ObjectMeta{
+				Namespace:  "bar",
+				Name:       "foo",
+				Generation: test.generation,
+			},
+			Spec: extensions.DeploymentSpec{
+				Replicas: test.replicas,
+			},
+			Status: test.status,
+		}
+		viewer := &DeploymentStatusViewer{testclient.NewSimpleFake(d).Extensions()}
+		msg, done, err := viewer.Status("bar", "foo")
+		if test.err {
+			if err == nil {
+				t.Errorf("%d: expected an error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if msg != test.msg || done != test.done {
+			t.Errorf("%d: expected (%q, %v), got (%q, %v)", i, test.msg, test.done, msg, done)
+		}
+	}
+}
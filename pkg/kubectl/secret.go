@@ -0,0 +1,187 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/credentialprovider"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// SecretGeneratorV1 generates a generic secret from files and literal values.
+type SecretGeneratorV1 struct{}
+
+func (SecretGeneratorV1) ParamNames() []GeneratorParam {
+	return []GeneratorParam{
+		{"name", true},
+		{"type", false},
+		{"from-file", false},
+		{"from-literal", false},
+	}
+}
+
+func (SecretGeneratorV1) Generate(genericParams map[string]interface{}) (runtime.Object, error) {
+	name, isString := genericParams["name"].(string)
+	if !isString {
+		return nil, fmt.Errorf("expected string, saw %v for 'name'", genericParams["name"])
+	}
+	secretType := api.SecretTypeOpaque
+	if value, found := genericParams["type"]; found && value != nil {
+		typeString, isString := value.(string)
+		if !isString {
+			return nil, fmt.Errorf("expected string, saw %v for 'type'", value)
+		}
+		if len(typeString) != 0 {
+			secretType = api.SecretType(typeString)
+		}
+	}
+	fileSources, err := stringSliceParam(genericParams, "from-file")
+	if err != nil {
+		return nil, err
+	}
+	literalSources, err := stringSliceParam(genericParams, "from-literal")
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]string{}
+	for _, source := range fileSources {
+		if err := addFromFileSource(data, source); err != nil {
+			return nil, err
+		}
+	}
+	for _, source := range literalSources {
+		if err := addFromLiteralSource(data, source); err != nil {
+			return nil, err
+		}
+	}
+	secret := newSecret(name, secretType)
+	for key, value := range data {
+		secret.Data[key] = []byte(value)
+	}
+	return secret, nil
+}
+
+// SecretForDockerRegistryGeneratorV1 generates a secret holding the
+// credentials of a docker registry, for use as an image pull secret.
+type SecretForDockerRegistryGeneratorV1 struct{}
+
+func (SecretForDockerRegistryGeneratorV1) ParamNames() []GeneratorParam {
+	return []GeneratorParam{
+		{"name", true},
+		{"docker-server", true},
+		{"docker-username", true},
+		{"docker-password", true},
+		{"docker-email", true},
+	}
+}
+
+func (SecretForDockerRegistryGeneratorV1) Generate(genericParams map[string]interface{}) (runtime.Object, error) {
+	params, err := stringParams(genericParams)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range []string{"name", "docker-server", "docker-username", "docker-password", "docker-email"} {
+		if len(params[key]) == 0 {
+			return nil, fmt.Errorf("'%s' is a required parameter.", key)
+		}
+	}
+
+	dockercfg := credentialprovider.DockerConfig{
+		params["docker-server"]: credentialprovider.DockerConfigEntry{
+			Username: params["docker-username"],
+			Password: params["docker-password"],
+			Email:    params["docker-email"],
+		},
+	}
+	content, err := json.Marshal(dockercfg)
+	if err != nil {
+		return nil, err
+	}
+	secret := newSecret(params["name"], api.SecretTypeDockercfg)
+	secret.Data[api.DockerConfigKey] = content
+	return secret, nil
+}
+
+// SecretForTLSGeneratorV1 generates a TLS secret from a PEM encoded
+// certificate and private key.
+type SecretForTLSGeneratorV1 struct{}
+
+func (SecretForTLSGeneratorV1) ParamNames() []GeneratorParam {
+	return []GeneratorParam{
+		{"name", true},
+		{"cert", true},
+		{"key", true},
+	}
+}
+
+func (SecretForTLSGeneratorV1) Generate(genericParams map[string]interface{}) (runtime.Object, error) {
+	params, err := stringParams(genericParams)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range []string{"name", "cert", "key"} {
+		if len(params[key]) == 0 {
+			return nil, fmt.Errorf("'%s' is a required parameter.", key)
+		}
+	}
+
+	cert, err := ioutil.ReadFile(params["cert"])
+	if err != nil {
+		return nil, err
+	}
+	key, err := ioutil.ReadFile(params["key"])
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tls.X509KeyPair(cert, key); err != nil {
+		return nil, fmt.Errorf("invalid certificate and key pair: %v", err)
+	}
+	secret := newSecret(params["name"], api.SecretTypeTLS)
+	secret.Data[api.TLSCertKey] = cert
+	secret.Data[api.TLSPrivateKeyKey] = key
+	return secret, nil
+}
+
+func newSecret(name string, secretType api.SecretType) *api.Secret {
+	return &api.Secret{
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Type: secretType,
+		Data: map[string][]byte{},
+	}
+}
+
+// stringParams converts generator parameters that are all strings to a map of
+// strings.
+func stringParams(genericParams map[string]interface{}) (map[string]string, error) {
+	params := map[string]string{}
+	for key, value := range genericParams {
+		strVal, isString := value.(string)
+		if !isString {
+			return nil, fmt.Errorf("expected string, saw %v for '%s'", value, key)
+		}
+		params[key] = strVal
+	}
+	return params, nil
+}
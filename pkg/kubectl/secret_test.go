@@ -0,0 +1,182 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/credentialprovider"
+	"k8s.io/kubernetes/pkg/util"
+)
+
+func TestSecretGenerate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secret-generator")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(path.Join(dir, "id_rsa"), []byte("private"), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		params    map[string]interface{}
+		expected  *api.Secret
+		expectErr bool
+	}{
+		{
+			params: map[string]interface{}{
+				"name": "foo",
+			},
+			expected: &api.Secret{
+				ObjectMeta: api.ObjectMeta{Name: "foo"},
+				Type:       api.SecretTypeOpaque,
+				Data:       map[string][]byte{},
+			},
+		},
+		{
+			params: map[string]interface{}{
+				"name":         "foo",
+				"type":         "my-type",
+				"from-file":    []string{"ssh-privatekey=" + path.Join(dir, "id_rsa")},
+				"from-literal": []string{"key1=value1"},
+			},
+			expected: &api.Secret{
+				ObjectMeta: api.ObjectMeta{Name: "foo"},
+				Type:       "my-type",
+				Data: map[string][]byte{
+					"ssh-privatekey": []byte("private"),
+					"key1":           []byte("value1"),
+				},
+			},
+		},
+		{
+			params: map[string]interface{}{
+				"name":         "foo",
+				"from-literal": []string{"key1=value1", "key1=value2"},
+			},
+			expectErr: true,
+		},
+		{
+			params: map[string]interface{}{
+				"name":         "foo",
+				"from-literal": []string{"a..b=value1"},
+			},
+			expectErr: true,
+		},
+	}
+	generator := SecretGeneratorV1{}
+	for i, test := range tests {
+		obj, err := generator.Generate(test.params)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%d: expected error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(obj, test.expected) {
+			t.Errorf("%d: expected:\n%#v\nsaw:\n%#v", i, test.expected, obj)
+		}
+	}
+}
+
+func TestSecretForDockerRegistryGenerate(t *testing.T) {
+	params := map[string]interface{}{
+		"name":            "foo",
+		"docker-server":   "https://registry.example.com",
+		"docker-username": "user",
+		"docker-password": "secret",
+		"docker-email":    "user@example.com",
+	}
+	obj, err := SecretForDockerRegistryGeneratorV1{}.Generate(params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	secret := obj.(*api.Secret)
+	if secret.Name != "foo" || secret.Type != api.SecretTypeDockercfg {
+		t.Errorf("unexpected secret: %#v", secret)
+	}
+	dockercfg := credentialprovider.DockerConfig{}
+	if err := json.Unmarshal(secret.Data[api.DockerConfigKey], &dockercfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := credentialprovider.DockerConfig{
+		"https://registry.example.com": {Username: "user", Password: "secret", Email: "user@example.com"},
+	}
+	if !reflect.DeepEqual(expected, dockercfg) {
+		t.Errorf("expected:\n%#v\nsaw:\n%#v", expected, dockercfg)
+	}
+
+	delete(params, "docker-email")
+	if _, err := (SecretForDockerRegistryGeneratorV1{}).Generate(params); err == nil {
+		t.Errorf("expected error for a missing email")
+	}
+}
+
+func TestSecretForTLSGenerate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secret-for-tls-generator")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	certPath, keyPath := path.Join(dir, "tls.crt"), path.Join(dir, "tls.key")
+	if err := util.GenerateSelfSignedCert("localhost", certPath, keyPath, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cert, _ := ioutil.ReadFile(certPath)
+	key, _ := ioutil.ReadFile(keyPath)
+
+	obj, err := SecretForTLSGeneratorV1{}.Generate(map[string]interface{}{
+		"name": "foo",
+		"cert": certPath,
+		"key":  keyPath,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := &api.Secret{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+		Type:       api.SecretTypeTLS,
+		Data: map[string][]byte{
+			api.TLSCertKey:       cert,
+			api.TLSPrivateKeyKey: key,
+		},
+	}
+	if !reflect.DeepEqual(obj, expected) {
+		t.Errorf("expected:\n%#v\nsaw:\n%#v", expected, obj)
+	}
+
+	// the certificate and the key must form a pair
+	_, err = SecretForTLSGeneratorV1{}.Generate(map[string]interface{}{
+		"name": "foo",
+		"cert": keyPath,
+		"key":  certPath,
+	})
+	if err == nil {
+		t.Errorf("expected error for a mismatched key pair")
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// ServiceAccountGeneratorV1 generates a service account.
+type ServiceAccountGeneratorV1 struct{}
+
+func (ServiceAccountGeneratorV1) ParamNames() []GeneratorParam {
+	return []GeneratorParam{
+		{"name", true},
+	}
+}
+
+func (ServiceAccountGeneratorV1) Generate(genericParams map[string]interface{}) (runtime.Object, error) {
+	params, err := stringParams(genericParams)
+	if err != nil {
+		return nil, err
+	}
+	if len(params["name"]) == 0 {
+		return nil, fmt.Errorf("'name' is a required parameter.")
+	}
+	return &api.ServiceAccount{
+		ObjectMeta: api.ObjectMeta{
+			Name: params["name"],
+		},
+	}, nil
+}
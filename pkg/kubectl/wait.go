@@ -0,0 +1,56 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"encoding/json"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// conditionedObject is the subset of an object serialization that holds
+// status conditions, following the API conventions.
+type conditionedObject struct {
+	Status struct {
+		Conditions []struct {
+			Type   string `json:"type"`
+			Status string `json:"status"`
+		} `json:"conditions"`
+	} `json:"status"`
+}
+
+// ConditionStatus returns the status of the condition with the given type
+// in the status.conditions of obj, and whether obj reports that condition.
+// Condition types are compared case-insensitively. The object is serialized
+// with codec, so any resource with Status.Conditions is supported.
+func ConditionStatus(codec runtime.Codec, obj runtime.Object, conditionType string) (string, bool, error) {
+	data, err := codec.Encode(obj)
+	if err != nil {
+		return "", false, err
+	}
+	var conditioned conditionedObject
+	if err := json.Unmarshal(data, &conditioned); err != nil {
+		return "", false, err
+	}
+	for _, condition := range conditioned.Status.Conditions {
+		if strings.EqualFold(condition.Type, conditionType) {
+			return condition.Status, true, nil
+		}
+	}
+	return "", false, nil
+}
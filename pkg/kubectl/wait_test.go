@@ -0,0 +1,84 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+func TestConditionStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		codec         runtime.Codec
+		obj           runtime.Object
+		conditionType string
+		status        string
+		found         bool
+	}{
+		{
+			name:          "pod ready",
+			codec:         testapi.Default.Codec(),
+			obj:           &api.Pod{Status: api.PodStatus{Conditions: []api.PodCondition{{Type: api.PodReady, Status: api.ConditionTrue}}}},
+			conditionType: "Ready",
+			status:        "True",
+			found:         true,
+		},
+		{
+			name:          "case insensitive",
+			codec:         testapi.Default.Codec(),
+			obj:           &api.Pod{Status: api.PodStatus{Conditions: []api.PodCondition{{Type: api.PodReady, Status: api.ConditionFalse}}}},
+			conditionType: "ready",
+			status:        "False",
+			found:         true,
+		},
+		{
+			name:          "job complete",
+			codec:         testapi.Extensions.Codec(),
+			obj:           &extensions.Job{Status: extensions.JobStatus{Conditions: []extensions.JobCondition{{Type: extensions.JobComplete, Status: api.ConditionTrue}}}},
+			conditionType: "Complete",
+			status:        "True",
+			found:         true,
+		},
+		{
+			name:          "missing condition",
+			codec:         testapi.Default.Codec(),
+			obj:           &api.Pod{},
+			conditionType: "Ready",
+		},
+		{
+			name:          "no conditions in status",
+			codec:         testapi.Default.Codec(),
+			obj:           &api.Service{},
+			conditionType: "Ready",
+		},
+	}
+	for _, test := range tests {
+		status, found, err := ConditionStatus(test.codec, test.obj, test.conditionType)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if status != test.status || found != test.found {
+			t.Errorf("%s: expected %q/%t, got %q/%t", test.name, test.status, test.found, status, found)
+		}
+	}
+}
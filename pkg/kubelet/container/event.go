@@ -41,6 +41,7 @@ const (
 	StartingKubelet         = "Starting"
 	KubeletSetupFailed      = "KubeletSetupFailed"
 	FailedMountVolume       = "FailedMount"
+	FailedEnvironment       = "FailedEnvironment"
 	HostPortConflict        = "HostPortConflict"
 	NodeSelectorMismatching = "NodeSelectorMismatching"
 	InsufficientFreeCPU     = "InsufficientFreeCPU"
@@ -266,6 +266,22 @@ func (f *FakeRuntime) AttachContainer(containerID ContainerID, stdin io.Reader,
 	return f.Err
 }
 
+func (f *FakeRuntime) RunDebugContainer(pod *api.Pod, container *api.Container, target string, pullSecrets []api.Secret, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool) (ContainerID, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.CalledFunctions = append(f.CalledFunctions, "RunDebugContainer")
+	return ContainerID{Type: "test", ID: container.Name}, f.Err
+}
+
+func (f *FakeRuntime) RemoveDebugContainer(containerID ContainerID) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.CalledFunctions = append(f.CalledFunctions, "RemoveDebugContainer")
+	return f.Err
+}
+
 func (f *FakeRuntime) RunInContainer(containerID ContainerID, cmd []string) ([]byte, error) {
 	f.Lock()
 	defer f.Unlock()
@@ -131,6 +131,19 @@ type ContainerAttacher interface {
 	AttachContainer(id ContainerID, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool) (err error)
 }
 
+// DebugContainerRunner is implemented by runtimes that can run a container in
+// the namespaces of a running pod without adding it to the pod's spec.
+type DebugContainerRunner interface {
+	// RunDebugContainer creates the container in the pod, sharing the PID and IPC
+	// namespaces of the target container of the pod, or of the pod itself if target
+	// is empty. The streams are attached before the container starts, and the call
+	// returns once the container exits. The returned ID is set whenever the container
+	// was created, even if an error is returned.
+	RunDebugContainer(pod *api.Pod, container *api.Container, target string, pullSecrets []api.Secret, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool) (ContainerID, error)
+	// RemoveDebugContainer kills and removes a container started by RunDebugContainer.
+	RemoveDebugContainer(id ContainerID) error
+}
+
 // CommandRunner encapsulates the command runner interfaces for testability.
 type ContainerCommandRunner interface {
 	// TODO(vmarmol): Merge RunInContainer and ExecInContainer.
@@ -390,8 +390,14 @@ func (f *FakeDockerClient) StartExec(_ string, _ docker.StartExecOptions) error
 
 func (f *FakeDockerClient) AttachToContainer(opts docker.AttachToContainerOptions) error {
 	f.Lock()
-	defer f.Unlock()
 	f.called = append(f.called, "attach")
+	f.Unlock()
+	// Like the real client, report the attach as established and wait for the
+	// caller to let it proceed.
+	if opts.Success != nil {
+		opts.Success <- struct{}{}
+		<-opts.Success
+	}
 	return nil
 }
 
@@ -140,6 +140,11 @@ type DockerManager struct {
 
 	// Container GC manager
 	containerGC *containerGC
+
+	// IDs of the containers started by RunDebugContainer. They are not part of
+	// their pod's spec, so SyncPod must not kill them as unwanted containers.
+	debugContainersLock sync.Mutex
+	debugContainers     sets.String
 }
 
 func NewDockerManager(
@@ -213,6 +218,7 @@ func NewDockerManager(
 		oomAdjuster:            oomAdjuster,
 		procFs:                 procFs,
 		cpuCFSQuota:            cpuCFSQuota,
+		debugContainers:        sets.NewString(),
 	}
 	dm.runner = lifecycle.NewHandlerRunner(httpClient, dm, dm)
 	if serializeImagePulls {
@@ -668,6 +674,9 @@ func makePortsAndBindings(portMappings []kubecontainer.PortMapping) (map[docker.
 	return exposedPorts, portBindings
 }
 
+// runContainer creates and starts a docker container for the container of pod.
+// If beforeStart is not nil, it is called with the ID of the created container
+// before the container is started.
 func (dm *DockerManager) runContainer(
 	pod *api.Pod,
 	container *api.Container,
@@ -677,7 +686,8 @@ func (dm *DockerManager) runContainer(
 	ipcMode string,
 	utsMode string,
 	pidMode string,
-	restartCount int) (kubecontainer.ContainerID, error) {
+	restartCount int,
+	beforeStart func(dockerID string) error) (kubecontainer.ContainerID, error) {
 
 	dockerName := KubeletContainerName{
 		PodFullName:   kubecontainer.GetPodFullName(pod),
@@ -816,6 +826,11 @@ func (dm *DockerManager) runContainer(
 	}
 	securityContextProvider.ModifyHostConfig(pod, container, hc)
 
+	if beforeStart != nil {
+		if err = beforeStart(dockerContainer.ID); err != nil {
+			return kubecontainer.ContainerID{}, err
+		}
+	}
 	if err = dm.client.StartContainer(dockerContainer.ID, hc); err != nil {
 		dm.recorder.Eventf(ref, api.EventTypeWarning, kubecontainer.FailedToStartContainer,
 			"Failed to start container with docker id %v with error: %v", util.ShortenString(dockerContainer.ID, 12), err)
@@ -1185,6 +1200,118 @@ func noPodInfraContainerError(podName, podNamespace string) error {
 	return fmt.Errorf("cannot find pod infra container in pod %q", kubecontainer.BuildPodFullName(podName, podNamespace))
 }
 
+// RunDebugContainer runs container in the network namespace of a running pod and
+// in the IPC and PID namespaces of its target container, so that the container can
+// see the target's processes. If target is empty, the namespaces of the pod infra
+// container are used instead. The streams are attached before the container is
+// started so that no output is lost, and the call returns once the container exits.
+// The pod spec is left untouched, so the container is neither restarted nor killed
+// by SyncPod; the caller is responsible for removing it with RemoveDebugContainer.
+// Sharing the PID namespace of another container requires Docker 1.12 or later.
+func (dm *DockerManager) RunDebugContainer(pod *api.Pod, container *api.Container, target string, pullSecrets []api.Secret, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool) (kubecontainer.ContainerID, error) {
+	pods, err := dm.GetPods(false)
+	if err != nil {
+		return kubecontainer.ContainerID{}, err
+	}
+	runningPod := kubecontainer.Pods(pods).FindPod(kubecontainer.GetPodFullName(pod), pod.UID)
+	podInfraContainer := runningPod.FindContainerByName(PodInfraContainerName)
+	if podInfraContainer == nil {
+		return kubecontainer.ContainerID{}, noPodInfraContainerError(pod.Name, pod.Namespace)
+	}
+
+	netMode := fmt.Sprintf("container:%v", podInfraContainer.ID.ID)
+	ipcMode := netMode
+	pidMode := getPidMode(pod)
+	if pidMode == "" {
+		pidMode = netMode
+	}
+	if len(target) != 0 {
+		targetContainer := runningPod.FindContainerByName(target)
+		if targetContainer == nil {
+			return kubecontainer.ContainerID{}, fmt.Errorf("target container %q is not running in pod %q", target, kubecontainer.GetPodFullName(pod))
+		}
+		ipcMode = fmt.Sprintf("container:%v", targetContainer.ID.ID)
+		pidMode = ipcMode
+	}
+
+	if err, msg := dm.imagePuller.PullImage(pod, container, pullSecrets); err != nil {
+		return kubecontainer.ContainerID{}, fmt.Errorf("%v: %s", err, msg)
+	}
+
+	ref, err := kubecontainer.GenerateContainerRef(pod, container)
+	if err != nil {
+		glog.Errorf("Couldn't make a ref to pod %v, container %v: '%v'", pod.Name, container.Name, err)
+	}
+	opts, err := dm.generator.GenerateRunContainerOptions(pod, container)
+	if err != nil {
+		return kubecontainer.ContainerID{}, err
+	}
+	utsMode := ""
+	if pod.Spec.SecurityContext != nil && pod.Spec.SecurityContext.HostNetwork {
+		utsMode = "host"
+	}
+
+	var created kubecontainer.ContainerID
+	attachDone := make(chan error, 1)
+	attach := func(dockerID string) error {
+		created = kubetypes.DockerID(dockerID).ContainerID()
+		// Track the container before it is started so that a concurrent
+		// SyncPod does not kill it.
+		dm.debugContainersLock.Lock()
+		dm.debugContainers.Insert(dockerID)
+		dm.debugContainersLock.Unlock()
+
+		attached := make(chan struct{})
+		go func() {
+			attachDone <- dm.client.AttachToContainer(docker.AttachToContainerOptions{
+				Container:    dockerID,
+				InputStream:  stdin,
+				OutputStream: stdout,
+				ErrorStream:  stderr,
+				Stream:       true,
+				Stdin:        stdin != nil,
+				Stdout:       stdout != nil,
+				Stderr:       stderr != nil,
+				RawTerminal:  tty,
+				Success:      attached,
+			})
+		}()
+		select {
+		case <-attached:
+			// let the attach proceed
+			attached <- struct{}{}
+			return nil
+		case err := <-attachDone:
+			return fmt.Errorf("unable to attach to debug container: %v", err)
+		}
+	}
+	if _, err := dm.runContainer(pod, container, opts, ref, netMode, ipcMode, utsMode, pidMode, 0, attach); err != nil {
+		return created, err
+	}
+	return created, <-attachDone
+}
+
+// RemoveDebugContainer kills and removes a container started by RunDebugContainer.
+func (dm *DockerManager) RemoveDebugContainer(containerID kubecontainer.ContainerID) error {
+	defer func() {
+		dm.debugContainersLock.Lock()
+		defer dm.debugContainersLock.Unlock()
+		dm.debugContainers.Delete(containerID.ID)
+	}()
+	if err := dm.killContainer(containerID, nil, nil, "Debug session ended"); err != nil {
+		// the container may never have started; removing it forcefully still cleans it up
+		glog.V(4).Infof("Failed to kill debug container %q: %v", containerID.ID, err)
+	}
+	return dm.client.RemoveContainer(docker.RemoveContainerOptions{ID: containerID.ID, RemoveVolumes: true, Force: true})
+}
+
+// isDebugContainer returns true if the container was started by RunDebugContainer.
+func (dm *DockerManager) isDebugContainer(containerID kubecontainer.ContainerID) bool {
+	dm.debugContainersLock.Lock()
+	defer dm.debugContainersLock.Unlock()
+	return dm.debugContainers.Has(containerID.ID)
+}
+
 // PortForward executes socat in the pod's network namespace and copies
 // data between stream (representing the user's local connection on their
 // computer) and the specified port in the container.
@@ -1507,7 +1634,7 @@ func (dm *DockerManager) runContainerInPod(pod *api.Pod, container *api.Containe
 	if pod.Spec.SecurityContext != nil && pod.Spec.SecurityContext.HostNetwork {
 		utsMode = "host"
 	}
-	id, err := dm.runContainer(pod, container, opts, ref, netMode, ipcMode, utsMode, pidMode, restartCount)
+	id, err := dm.runContainer(pod, container, opts, ref, netMode, ipcMode, utsMode, pidMode, restartCount, nil)
 	if err != nil {
 		return kubecontainer.ContainerID{}, err
 	}
@@ -1835,7 +1962,7 @@ func (dm *DockerManager) SyncPod(pod *api.Pod, runningPod kubecontainer.Pod, pod
 		// Otherwise kill any containers in this pod which are not specified as ones to keep.
 		for _, container := range runningPod.Containers {
 			_, keep := containerChanges.ContainersToKeep[kubetypes.DockerID(container.ID.ID)]
-			if !keep {
+			if !keep && !dm.isDebugContainer(container.ID) {
 				glog.V(3).Infof("Killing unwanted container %+v", container)
 				// attempt to find the appropriate container policy
 				var podContainer *api.Container
@@ -887,6 +887,105 @@ func TestSyncPodsDoesNothing(t *testing.T) {
 	})
 }
 
+func TestSyncPodKeepsDebugContainer(t *testing.T) {
+	dm, fakeDocker := newTestDockerManager()
+	container := api.Container{Name: "bar"}
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			UID:       "12345678",
+			Name:      "foo",
+			Namespace: "new",
+		},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				container,
+			},
+		},
+	}
+	fakeDocker.SetFakeRunningContainers([]*docker.Container{
+		{
+			ID:   "1234",
+			Name: "/k8s_bar." + strconv.FormatUint(kubecontainer.HashContainer(&container), 16) + "_foo_new_12345678_0",
+		},
+		{
+			ID:   "5678",
+			Name: "/k8s_debugger_foo_new_12345678_0",
+		},
+		{
+			ID:   "9876",
+			Name: "/k8s_POD." + strconv.FormatUint(generatePodInfraContainerHash(pod), 16) + "_foo_new_12345678_0",
+		}})
+	dm.debugContainers.Insert("5678")
+
+	runSyncPod(t, dm, fakeDocker, pod, nil, false)
+
+	verifyCalls(t, fakeDocker, []string{
+		// Check the pod infra contianer.
+		"inspect_container",
+	})
+
+	if err := dm.RemoveDebugContainer(kubetypes.DockerID("5678").ContainerID()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fakeDocker.Stopped) != 1 || fakeDocker.Stopped[0] != "5678" {
+		t.Errorf("Wrong containers were stopped: %v", fakeDocker.Stopped)
+	}
+	if dm.isDebugContainer(kubetypes.DockerID("5678").ContainerID()) {
+		t.Errorf("Expected debug container to be untracked after removal")
+	}
+}
+
+func TestRunDebugContainer(t *testing.T) {
+	dm, fakeDocker := newTestDockerManager()
+	container := api.Container{Name: "bar"}
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			UID:       "12345678",
+			Name:      "foo",
+			Namespace: "new",
+		},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				container,
+			},
+		},
+	}
+	fakeDocker.SetFakeRunningContainers([]*docker.Container{
+		{
+			ID:   "1234",
+			Name: "/k8s_bar." + strconv.FormatUint(kubecontainer.HashContainer(&container), 16) + "_foo_new_12345678_0",
+		},
+		{
+			ID:   "9876",
+			Name: "/k8s_POD." + strconv.FormatUint(generatePodInfraContainerHash(pod), 16) + "_foo_new_12345678_0",
+		}})
+	debugContainer := &api.Container{Name: "debugger", Image: "busybox", ImagePullPolicy: api.PullIfNotPresent}
+
+	if _, err := dm.RunDebugContainer(pod, debugContainer, "missing", nil, nil, nil, nil, false); err == nil {
+		t.Errorf("expected an error for a target container that is not running")
+	}
+
+	fakeDocker.ClearCalls()
+	id, err := dm.RunDebugContainer(pod, debugContainer, "bar", nil, nil, nil, nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The streams must be attached before the container starts.
+	if err := fakeDocker.AssertCalls([]string{"list", "create", "attach", "start"}); err != nil {
+		t.Error(err)
+	}
+	if !dm.isDebugContainer(id) {
+		t.Errorf("expected the debug container to be tracked")
+	}
+	hostConfig := fakeDocker.ContainerMap[id.ID].HostConfig
+	if hostConfig.NetworkMode != "container:9876" {
+		t.Errorf("expected the network namespace of the pod, got %q", hostConfig.NetworkMode)
+	}
+	if hostConfig.PidMode != "container:1234" || hostConfig.IpcMode != "container:1234" {
+		t.Errorf("expected the PID and IPC namespaces of the target container, got %q and %q", hostConfig.PidMode, hostConfig.IpcMode)
+	}
+}
+
 func TestSyncPodWithPullPolicy(t *testing.T) {
 	dm, fakeDocker := newTestDockerManager()
 	puller := dm.dockerPuller.(*FakeDockerPuller)
@@ -1417,6 +1417,9 @@ func (kl *Kubelet) makeEnvironmentVariables(pod *api.Pod, container *api.Contain
 	// 3.  Add remaining service environment vars
 
 	tmpEnv := make(map[string]string)
+	// ConfigMaps and secrets referenced by more than one variable are only fetched once.
+	configMaps := make(map[string]*api.ConfigMap)
+	secrets := make(map[string]*api.Secret)
 	mappingFunc := expansion.MappingFuncFor(tmpEnv, serviceEnv)
 	for _, envVar := range container.Env {
 		// Accesses apiserver+Pods.
@@ -1430,10 +1433,20 @@ func (kl *Kubelet) makeEnvironmentVariables(pod *api.Pod, container *api.Contain
 		if runtimeVal != "" {
 			// Step 1a: expand variable references
 			runtimeVal = expansion.Expand(runtimeVal, mappingFunc)
-		} else if envVar.ValueFrom != nil && envVar.ValueFrom.FieldRef != nil {
+		} else if envVar.ValueFrom != nil {
 			// Step 1b: resolve alternate env var sources
-			runtimeVal, err = kl.podFieldSelectorRuntimeValue(envVar.ValueFrom.FieldRef, pod)
+			switch {
+			case envVar.ValueFrom.FieldRef != nil:
+				runtimeVal, err = kl.podFieldSelectorRuntimeValue(envVar.ValueFrom.FieldRef, pod)
+			case envVar.ValueFrom.ConfigMapKeyRef != nil:
+				runtimeVal, err = kl.configMapKeyRuntimeValue(envVar.ValueFrom.ConfigMapKeyRef, pod, configMaps)
+			case envVar.ValueFrom.SecretKeyRef != nil:
+				runtimeVal, err = kl.secretKeyRuntimeValue(envVar.ValueFrom.SecretKeyRef, pod, secrets)
+			}
 			if err != nil {
+				// Surface the problem on the pod; the container is not started
+				// until the source can be resolved.
+				kl.recorder.Eventf(pod, api.EventTypeWarning, kubecontainer.FailedEnvironment, "Couldn't resolve environment variable %q of container %q: %v", envVar.Name, container.Name, err)
 				return result, err
 			}
 		}
@@ -1449,6 +1462,50 @@ func (kl *Kubelet) makeEnvironmentVariables(pod *api.Pod, container *api.Contain
 	return result, nil
 }
 
+// configMapKeyRuntimeValue returns the value of the selected key of a ConfigMap
+// in the pod's namespace.  Fetched ConfigMaps are kept in cache.
+func (kl *Kubelet) configMapKeyRuntimeValue(s *api.ConfigMapKeySelector, pod *api.Pod, cache map[string]*api.ConfigMap) (string, error) {
+	configMap, ok := cache[s.Name]
+	if !ok {
+		if kl.kubeClient == nil {
+			return "", fmt.Errorf("Couldn't get configMap %v/%v, no kubeClient defined", pod.Namespace, s.Name)
+		}
+		var err error
+		configMap, err = kl.kubeClient.ConfigMaps(pod.Namespace).Get(s.Name)
+		if err != nil {
+			return "", err
+		}
+		cache[s.Name] = configMap
+	}
+	value, ok := configMap.Data[s.Key]
+	if !ok {
+		return "", fmt.Errorf("Couldn't find key %v in ConfigMap %v/%v", s.Key, pod.Namespace, s.Name)
+	}
+	return value, nil
+}
+
+// secretKeyRuntimeValue returns the value of the selected key of a secret in
+// the pod's namespace.  Fetched secrets are kept in cache.
+func (kl *Kubelet) secretKeyRuntimeValue(s *api.SecretKeySelector, pod *api.Pod, cache map[string]*api.Secret) (string, error) {
+	secret, ok := cache[s.Name]
+	if !ok {
+		if kl.kubeClient == nil {
+			return "", fmt.Errorf("Couldn't get secret %v/%v, no kubeClient defined", pod.Namespace, s.Name)
+		}
+		var err error
+		secret, err = kl.kubeClient.Secrets(pod.Namespace).Get(s.Name)
+		if err != nil {
+			return "", err
+		}
+		cache[s.Name] = secret
+	}
+	value, ok := secret.Data[s.Key]
+	if !ok {
+		return "", fmt.Errorf("Couldn't find key %v in Secret %v/%v", s.Key, pod.Namespace, s.Name)
+	}
+	return string(value), nil
+}
+
 func (kl *Kubelet) podFieldSelectorRuntimeValue(fs *api.ObjectFieldSelector, pod *api.Pod) (string, error) {
 	internalFieldPath, _, err := api.Scheme.ConvertFieldLabel(fs.APIVersion, "Pod", fs.FieldPath, "")
 	if err != nil {
@@ -3186,6 +3243,54 @@ func (kl *Kubelet) AttachContainer(podFullName string, podUID types.UID, contain
 	return kl.containerRuntime.AttachContainer(container.ID, stdin, stdout, stderr, tty)
 }
 
+// DebugContainer runs container in the namespaces of a running pod, attaches the
+// supplied stdin/stdout/stderr to it and removes it once it exits. The container
+// sees the processes of the target container, if one is named. The pod itself is
+// not modified or restarted.
+func (kl *Kubelet) DebugContainer(podFullName string, podUID types.UID, container *api.Container, target string, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool) error {
+	podUID = kl.podManager.TranslatePodUID(podUID)
+
+	runner, ok := kl.containerRuntime.(kubecontainer.DebugContainerRunner)
+	if !ok {
+		return fmt.Errorf("the container runtime does not support debug containers")
+	}
+	pod, ok := kl.GetPodByFullName(podFullName)
+	if !ok || (podUID != "" && pod.UID != podUID) {
+		return fmt.Errorf("pod not found (%q)", podFullName)
+	}
+	targetFound := len(target) == 0
+	for _, c := range pod.Spec.Containers {
+		if c.Name == container.Name {
+			return fmt.Errorf("container %q already exists in pod %q", container.Name, podFullName)
+		}
+		if c.Name == target {
+			targetFound = true
+		}
+	}
+	if !targetFound {
+		return fmt.Errorf("target container %q not found in pod %q", target, podFullName)
+	}
+	existing, err := kl.findContainer(podFullName, pod.UID, container.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("container %q already exists in pod %q", container.Name, podFullName)
+	}
+
+	pullSecrets, err := kl.getPullSecretsForPod(pod)
+	if err != nil {
+		return err
+	}
+	id, err := runner.RunDebugContainer(pod, container, target, pullSecrets, stdin, stdout, stderr, tty)
+	if len(id.ID) != 0 {
+		if err := runner.RemoveDebugContainer(id); err != nil {
+			glog.Errorf("Failed to remove debug container %q of pod %q: %v", container.Name, podFullName, err)
+		}
+	}
+	return err
+}
+
 // PortForward connects to the pod's port and copies data between the port
 // and the stream.
 func (kl *Kubelet) PortForward(podFullName string, podUID types.UID, port uint16, stream io.ReadWriteCloser) error {
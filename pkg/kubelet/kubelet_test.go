@@ -1530,6 +1530,232 @@ func TestMakeEnvironmentVariables(t *testing.T) {
 	}
 }
 
+func TestMakeEnvironmentVariablesFromConfigMap(t *testing.T) {
+	configMap := &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{Name: "test-configmap", Namespace: "test1"},
+		Data: map[string]string{
+			"REPLACE_ME": "FROM_CONFIG_MAP",
+			"DUPLICATE":  "AGAIN",
+		},
+	}
+	testCases := []struct {
+		name         string
+		env          []api.EnvVar
+		expectedEnvs []kubecontainer.EnvVar
+		expectErr    bool
+	}{
+		{
+			name: "keys resolved from a config map",
+			env: []api.EnvVar{
+				{
+					Name: "FOO",
+					ValueFrom: &api.EnvVarSource{
+						ConfigMapKeyRef: &api.ConfigMapKeySelector{
+							LocalObjectReference: api.LocalObjectReference{Name: "test-configmap"},
+							Key:                  "REPLACE_ME",
+						},
+					},
+				},
+				{
+					Name: "BAR",
+					ValueFrom: &api.EnvVarSource{
+						ConfigMapKeyRef: &api.ConfigMapKeySelector{
+							LocalObjectReference: api.LocalObjectReference{Name: "test-configmap"},
+							Key:                  "DUPLICATE",
+						},
+					},
+				},
+				{
+					Name:  "EXPANDED",
+					Value: "$(FOO)-$(BAR)",
+				},
+			},
+			expectedEnvs: []kubecontainer.EnvVar{
+				{Name: "FOO", Value: "FROM_CONFIG_MAP"},
+				{Name: "BAR", Value: "AGAIN"},
+				{Name: "EXPANDED", Value: "FROM_CONFIG_MAP-AGAIN"},
+			},
+		},
+		{
+			name: "missing key",
+			env: []api.EnvVar{
+				{
+					Name: "FOO",
+					ValueFrom: &api.EnvVarSource{
+						ConfigMapKeyRef: &api.ConfigMapKeySelector{
+							LocalObjectReference: api.LocalObjectReference{Name: "test-configmap"},
+							Key:                  "MISSING",
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "missing config map",
+			env: []api.EnvVar{
+				{
+					Name: "FOO",
+					ValueFrom: &api.EnvVarSource{
+						ConfigMapKeyRef: &api.ConfigMapKeySelector{
+							LocalObjectReference: api.LocalObjectReference{Name: "missing-configmap"},
+							Key:                  "REPLACE_ME",
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		testKubelet := newTestKubelet(t)
+		kl := testKubelet.kubelet
+		kl.serviceLister = testServiceLister{}
+		fakeClient := &testclient.Fake{}
+		fakeClient.AddReactor("get", "configmaps", func(action testclient.Action) (bool, runtime.Object, error) {
+			if action.(testclient.GetAction).GetName() != configMap.Name {
+				return true, nil, apierrors.NewNotFound("ConfigMap", action.(testclient.GetAction).GetName())
+			}
+			return true, configMap, nil
+		})
+		kl.kubeClient = fakeClient
+
+		testPod := &api.Pod{
+			ObjectMeta: api.ObjectMeta{
+				Namespace: "test1",
+				Name:      "dapi-test-pod-name",
+			},
+		}
+
+		result, err := kl.makeEnvironmentVariables(testPod, &api.Container{Env: tc.env})
+		if tc.expectErr {
+			if err == nil {
+				t.Errorf("[%v] Expected error, got none", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("[%v] Unexpected error: %v", tc.name, err)
+			continue
+		}
+
+		sort.Sort(envs(result))
+		sort.Sort(envs(tc.expectedEnvs))
+		if !reflect.DeepEqual(result, tc.expectedEnvs) {
+			t.Errorf("[%v] Unexpected env entries; expected {%v}, got {%v}", tc.name, tc.expectedEnvs, result)
+		}
+
+		gets := 0
+		for _, action := range fakeClient.Actions() {
+			if action.GetVerb() == "get" && action.GetResource() == "configmaps" {
+				gets++
+			}
+		}
+		if gets != 1 {
+			t.Errorf("[%v] Expected the config map to be fetched once, got %d gets", tc.name, gets)
+		}
+	}
+}
+
+func TestMakeEnvironmentVariablesFromSecret(t *testing.T) {
+	secret := &api.Secret{
+		ObjectMeta: api.ObjectMeta{Name: "test-secret", Namespace: "test1"},
+		Data: map[string][]byte{
+			"password": []byte("s3cr3t"),
+			"user":     []byte("admin"),
+		},
+	}
+	secretKeyRef := func(name, key string) *api.EnvVarSource {
+		return &api.EnvVarSource{
+			SecretKeyRef: &api.SecretKeySelector{
+				LocalObjectReference: api.LocalObjectReference{Name: name},
+				Key:                  key,
+			},
+		}
+	}
+	testCases := []struct {
+		name         string
+		env          []api.EnvVar
+		expectedEnvs []kubecontainer.EnvVar
+		expectEvent  bool
+	}{
+		{
+			name: "keys resolved from a secret",
+			env: []api.EnvVar{
+				{Name: "PASSWORD", ValueFrom: secretKeyRef("test-secret", "password")},
+				{Name: "USER", ValueFrom: secretKeyRef("test-secret", "user")},
+				{Name: "CREDENTIALS", Value: "$(USER):$(PASSWORD)"},
+			},
+			expectedEnvs: []kubecontainer.EnvVar{
+				{Name: "PASSWORD", Value: "s3cr3t"},
+				{Name: "USER", Value: "admin"},
+				{Name: "CREDENTIALS", Value: "admin:s3cr3t"},
+			},
+		},
+		{
+			name: "missing key",
+			env: []api.EnvVar{
+				{Name: "TOKEN", ValueFrom: secretKeyRef("test-secret", "token")},
+			},
+			expectEvent: true,
+		},
+		{
+			name: "missing secret",
+			env: []api.EnvVar{
+				{Name: "PASSWORD", ValueFrom: secretKeyRef("missing-secret", "password")},
+			},
+			expectEvent: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		testKubelet := newTestKubelet(t)
+		kl := testKubelet.kubelet
+		fakeRecorder := &record.FakeRecorder{}
+		kl.recorder = fakeRecorder
+		fakeClient := &testclient.Fake{}
+		fakeClient.AddReactor("get", "secrets", func(action testclient.Action) (bool, runtime.Object, error) {
+			if action.(testclient.GetAction).GetName() != secret.Name {
+				return true, nil, apierrors.NewNotFound("Secret", action.(testclient.GetAction).GetName())
+			}
+			return true, secret, nil
+		})
+		kl.kubeClient = fakeClient
+
+		testPod := &api.Pod{
+			ObjectMeta: api.ObjectMeta{
+				Namespace: "test1",
+				Name:      "dapi-test-pod-name",
+			},
+		}
+
+		result, err := kl.makeEnvironmentVariables(testPod, &api.Container{Name: "test-container", Env: tc.env})
+		if tc.expectEvent {
+			if err == nil {
+				t.Errorf("[%v] Expected error, got none", tc.name)
+			}
+			if len(fakeRecorder.Events) != 1 || !strings.Contains(fakeRecorder.Events[0], kubecontainer.FailedEnvironment) {
+				t.Errorf("[%v] Expected a %s event, got %v", tc.name, kubecontainer.FailedEnvironment, fakeRecorder.Events)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("[%v] Unexpected error: %v", tc.name, err)
+			continue
+		}
+		if len(fakeRecorder.Events) != 0 {
+			t.Errorf("[%v] Unexpected events: %v", tc.name, fakeRecorder.Events)
+		}
+
+		sort.Sort(envs(result))
+		sort.Sort(envs(tc.expectedEnvs))
+		if !reflect.DeepEqual(result, tc.expectedEnvs) {
+			t.Errorf("[%v] Unexpected env entries; expected {%v}, got {%v}", tc.name, tc.expectedEnvs, result)
+		}
+	}
+}
+
 func waitingState(cName string) api.ContainerStatus {
 	return api.ContainerStatus{
 		Name: cName,
@@ -2169,6 +2395,40 @@ func TestExecInContainer(t *testing.T) {
 	}
 }
 
+func TestDebugContainer(t *testing.T) {
+	testKubelet := newTestKubelet(t)
+	kubelet := testKubelet.kubelet
+	fakeRuntime := testKubelet.fakeRuntime
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{UID: "12345678", Name: "podFoo", Namespace: "nsFoo"},
+		Spec:       api.PodSpec{Containers: []api.Container{{Name: "containerFoo"}}},
+	}
+	kubelet.podManager.SetPods([]*api.Pod{pod})
+	podFullName := kubecontainer.GetPodFullName(pod)
+
+	// The name of a container of the pod cannot be reused.
+	err := kubelet.DebugContainer(podFullName, "", &api.Container{Name: "containerFoo", Image: "busybox"}, "", nil, nil, nil, false)
+	if err == nil {
+		t.Fatal("unexpected non-error")
+	}
+	// The target must be a container of the pod.
+	err = kubelet.DebugContainer(podFullName, "", &api.Container{Name: "debugger", Image: "busybox"}, "containerBar", nil, nil, nil, false)
+	if err == nil {
+		t.Fatal("unexpected non-error")
+	}
+	if len(fakeRuntime.CalledFunctions) != 0 {
+		t.Fatalf("unexpected function calls: %v", fakeRuntime.CalledFunctions)
+	}
+
+	err = kubelet.DebugContainer(podFullName, "", &api.Container{Name: "debugger", Image: "busybox"}, "containerFoo", nil, nil, nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := fakeRuntime.AssertCalls([]string{"GetPods", "RunDebugContainer", "RemoveDebugContainer"}); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestPortForwardNoSuchPod(t *testing.T) {
 	testKubelet := newTestKubelet(t)
 	kubelet := testKubelet.kubelet
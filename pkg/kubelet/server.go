@@ -150,6 +150,7 @@ type HostInterface interface {
 	RunInContainer(name string, uid types.UID, container string, cmd []string) ([]byte, error)
 	ExecInContainer(name string, uid types.UID, container string, cmd []string, in io.Reader, out, err io.WriteCloser, tty bool) error
 	AttachContainer(name string, uid types.UID, container string, in io.Reader, out, err io.WriteCloser, tty bool) error
+	DebugContainer(name string, uid types.UID, container *api.Container, target string, in io.Reader, out, err io.WriteCloser, tty bool) error
 	GetKubeletContainerLogs(podFullName, containerName string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) error
 	ServeLogs(w http.ResponseWriter, req *http.Request)
 	PortForward(name string, uid types.UID, port uint16, stream io.ReadWriteCloser) error
@@ -290,6 +291,23 @@ func (s *Server) InstallDebuggingHandlers() {
 		Operation("getAttach"))
 	s.restfulCont.Add(ws)
 
+	ws = new(restful.WebService)
+	ws.
+		Path("/debug")
+	ws.Route(ws.GET("/{podNamespace}/{podID}/{containerName}").
+		To(s.getDebug).
+		Operation("getDebug"))
+	ws.Route(ws.POST("/{podNamespace}/{podID}/{containerName}").
+		To(s.getDebug).
+		Operation("getDebug"))
+	ws.Route(ws.GET("/{podNamespace}/{podID}/{uid}/{containerName}").
+		To(s.getDebug).
+		Operation("getDebug"))
+	ws.Route(ws.POST("/{podNamespace}/{podID}/{uid}/{containerName}").
+		To(s.getDebug).
+		Operation("getDebug"))
+	s.restfulCont.Add(ws)
+
 	ws = new(restful.WebService)
 	ws.
 		Path("/portForward")
@@ -623,6 +641,46 @@ func (s *Server) getExec(request *restful.Request, response *restful.Response) {
 	}
 }
 
+// getDebug handles requests to run a debug container in the namespaces of a pod
+// and attach to it.
+func (s *Server) getDebug(request *restful.Request, response *restful.Response) {
+	podNamespace, podID, uid, container := getContainerCoordinates(request)
+	pod, ok := s.host.GetPodByName(podNamespace, podID)
+	if !ok {
+		response.WriteError(http.StatusNotFound, fmt.Errorf("pod does not exist"))
+		return
+	}
+	image := request.QueryParameter("image")
+	if len(image) == 0 {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("an image must be specified"))
+		return
+	}
+	stdinStream, stdoutStream, stderrStream, errorStream, conn, tty, ok := s.createStreams(request, response)
+	if conn != nil {
+		defer conn.Close()
+	}
+	if !ok {
+		// error is handled in the createStreams function
+		return
+	}
+	debugContainer := &api.Container{
+		Name:            container,
+		Image:           image,
+		Command:         request.Request.URL.Query()[api.ExecCommandParamm],
+		ImagePullPolicy: api.PullIfNotPresent,
+		Stdin:           stdinStream != nil,
+		StdinOnce:       stdinStream != nil,
+		TTY:             tty,
+	}
+	target := request.QueryParameter("target")
+	err := s.host.DebugContainer(kubecontainer.GetPodFullName(pod), uid, debugContainer, target, stdinStream, stdoutStream, stderrStream, tty)
+	if err != nil {
+		msg := fmt.Sprintf("Error running debug container: %v", err)
+		glog.Error(msg)
+		errorStream.Write([]byte(msg))
+	}
+}
+
 // standardShellChannels returns the standard channel types for a shell connection (STDIN 0, STDOUT 1, STDERR 2)
 // along with the approprxate duplex value
 func standardShellChannels(stdin, stdout, stderr bool) []wsstream.ChannelType {
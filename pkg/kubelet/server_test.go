@@ -59,6 +59,7 @@ type fakeKubelet struct {
 	containerVersionFunc               func() (kubecontainer.Version, error)
 	execFunc                           func(pod string, uid types.UID, container string, cmd []string, in io.Reader, out, err io.WriteCloser, tty bool) error
 	attachFunc                         func(pod string, uid types.UID, container string, in io.Reader, out, err io.WriteCloser, tty bool) error
+	debugFunc                          func(pod string, uid types.UID, container *api.Container, target string, in io.Reader, out, err io.WriteCloser, tty bool) error
 	portForwardFunc                    func(name string, uid types.UID, port uint16, stream io.ReadWriteCloser) error
 	containerLogsFunc                  func(podFullName, containerName string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) error
 	streamingConnectionIdleTimeoutFunc func() time.Duration
@@ -127,6 +128,10 @@ func (fk *fakeKubelet) AttachContainer(name string, uid types.UID, container str
 	return fk.attachFunc(name, uid, container, in, out, err, tty)
 }
 
+func (fk *fakeKubelet) DebugContainer(name string, uid types.UID, container *api.Container, target string, in io.Reader, out, err io.WriteCloser, tty bool) error {
+	return fk.debugFunc(name, uid, container, target, in, out, err, tty)
+}
+
 func (fk *fakeKubelet) PortForward(name string, uid types.UID, port uint16, stream io.ReadWriteCloser) error {
 	return fk.portForwardFunc(name, uid, port, stream)
 }
@@ -1462,6 +1467,104 @@ func TestServeAttachContainer(t *testing.T) {
 	}
 }
 
+func TestServeDebugContainer(t *testing.T) {
+	fw := newServerTest()
+	fw.fakeKubelet.streamingConnectionIdleTimeoutFunc = func() time.Duration {
+		return 0
+	}
+
+	podNamespace := "other"
+	podName := "foo"
+	expectedPodName := getPodName(podName, podNamespace)
+	expectedContainer := &api.Container{
+		Name:            "debugger",
+		Image:           "busybox",
+		Command:         []string{"ls", "-a"},
+		ImagePullPolicy: api.PullIfNotPresent,
+	}
+	expectedStdout := "stdout"
+	debugFuncDone := make(chan struct{})
+	clientStdoutReadDone := make(chan struct{})
+
+	fw.fakeKubelet.debugFunc = func(podFullName string, uid types.UID, container *api.Container, target string, in io.Reader, out, stderr io.WriteCloser, tty bool) error {
+		defer close(debugFuncDone)
+		if podFullName != expectedPodName {
+			t.Fatalf("podFullName: expected %s, got %s", expectedPodName, podFullName)
+		}
+		if target != "app" {
+			t.Fatalf("target: expected app, got %s", target)
+		}
+		if !reflect.DeepEqual(expectedContainer, container) {
+			t.Fatalf("container: expected %#v, got %#v", expectedContainer, container)
+		}
+		if in != nil || stderr != nil {
+			t.Fatalf("expected nil stdin and stderr, got %#v and %#v", in, stderr)
+		}
+		if _, err := out.Write([]byte(expectedStdout)); err != nil {
+			t.Fatalf("error writing to stdout: %v", err)
+		}
+		out.Close()
+		<-clientStdoutReadDone
+		return nil
+	}
+
+	url := fw.testHTTPServer.URL + "/debug/" + podNamespace + "/" + podName + "/debugger?command=ls&command=-a&" + api.ExecStdoutParam + "=1"
+
+	// an image is required
+	resp, err := http.Post(url, "", nil)
+	if err != nil {
+		t.Fatalf("Got error POSTing: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("response status: expected %v, got %v", http.StatusBadRequest, resp.StatusCode)
+	}
+
+	upgradeRoundTripper := spdy.NewRoundTripper(nil)
+	c := &http.Client{Transport: upgradeRoundTripper}
+	resp, err = c.Post(url+"&image=busybox&target=app", "", nil)
+	if err != nil {
+		t.Fatalf("Got error POSTing: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("response status: expected %v, got %v", http.StatusSwitchingProtocols, resp.StatusCode)
+	}
+
+	conn, err := upgradeRoundTripper.NewConnection(resp)
+	if err != nil {
+		t.Fatalf("Unexpected error creating streaming connection: %s", err)
+	}
+	defer conn.Close()
+
+	h := http.Header{}
+	h.Set(api.StreamType, api.StreamTypeError)
+	errorStream, err := conn.CreateStream(h)
+	if err != nil {
+		t.Fatalf("error creating error stream: %v", err)
+	}
+	defer errorStream.Reset()
+
+	h.Set(api.StreamType, api.StreamTypeStdout)
+	stdoutStream, err := conn.CreateStream(h)
+	if err != nil {
+		t.Fatalf("error creating stdout stream: %v", err)
+	}
+	defer stdoutStream.Reset()
+
+	output := make([]byte, 10)
+	n, err := stdoutStream.Read(output)
+	close(clientStdoutReadDone)
+	if err != nil {
+		t.Fatalf("error reading from stdout stream: %v", err)
+	}
+	if e, a := expectedStdout, string(output[0:n]); e != a {
+		t.Fatalf("stdout: expected '%v', got '%v'", e, a)
+	}
+
+	<-debugFuncDone
+}
+
 func TestServePortForwardIdleTimeout(t *testing.T) {
 	fw := newServerTest()
 
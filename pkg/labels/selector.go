@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"k8s.io/kubernetes/pkg/util/sets"
@@ -70,6 +71,8 @@ const (
 	NotEqualsOperator    Operator = "!="
 	NotInOperator        Operator = "notin"
 	ExistsOperator       Operator = "exists"
+	GreaterThanOperator  Operator = "gt"
+	LessThanOperator     Operator = "lt"
 )
 
 //LabelSelector is a list of Requirements.
@@ -97,11 +100,13 @@ type Requirement struct {
 
 // NewRequirement is the constructor for a Requirement.
 // If any of these rules is violated, an error is returned:
-// (1) The operator can only be In, NotIn, Equals, DoubleEquals, NotEquals, Exists, or DoesNotExist.
+// (1) The operator can only be In, NotIn, Equals, DoubleEquals, NotEquals, Exists, DoesNotExist,
+//     GreaterThan or LessThan.
 // (2) If the operator is In or NotIn, the values set must be non-empty.
 // (3) If the operator is Equals, DoubleEquals, or NotEquals, the values set must contain one value.
 // (4) If the operator is Exists or DoesNotExist, the value set must be empty.
-// (5) The key is invalid due to its length, or sequence
+// (5) If the operator is GreaterThan or LessThan, the values set must contain one integer value.
+// (6) The key is invalid due to its length, or sequence
 //     of characters. See validateLabelKey for more details.
 //
 // The empty string is a valid value in the input values set.
@@ -122,6 +127,15 @@ func NewRequirement(key string, op Operator, vals sets.String) (*Requirement, er
 		if len(vals) != 0 {
 			return nil, fmt.Errorf("values set must be empty for exists and does not exist")
 		}
+	case GreaterThanOperator, LessThanOperator:
+		if len(vals) != 1 {
+			return nil, fmt.Errorf("for 'gt', 'lt' operators, exactly one value is required")
+		}
+		for v := range vals {
+			if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+				return nil, fmt.Errorf("for 'gt', 'lt' operators, the value must be an integer")
+			}
+		}
 	default:
 		return nil, fmt.Errorf("operator '%v' is not recognized", op)
 	}
@@ -143,6 +157,9 @@ func NewRequirement(key string, op Operator, vals sets.String) (*Requirement, er
 //     Labels' value for that key is not in Requirement's value set.
 // (4) The operator is DoesNotExist or NotIn and Labels does not have the
 //     Requirement's key.
+// (5) The operator is GreaterThan or LessThan, Labels has the Requirement's
+//     key and Labels' value for that key, parsed as an integer, is greater
+//     than or less than the Requirement's single value.
 func (r *Requirement) Matches(ls Labels) bool {
 	switch r.operator {
 	case InOperator, EqualsOperator, DoubleEqualsOperator:
@@ -159,6 +176,23 @@ func (r *Requirement) Matches(ls Labels) bool {
 		return ls.Has(r.key)
 	case DoesNotExistOperator:
 		return !ls.Has(r.key)
+	case GreaterThanOperator, LessThanOperator:
+		if !ls.Has(r.key) {
+			return false
+		}
+		lsValue, err := strconv.ParseInt(ls.Get(r.key), 10, 64)
+		if err != nil {
+			return false
+		}
+		// NewRequirement guarantees a single integer value.
+		rValue, err := strconv.ParseInt(r.strValues.List()[0], 10, 64)
+		if err != nil {
+			return false
+		}
+		if r.operator == GreaterThanOperator {
+			return lsValue > rValue
+		}
+		return lsValue < rValue
 	default:
 		return false
 	}
@@ -207,6 +241,10 @@ func (r *Requirement) String() string {
 		buffer.WriteString(" in ")
 	case NotInOperator:
 		buffer.WriteString(" notin ")
+	case GreaterThanOperator:
+		buffer.WriteString(">")
+	case LessThanOperator:
+		buffer.WriteString("<")
 	case ExistsOperator, DoesNotExistOperator:
 		return buffer.String()
 	}
@@ -273,8 +311,10 @@ const (
 	DoesNotExistToken
 	DoubleEqualsToken
 	EqualsToken
+	GreaterThanToken
 	IdentifierToken // to represent keys and values
 	InToken
+	LessThanToken
 	NotEqualsToken
 	NotInToken
 	OpenParToken
@@ -288,7 +328,9 @@ var string2token = map[string]Token{
 	"!":     DoesNotExistToken,
 	"==":    DoubleEqualsToken,
 	"=":     EqualsToken,
+	">":     GreaterThanToken,
 	"in":    InToken,
+	"<":     LessThanToken,
 	"!=":    NotEqualsToken,
 	"notin": NotInToken,
 	"(":     OpenParToken,
@@ -308,7 +350,7 @@ func isWhitespace(ch byte) bool {
 // isSpecialSymbol detect if the character ch can be an operator
 func isSpecialSymbol(ch byte) bool {
 	switch ch {
-	case '=', '!', '(', ')', ',':
+	case '=', '!', '(', ')', ',', '>', '<':
 		return true
 	}
 	return false
@@ -362,7 +404,7 @@ IdentifierLoop:
 }
 
 // scanSpecialSymbol scans string starting with special symbol.
-// special symbol identify non literal operators. "!=", "==", "="
+// special symbol identify non literal operators. "!=", "==", "=", ">", "<"
 func (l *Lexer) scanSpecialSymbol() (Token, string) {
 	lastScannedItem := ScannedItem{}
 	var buffer []byte
@@ -522,7 +564,7 @@ func (p *Parser) parseRequirement() (*Requirement, error) {
 	switch operator {
 	case InOperator, NotInOperator:
 		values, err = p.parseValues()
-	case EqualsOperator, DoubleEqualsOperator, NotEqualsOperator:
+	case EqualsOperator, DoubleEqualsOperator, NotEqualsOperator, GreaterThanOperator, LessThanOperator:
 		values, err = p.parseExactValue()
 	}
 	if err != nil {
@@ -533,7 +575,7 @@ func (p *Parser) parseRequirement() (*Requirement, error) {
 }
 
 // parseKeyAndInferOperator parse literals.
-// in case of no operator '!, in, notin, ==, =, !=' are found
+// in case of no operator '!, in, notin, ==, =, !=, >, <' are found
 // the 'exists' operator is inferred
 func (p *Parser) parseKeyAndInferOperator() (string, Operator, error) {
 	var operator Operator
@@ -573,8 +615,12 @@ func (p *Parser) parseOperator() (op Operator, err error) {
 		op = NotInOperator
 	case NotEqualsToken:
 		op = NotEqualsOperator
+	case GreaterThanToken:
+		op = GreaterThanOperator
+	case LessThanToken:
+		op = LessThanOperator
 	default:
-		return "", fmt.Errorf("found '%s', expected: '=', '!=', '==', 'in', notin'", lit)
+		return "", fmt.Errorf("found '%s', expected: '=', '!=', '==', 'in', notin', '>', '<'", lit)
 	}
 	return op, nil
 }
@@ -663,7 +709,7 @@ func (p *Parser) parseExactValue() (sets.String, error) {
 // The input will cause an error if it does not follow this form:
 //
 // <selector-syntax> ::= <requirement> | <requirement> "," <selector-syntax> ]
-// <requirement> ::= [!] KEY [ <set-based-restriction> | <exact-match-restriction> ]
+// <requirement> ::= [!] KEY [ <set-based-restriction> | <exact-match-restriction> | <numeric-restriction> ]
 // <set-based-restriction> ::= "" | <inclusion-exclusion> <value-set>
 // <inclusion-exclusion> ::= <inclusion> | <exclusion>
 //           <exclusion> ::= "notin"
@@ -671,6 +717,7 @@ func (p *Parser) parseExactValue() (sets.String, error) {
 //           <value-set> ::= "(" <values> ")"
 //              <values> ::= VALUE | VALUE "," <values>
 // <exact-match-restriction> ::= ["="|"=="|"!="] VALUE
+// <numeric-restriction> ::= [">"|"<"] INTEGER
 // KEY is a sequence of one or more characters following [ DNS_SUBDOMAIN "/" ] DNS_LABEL
 // VALUE is a sequence of zero or more characters "([A-Za-z0-9_-\.])". Max length is 64 character.
 // Delimiter is white space: (' ', '\t')
@@ -686,6 +733,8 @@ func (p *Parser) parseExactValue() (sets.String, error) {
 //  (4) A requirement with just a KEY - as in "y" above - denotes that
 //      the KEY exists and can be any VALUE.
 //  (5) A requirement with just !KEY requires that the KEY not exist.
+//  (6) A requirement with ">" or "<" requires that the KEY exists and that
+//      its VALUE, parsed as an integer, is greater or less than INTEGER.
 //
 func Parse(selector string) (Selector, error) {
 	p := &Parser{l: &Lexer{s: selector, pos: 0}}
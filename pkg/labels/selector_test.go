@@ -34,11 +34,17 @@ func TestSelectorParse(t *testing.T) {
 		"x=,z= ",
 		"x= ,z= ",
 		"!x",
+		"x>1",
+		"x<10,y>2",
 	}
 	testBadStrings := []string{
 		"x=a||y=b",
 		"x==a==b",
 		"!x=a",
+		"x>a",
+		"x<",
+		"x>=1",
+		"x<(1)",
 	}
 	for _, test := range testGoodStrings {
 		lq, err := Parse(test)
@@ -189,6 +195,8 @@ func TestLexer(t *testing.T) {
 		{"!=", NotEqualsToken},
 		{"(", OpenParToken},
 		{")", ClosedParToken},
+		{">", GreaterThanToken},
+		{"<", LessThanToken},
 		//Non-"special" characters are considered part of an identifier
 		{"~", IdentifierToken},
 		{"||", IdentifierToken},
@@ -226,6 +234,7 @@ func TestLexerSequence(t *testing.T) {
 		{"()", []Token{OpenParToken, ClosedParToken}},
 		{"x in (),y", []Token{IdentifierToken, InToken, OpenParToken, ClosedParToken, CommaToken, IdentifierToken}},
 		{"== != (), = notin", []Token{DoubleEqualsToken, NotEqualsToken, OpenParToken, ClosedParToken, CommaToken, EqualsToken, NotInToken}},
+		{"key>1,key<2", []Token{IdentifierToken, GreaterThanToken, IdentifierToken, CommaToken, IdentifierToken, LessThanToken, IdentifierToken}},
 	}
 	for _, v := range testcases {
 		var literals []string
@@ -299,6 +308,11 @@ func TestRequirementConstructor(t *testing.T) {
 		{"x", DoesNotExistOperator, nil, true},
 		{"1foo", InOperator, sets.NewString("bar"), true},
 		{"1234", InOperator, sets.NewString("bar"), true},
+		{"x", GreaterThanOperator, sets.NewString("1"), true},
+		{"x", LessThanOperator, sets.NewString("1"), true},
+		{"x", GreaterThanOperator, nil, false},
+		{"x", LessThanOperator, sets.NewString("1", "2"), false},
+		{"x", GreaterThanOperator, sets.NewString("a"), false},
 		{strings.Repeat("a", 254), ExistsOperator, nil, false}, //breaks DNS rule that len(key) <= 253
 	}
 	for _, rc := range requirementConstructorTests {
@@ -342,6 +356,10 @@ func TestToString(t *testing.T) {
 			getRequirement("z", NotEqualsOperator, sets.NewString("a"), t),
 			getRequirement("z", ExistsOperator, nil, t)},
 			"x=abc,y==jkl,z!=a,z", true},
+		{&LabelSelector{
+			getRequirement("x", GreaterThanOperator, sets.NewString("1"), t),
+			getRequirement("y", LessThanOperator, sets.NewString("20"), t)},
+			"x>1,y<20", true},
 	}
 	for _, ts := range toStringTests {
 		if out := ts.In.String(); out == "" && ts.Valid {
@@ -349,6 +367,15 @@ func TestToString(t *testing.T) {
 		} else if out != ts.Out {
 			t.Errorf("%+v.String() => '%v' want '%v'", ts.In, out, ts.Out)
 		}
+		if !ts.Valid {
+			continue
+		}
+		// The string form must parse back to the same selector.
+		if parsed, err := Parse(ts.In.String()); err != nil {
+			t.Errorf("Parse(%q) => %v, expected no error", ts.In.String(), err)
+		} else if out := parsed.String(); out != ts.Out {
+			t.Errorf("Parse(%q).String() => '%v' want '%v'", ts.In.String(), out, ts.Out)
+		}
 	}
 }
 
@@ -385,6 +412,18 @@ func TestRequirementLabelSelectorMatching(t *testing.T) {
 		{Set{"y": "baz"}, &LabelSelector{
 			getRequirement("x", InOperator, sets.NewString(""), t),
 		}, false},
+		{Set{"z": "2"}, &LabelSelector{
+			getRequirement("z", GreaterThanOperator, sets.NewString("1"), t),
+		}, true},
+		{Set{"z": "v2"}, &LabelSelector{
+			getRequirement("z", GreaterThanOperator, sets.NewString("1"), t),
+		}, false},
+		{Set{"z": "2"}, &LabelSelector{
+			getRequirement("z", LessThanOperator, sets.NewString("1"), t),
+		}, false},
+		{Set{"y": "2"}, &LabelSelector{
+			getRequirement("z", LessThanOperator, sets.NewString("1"), t),
+		}, false},
 	}
 	for _, lsm := range labelSelectorMatchingTests {
 		if match := lsm.Sel.Matches(lsm.Set); match != lsm.Match {
@@ -416,6 +455,12 @@ func TestSetSelectorParser(t *testing.T) {
 		{"foo  in	 (abc)", LabelSelector{
 			getRequirement("foo", InOperator, sets.NewString("abc"), t),
 		}, true, true},
+		{"x>1", LabelSelector{
+			getRequirement("x", GreaterThanOperator, sets.NewString("1"), t),
+		}, true, true},
+		{"x < 1", LabelSelector{
+			getRequirement("x", LessThanOperator, sets.NewString("1"), t),
+		}, true, true},
 		{"x notin\n (abc)", LabelSelector{
 			getRequirement("x", NotInOperator, sets.NewString("abc"), t),
 		}, true, true},
@@ -37,14 +37,23 @@ import (
 	apiutil "k8s.io/kubernetes/pkg/api/util"
 	"k8s.io/kubernetes/pkg/api/v1"
 	expapi "k8s.io/kubernetes/pkg/apis/extensions"
+	rbacvalidation "k8s.io/kubernetes/pkg/apis/rbac/validation"
 	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/apiserver/audit"
 	"k8s.io/kubernetes/pkg/auth/authenticator"
 	"k8s.io/kubernetes/pkg/auth/authorizer"
 	"k8s.io/kubernetes/pkg/auth/handlers"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/healthz"
 	"k8s.io/kubernetes/pkg/master/ports"
+	"k8s.io/kubernetes/pkg/registry/clusterrole"
+	clusterroleetcd "k8s.io/kubernetes/pkg/registry/clusterrole/etcd"
+	clusterrolepolicybased "k8s.io/kubernetes/pkg/registry/clusterrole/policybased"
+	"k8s.io/kubernetes/pkg/registry/clusterrolebinding"
+	clusterrolebindingetcd "k8s.io/kubernetes/pkg/registry/clusterrolebinding/etcd"
+	clusterrolebindingpolicybased "k8s.io/kubernetes/pkg/registry/clusterrolebinding/policybased"
 	"k8s.io/kubernetes/pkg/registry/componentstatus"
+	configmapetcd "k8s.io/kubernetes/pkg/registry/configmap/etcd"
 	controlleretcd "k8s.io/kubernetes/pkg/registry/controller/etcd"
 	deploymentetcd "k8s.io/kubernetes/pkg/registry/deployment/etcd"
 	"k8s.io/kubernetes/pkg/registry/endpoint"
@@ -63,8 +72,15 @@ import (
 	pvetcd "k8s.io/kubernetes/pkg/registry/persistentvolume/etcd"
 	pvcetcd "k8s.io/kubernetes/pkg/registry/persistentvolumeclaim/etcd"
 	podetcd "k8s.io/kubernetes/pkg/registry/pod/etcd"
+	poddisruptionbudgetetcd "k8s.io/kubernetes/pkg/registry/poddisruptionbudget/etcd"
 	podtemplateetcd "k8s.io/kubernetes/pkg/registry/podtemplate/etcd"
 	resourcequotaetcd "k8s.io/kubernetes/pkg/registry/resourcequota/etcd"
+	"k8s.io/kubernetes/pkg/registry/role"
+	roleetcd "k8s.io/kubernetes/pkg/registry/role/etcd"
+	rolepolicybased "k8s.io/kubernetes/pkg/registry/role/policybased"
+	"k8s.io/kubernetes/pkg/registry/rolebinding"
+	rolebindingetcd "k8s.io/kubernetes/pkg/registry/rolebinding/etcd"
+	rolebindingpolicybased "k8s.io/kubernetes/pkg/registry/rolebinding/policybased"
 	secretetcd "k8s.io/kubernetes/pkg/registry/secret/etcd"
 	"k8s.io/kubernetes/pkg/registry/service"
 	etcdallocator "k8s.io/kubernetes/pkg/registry/service/allocator/etcd"
@@ -90,6 +106,7 @@ import (
 	"github.com/golang/glog"
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/net/context"
+	"gopkg.in/natefinch/lumberjack.v2"
 	"k8s.io/kubernetes/pkg/registry/service/allocator"
 	"k8s.io/kubernetes/pkg/registry/service/portallocator"
 )
@@ -132,7 +149,8 @@ func (s *StorageDestinations) AddStorageOverride(group, resource string, overrid
 	s.APIGroups[group].Overrides[resource] = override
 }
 
-func (s *StorageDestinations) get(group, resource string) storage.Interface {
+// Get returns the storage for the given resource of an API group, honoring per-resource overrides.
+func (s *StorageDestinations) Get(group, resource string) storage.Interface {
 	apigroup, ok := s.APIGroups[group]
 	if !ok {
 		glog.Errorf("No storage defined for API group: '%s'", apigroup)
@@ -205,6 +223,18 @@ type Config struct {
 	AdmissionControl       admission.Interface
 	MasterServiceNamespace string
 
+	// User which can bypass the privilege escalation checks on roles and bindings of the rbac API group.
+	AuthorizerRBACSuperUser string
+
+	// If specified, requests to the secure port are recorded to this file, one JSON record per line.
+	AuditLogPath string
+	// The maximum number of days to retain old audit log files.
+	AuditLogMaxAge int
+	// The maximum number of old audit log files to retain.
+	AuditLogMaxBackups int
+	// The maximum size in megabytes of the audit log file before it gets rotated.
+	AuditLogMaxSize int
+
 	// Map requests to contexts. Exported so downstream consumers can provider their own mappers
 	RequestContextMapper api.RequestContextMapper
 
@@ -545,7 +575,7 @@ func (m *Master) init(c *Config) {
 	healthzChecks := []healthz.HealthzChecker{}
 
 	storageDecorator := c.storageDecorator()
-	dbClient := func(resource string) storage.Interface { return c.StorageDestinations.get("", resource) }
+	dbClient := func(resource string) storage.Interface { return c.StorageDestinations.Get("", resource) }
 	podStorage := podetcd.NewStorage(dbClient("pods"), storageDecorator, c.KubeletClient, m.proxyTransport)
 
 	podTemplateStorage := podtemplateetcd.NewREST(dbClient("podTemplates"), storageDecorator)
@@ -555,6 +585,7 @@ func (m *Master) init(c *Config) {
 
 	resourceQuotaStorage, resourceQuotaStatusStorage := resourcequotaetcd.NewREST(dbClient("resourceQuotas"), storageDecorator)
 	secretStorage := secretetcd.NewREST(dbClient("secrets"), storageDecorator)
+	configMapStorage := configmapetcd.NewREST(dbClient("configMaps"), storageDecorator)
 	serviceAccountStorage := serviceaccountetcd.NewREST(dbClient("serviceAccounts"), storageDecorator)
 	persistentVolumeStorage, persistentVolumeStatusStorage := pvetcd.NewREST(dbClient("persistentVolumes"), storageDecorator)
 	persistentVolumeClaimStorage, persistentVolumeClaimStatusStorage := pvcetcd.NewREST(dbClient("persistentVolumeClaims"), storageDecorator)
@@ -595,12 +626,14 @@ func (m *Master) init(c *Config) {
 	m.storage = map[string]rest.Storage{
 		"pods":             podStorage.Pod,
 		"pods/attach":      podStorage.Attach,
+		"pods/debug":       podStorage.Debug,
 		"pods/status":      podStorage.Status,
 		"pods/log":         podStorage.Log,
 		"pods/exec":        podStorage.Exec,
 		"pods/portforward": podStorage.PortForward,
 		"pods/proxy":       podStorage.Proxy,
 		"pods/binding":     podStorage.Binding,
+		"pods/eviction":    podStorage.Eviction,
 		"bindings":         podStorage.Binding,
 
 		"podTemplates": podTemplateStorage,
@@ -620,6 +653,7 @@ func (m *Master) init(c *Config) {
 		"namespaces/status":             namespaceStatusStorage,
 		"namespaces/finalize":           namespaceFinalizeStorage,
 		"secrets":                       secretStorage,
+		"configMaps":                    configMapStorage,
 		"serviceAccounts":               serviceAccountStorage,
 		"persistentVolumes":             persistentVolumeStorage,
 		"persistentVolumes/status":      persistentVolumeStatusStorage,
@@ -687,6 +721,37 @@ func (m *Master) init(c *Config) {
 		apiserver.InstallServiceErrorHandler(m.handlerContainer, m.newRequestInfoResolver(), []string{expVersion.GroupVersion.String()})
 	}
 
+	// Install rbac if storage is configured for it, unless disabled.
+	if _, found := c.StorageDestinations.APIGroups["rbac"]; found && !m.apiGroupVersionOverrides["rbac/v1alpha1"].Disable {
+		rbacVersion := m.rbac(c)
+
+		if err := rbacVersion.InstallREST(m.handlerContainer); err != nil {
+			glog.Fatalf("Unable to setup rbac api: %v", err)
+		}
+		g, err := latest.Group("rbac")
+		if err != nil {
+			glog.Fatalf("Unable to setup rbac api: %v", err)
+		}
+		rbacAPIVersions := []unversioned.GroupVersionForDiscovery{
+			{
+				GroupVersion: rbacVersion.GroupVersion.String(),
+				Version:      rbacVersion.GroupVersion.Version,
+			},
+		}
+		storageVersion, found := c.StorageVersions[g.Group]
+		if !found {
+			glog.Fatalf("Couldn't find storage version of group %v", g.Group)
+		}
+		group := unversioned.APIGroup{
+			Name:             g.Group,
+			Versions:         rbacAPIVersions,
+			PreferredVersion: unversioned.GroupVersionForDiscovery{GroupVersion: storageVersion, Version: apiutil.GetVersion(storageVersion)},
+		}
+		apiserver.AddGroupWebService(m.handlerContainer, c.APIGroupPrefix+"/"+g.Group, group)
+		allGroups = append(allGroups, group)
+		apiserver.InstallServiceErrorHandler(m.handlerContainer, m.newRequestInfoResolver(), []string{rbacVersion.GroupVersion.String()})
+	}
+
 	// This should be done after all groups are registered
 	// TODO: replace the hardcoded "apis".
 	apiserver.AddApisWebService(m.handlerContainer, "/apis", allGroups)
@@ -720,6 +785,15 @@ func (m *Master) init(c *Config) {
 
 	attributeGetter := apiserver.NewRequestAttributeGetter(m.requestContextMapper, m.newRequestInfoResolver())
 	handler = apiserver.WithAuthorizationCheck(handler, attributeGetter, m.authorizer)
+	handler = apiserver.WithImpersonation(handler, m.requestContextMapper, m.authorizer)
+	if len(c.AuditLogPath) != 0 {
+		handler = audit.WithAudit(handler, m.requestContextMapper, attributeGetter, &lumberjack.Logger{
+			Filename:   c.AuditLogPath,
+			MaxAge:     c.AuditLogMaxAge,
+			MaxBackups: c.AuditLogMaxBackups,
+			MaxSize:    c.AuditLogMaxSize,
+		})
+	}
 
 	// Install Authenticator
 	if c.Authenticator != nil {
@@ -1059,7 +1133,7 @@ func (m *Master) experimental(c *Config) *apiserver.APIGroupVersion {
 	}
 	storageDecorator := c.storageDecorator()
 	dbClient := func(resource string) storage.Interface {
-		return c.StorageDestinations.get("extensions", resource)
+		return c.StorageDestinations.Get("extensions", resource)
 	}
 
 	storage := map[string]rest.Storage{}
@@ -1067,7 +1141,7 @@ func (m *Master) experimental(c *Config) *apiserver.APIGroupVersion {
 		autoscalerStorage, autoscalerStatusStorage := horizontalpodautoscaleretcd.NewREST(dbClient("horizontalpodautoscalers"), storageDecorator)
 		storage["horizontalpodautoscalers"] = autoscalerStorage
 		storage["horizontalpodautoscalers/status"] = autoscalerStatusStorage
-		controllerStorage := expcontrolleretcd.NewStorage(c.StorageDestinations.get("", "replicationControllers"), storageDecorator)
+		controllerStorage := expcontrolleretcd.NewStorage(c.StorageDestinations.Get("", "replicationControllers"), storageDecorator)
 		storage["replicationcontrollers"] = controllerStorage.ReplicationController
 		storage["replicationcontrollers/scale"] = controllerStorage.Scale
 	}
@@ -1098,6 +1172,7 @@ func (m *Master) experimental(c *Config) *apiserver.APIGroupVersion {
 		storage["deployments"] = deploymentStorage.Deployment
 		storage["deployments/status"] = deploymentStorage.Status
 		storage["deployments/scale"] = deploymentStorage.Scale
+		storage["deployments/rollback"] = deploymentStorage.Rollback
 	}
 	if isEnabled("jobs") {
 		jobStorage, jobStatusStorage := jobetcd.NewREST(dbClient("jobs"), storageDecorator)
@@ -1109,6 +1184,15 @@ func (m *Master) experimental(c *Config) *apiserver.APIGroupVersion {
 		storage["ingresses"] = ingressStorage
 		storage["ingresses/status"] = ingressStatusStorage
 	}
+	if isEnabled("poddisruptionbudgets") {
+		podDisruptionBudgetStorage, podDisruptionBudgetStatusStorage := poddisruptionbudgetetcd.NewREST(dbClient("poddisruptionbudgets"), storageDecorator)
+		storage["poddisruptionbudgets"] = podDisruptionBudgetStorage
+		storage["poddisruptionbudgets/status"] = podDisruptionBudgetStatusStorage
+		// Evictions of pods covered by a budget must respect it.
+		if evictionStorage, ok := m.storage["pods/eviction"].(*podetcd.EvictionREST); ok {
+			evictionStorage.SetPodDisruptionBudgetStorage(podDisruptionBudgetStorage, podDisruptionBudgetStatusStorage)
+		}
+	}
 
 	extensionsGroup := latest.GroupOrDie("extensions")
 	serverGroupVersion := unversioned.ParseGroupVersionOrDie(latest.GroupOrDie("").GroupVersion)
@@ -1135,6 +1219,58 @@ func (m *Master) experimental(c *Config) *apiserver.APIGroupVersion {
 	}
 }
 
+// rbac returns the resources and codec for the rbac API group.
+func (m *Master) rbac(c *Config) *apiserver.APIGroupVersion {
+	storageDecorator := c.storageDecorator()
+	dbClient := func(resource string) storage.Interface {
+		return c.StorageDestinations.Get("rbac", resource)
+	}
+
+	roleStorage := roleetcd.NewREST(dbClient("roles"), storageDecorator)
+	roleBindingStorage := rolebindingetcd.NewREST(dbClient("rolebindings"), storageDecorator)
+	clusterRoleStorage := clusterroleetcd.NewREST(dbClient("clusterroles"), storageDecorator)
+	clusterRoleBindingStorage := clusterrolebindingetcd.NewREST(dbClient("clusterrolebindings"), storageDecorator)
+
+	// Roles and bindings may only grant permissions the requesting user already has.
+	ruleResolver := rbacvalidation.NewDefaultRuleResolver(
+		role.NewRegistry(roleStorage),
+		rolebinding.NewRegistry(roleBindingStorage),
+		clusterrole.NewRegistry(clusterRoleStorage),
+		clusterrolebinding.NewRegistry(clusterRoleBindingStorage),
+	)
+
+	storage := map[string]rest.Storage{
+		"roles":               rolepolicybased.NewStorage(roleStorage, ruleResolver, c.AuthorizerRBACSuperUser),
+		"rolebindings":        rolebindingpolicybased.NewStorage(roleBindingStorage, ruleResolver, c.AuthorizerRBACSuperUser),
+		"clusterroles":        clusterrolepolicybased.NewStorage(clusterRoleStorage, ruleResolver, c.AuthorizerRBACSuperUser),
+		"clusterrolebindings": clusterrolebindingpolicybased.NewStorage(clusterRoleBindingStorage, ruleResolver, c.AuthorizerRBACSuperUser),
+	}
+
+	rbacGroup := latest.GroupOrDie("rbac")
+	serverGroupVersion := unversioned.ParseGroupVersionOrDie(latest.GroupOrDie("").GroupVersion)
+
+	return &apiserver.APIGroupVersion{
+		Root:                m.apiGroupPrefix,
+		RequestInfoResolver: m.newRequestInfoResolver(),
+
+		Creater:   api.Scheme,
+		Convertor: api.Scheme,
+		Typer:     api.Scheme,
+
+		Mapper:             rbacGroup.RESTMapper,
+		Codec:              rbacGroup.Codec,
+		Linker:             rbacGroup.SelfLinker,
+		Storage:            storage,
+		GroupVersion:       unversioned.ParseGroupVersionOrDie(rbacGroup.GroupVersion),
+		ServerGroupVersion: &serverGroupVersion,
+
+		Admit:   m.admissionControl,
+		Context: m.requestContextMapper,
+
+		MinRequestTimeout: m.minRequestTimeout,
+	}
+}
+
 // findExternalAddress returns ExternalIP of provided node with fallback to LegacyHostIP.
 func findExternalAddress(node *api.Node) (string, error) {
 	var fallback string
@@ -0,0 +1,71 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package policybased implements a standard storage for ClusterRole that prevents privilege escalation.
+package policybased
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/apis/rbac"
+	"k8s.io/kubernetes/pkg/apis/rbac/validation"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// Storage wraps the storage for ClusterRole objects and rejects creates and updates of
+// ClusterRoles with rules granting more permissions than the requesting
+// user already has.
+type Storage struct {
+	rest.StandardStorage
+
+	ruleResolver validation.AuthorizationRuleResolver
+
+	// user which skips privilege escalation checks
+	superUser string
+}
+
+func NewStorage(s rest.StandardStorage, ruleResolver validation.AuthorizationRuleResolver, superUser string) *Storage {
+	return &Storage{s, ruleResolver, superUser}
+}
+
+func (s *Storage) Create(ctx api.Context, obj runtime.Object) (runtime.Object, error) {
+	if err := s.confirmNoEscalation(ctx, obj); err != nil {
+		return nil, err
+	}
+	return s.StandardStorage.Create(ctx, obj)
+}
+
+func (s *Storage) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	if err := s.confirmNoEscalation(ctx, obj); err != nil {
+		return nil, false, err
+	}
+	return s.StandardStorage.Update(ctx, obj)
+}
+
+func (s *Storage) confirmNoEscalation(ctx api.Context, obj runtime.Object) error {
+	if user, ok := api.UserFrom(ctx); ok && len(s.superUser) > 0 && user.GetName() == s.superUser {
+		return nil
+	}
+	clusterrole, ok := obj.(*rbac.ClusterRole)
+	if !ok {
+		return errors.NewBadRequest("not a ClusterRole")
+	}
+	if err := validation.ConfirmNoEscalation(ctx, s.ruleResolver, clusterrole.Rules); err != nil {
+		return errors.NewForbidden("ClusterRole", clusterrole.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/rbac"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	"k8s.io/kubernetes/pkg/registry/registrytest"
+	"k8s.io/kubernetes/pkg/runtime"
+	etcdtesting "k8s.io/kubernetes/pkg/storage/etcd/testing"
+)
+
+func newStorage(t *testing.T) (*REST, *etcdtesting.EtcdTestServer) {
+	etcdStorage, server := registrytest.NewEtcdStorage(t, "rbac")
+	return NewREST(etcdStorage, generic.UndecoratedStorage), server
+}
+
+func validNewClusterRoleBinding() *rbac.ClusterRoleBinding {
+	return &rbac.ClusterRoleBinding{
+		ObjectMeta: api.ObjectMeta{
+			Name:   "foo",
+			Labels: map[string]string{"a": "b"},
+		},
+		Subjects: []rbac.Subject{{Kind: rbac.UserKind, Name: "bob"}},
+		RoleRef:  rbac.RoleRef{Kind: rbac.ClusterRoleKind, Name: "pod-reader"},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd).ClusterScope()
+	clusterRoleBinding := validNewClusterRoleBinding()
+	clusterRoleBinding.ObjectMeta = api.ObjectMeta{GenerateName: "foo-"}
+	test.TestCreate(
+		// valid
+		clusterRoleBinding,
+		// invalid
+		&rbac.ClusterRoleBinding{
+			ObjectMeta: api.ObjectMeta{Name: "bar"},
+			Subjects:   []rbac.Subject{{Kind: rbac.UserKind, Name: "bob"}},
+			RoleRef:    rbac.RoleRef{Kind: rbac.RoleKind, Name: "pod-reader"},
+		},
+	)
+}
+
+func TestUpdate(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd).ClusterScope()
+	test.TestUpdate(
+		// valid
+		validNewClusterRoleBinding(),
+		// updateFunc
+		func(obj runtime.Object) runtime.Object {
+			object := obj.(*rbac.ClusterRoleBinding)
+			object.Subjects = append(object.Subjects, rbac.Subject{Kind: rbac.GroupKind, Name: "editors"})
+			return object
+		},
+		// invalid updateFunc
+		func(obj runtime.Object) runtime.Object {
+			object := obj.(*rbac.ClusterRoleBinding)
+			object.RoleRef = rbac.RoleRef{Kind: rbac.ClusterRoleKind, Name: "other"}
+			return object
+		},
+	)
+}
+
+func TestDelete(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd).ClusterScope()
+	test.TestDelete(validNewClusterRoleBinding())
+}
+
+func TestGet(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd).ClusterScope()
+	test.TestGet(validNewClusterRoleBinding())
+}
+
+func TestList(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd).ClusterScope()
+	test.TestList(validNewClusterRoleBinding())
+}
+
+func TestWatch(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd).ClusterScope()
+	test.TestWatch(
+		validNewClusterRoleBinding(),
+		// matching labels
+		[]labels.Set{
+			{"a": "b"},
+		},
+		// not matching labels
+		[]labels.Set{
+			{"a": "c"},
+			{"foo": "bar"},
+		},
+		// matching fields
+		[]fields.Set{
+			{"metadata.name": "foo"},
+		},
+		// not matching fields
+		[]fields.Set{
+			{"metadata.name": "bar"},
+			{"name": "foo"},
+		},
+	)
+}
@@ -21,7 +21,9 @@ import (
 
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
+	etcderr "k8s.io/kubernetes/pkg/api/errors/etcd"
 	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/apis/extensions"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
@@ -39,6 +41,7 @@ type DeploymentStorage struct {
 	Deployment *REST
 	Status     *StatusREST
 	Scale      *ScaleREST
+	Rollback   *RollbackREST
 }
 
 func NewStorage(s storage.Interface, storageDecorator generic.StorageDecorator) DeploymentStorage {
@@ -49,6 +52,7 @@ func NewStorage(s storage.Interface, storageDecorator generic.StorageDecorator)
 		Deployment: deploymentRest,
 		Status:     deploymentStatusRest,
 		Scale:      &ScaleREST{registry: &deploymentRegistry},
+		Rollback:   &RollbackREST{store: deploymentRest.Etcd},
 	}
 }
 
@@ -115,6 +119,86 @@ func (r *StatusREST) Update(ctx api.Context, obj runtime.Object) (runtime.Object
 	return r.store.Update(ctx, obj)
 }
 
+// RollbackREST implements the REST endpoint for initiating the rollback of a deployment
+type RollbackREST struct {
+	store *etcdgeneric.Etcd
+}
+
+// New creates a rollback
+func (r *RollbackREST) New() runtime.Object {
+	return &extensions.DeploymentRollback{}
+}
+
+var _ = rest.Creater(&RollbackREST{})
+
+// Create records the requested rollback in the deployment spec. The deployment
+// controller performs the actual rollback. Paused deployments are not rolled
+// back, so the rollback is rejected until the deployment is resumed.
+func (r *RollbackREST) Create(ctx api.Context, obj runtime.Object) (out runtime.Object, err error) {
+	rollback, ok := obj.(*extensions.DeploymentRollback)
+	if !ok {
+		return nil, errors.NewBadRequest(fmt.Sprintf("wrong object passed to rollback: %v", obj))
+	}
+
+	if errs := extvalidation.ValidateDeploymentRollback(rollback); len(errs) > 0 {
+		return nil, errors.NewInvalid("DeploymentRollback", rollback.Name, errs)
+	}
+
+	// Update the Deployment with information in DeploymentRollback to trigger rollback
+	err = r.rollbackDeployment(ctx, rollback.Name, &rollback.RollbackTo, rollback.UpdatedAnnotations)
+	out = &unversioned.Status{Status: unversioned.StatusSuccess}
+	return
+}
+
+func (r *RollbackREST) rollbackDeployment(ctx api.Context, deploymentID string, config *extensions.RollbackConfig, annotations map[string]string) (err error) {
+	if _, err = r.setDeploymentRollback(ctx, deploymentID, config, annotations); err != nil {
+		err = etcderr.InterpretGetError(err, "deployment", deploymentID)
+		err = etcderr.InterpretUpdateError(err, "deployment", deploymentID)
+		if _, ok := err.(*errors.StatusError); !ok {
+			err = errors.NewConflict("deployments/rollback", deploymentID, err)
+		}
+	}
+	return
+}
+
+// setDeploymentRollback sets the rollback config of the given deployment and
+// merges the provided annotations with those of the deployment.
+func (r *RollbackREST) setDeploymentRollback(ctx api.Context, deploymentID string, config *extensions.RollbackConfig, annotations map[string]string) (finalDeployment *extensions.Deployment, err error) {
+	dKey, err := r.store.KeyFunc(ctx, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	rollback := func(obj runtime.Object) (runtime.Object, error) {
+		d, ok := obj.(*extensions.Deployment)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object: %#v", obj)
+		}
+		if d.Spec.Paused {
+			return nil, errors.NewBadRequest(fmt.Sprintf("cannot roll back deployment %q: deployment is paused, resume it first", d.Name))
+		}
+		if d.Annotations == nil {
+			d.Annotations = make(map[string]string)
+		}
+		for k, v := range annotations {
+			d.Annotations[k] = v
+		}
+		d.Spec.RollbackTo = config
+		finalDeployment = d
+		return d, nil
+	}
+	if api.IsDryRun(ctx) {
+		// Check that the deployment exists without recording the rollback.
+		d := &extensions.Deployment{}
+		if err := r.store.Storage.Get(ctx, dKey, d, false); err != nil {
+			return nil, err
+		}
+		_, err = rollback(d)
+		return finalDeployment, err
+	}
+	err = r.store.Storage.GuaranteedUpdate(ctx, dKey, &extensions.Deployment{}, false, storage.SimpleUpdate(rollback))
+	return finalDeployment, err
+}
+
 type ScaleREST struct {
 	registry *deployment.Registry
 }
@@ -17,9 +17,11 @@ limitations under the License.
 package etcd
 
 import (
+	"strings"
 	"testing"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/apis/extensions"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
@@ -278,3 +280,126 @@ func TestStatusUpdate(t *testing.T) {
 		t.Errorf("we expected .status.replicas to be updated to 100 but it was %v", deployment.Status.Replicas)
 	}
 }
+
+func TestEtcdCreateDeploymentRollback(t *testing.T) {
+	ctx := api.WithNamespace(api.NewContext(), namespace)
+
+	testCases := map[string]struct {
+		rollback extensions.DeploymentRollback
+		errOK    func(error) bool
+	}{
+		"normal": {
+			rollback: extensions.DeploymentRollback{
+				Name:               name,
+				UpdatedAnnotations: map[string]string{},
+				RollbackTo:         extensions.RollbackConfig{Revision: 1},
+			},
+			errOK: func(err error) bool { return err == nil },
+		},
+		"noAnnotation": {
+			rollback: extensions.DeploymentRollback{
+				Name:       name,
+				RollbackTo: extensions.RollbackConfig{Revision: 1},
+			},
+			errOK: func(err error) bool { return err == nil },
+		},
+		"noName": {
+			rollback: extensions.DeploymentRollback{
+				UpdatedAnnotations: map[string]string{},
+				RollbackTo:         extensions.RollbackConfig{Revision: 1},
+			},
+			errOK: func(err error) bool { return err != nil },
+		},
+	}
+	for k, test := range testCases {
+		storage, server := newStorage(t)
+		rollbackStorage := storage.Rollback
+
+		if _, err := storage.Deployment.Create(ctx, validNewDeployment()); err != nil {
+			t.Fatalf("%s: unexpected error: %v", k, err)
+		}
+		if _, err := rollbackStorage.Create(ctx, &test.rollback); !test.errOK(err) {
+			t.Errorf("%s: unexpected error: %v", k, err)
+		} else if err == nil {
+			// If rollback succeeded, verify Rollback field of deployment
+			obj, err := storage.Deployment.Get(ctx, validNewDeployment().ObjectMeta.Name)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", k, err)
+			} else if !api.Semantic.DeepEqual(*obj.(*extensions.Deployment).Spec.RollbackTo, test.rollback.RollbackTo) {
+				t.Errorf("%s: expected: %v, got: %v", k, test.rollback.RollbackTo, *obj.(*extensions.Deployment).Spec.RollbackTo)
+			}
+		}
+		server.Terminate(t)
+	}
+}
+
+func TestEtcdCreateDeploymentRollbackDryRun(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	ctx := api.WithNamespace(api.NewContext(), namespace)
+
+	if _, err := storage.Deployment.Create(ctx, validNewDeployment()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := storage.Rollback.Create(api.WithDryRun(ctx), &extensions.DeploymentRollback{
+		Name:       name,
+		RollbackTo: extensions.RollbackConfig{Revision: 1},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := storage.Deployment.Get(ctx, name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rollbackTo := obj.(*extensions.Deployment).Spec.RollbackTo; rollbackTo != nil {
+		t.Errorf("expected a dry run rollback not to be recorded, got %v", rollbackTo)
+	}
+}
+
+func TestEtcdCreateDeploymentRollbackPaused(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	ctx := api.WithNamespace(api.NewContext(), namespace)
+
+	deployment := validNewDeployment()
+	deployment.Spec.Paused = true
+	if _, err := storage.Deployment.Create(ctx, deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err := storage.Rollback.Create(ctx, &extensions.DeploymentRollback{
+		Name:       name,
+		RollbackTo: extensions.RollbackConfig{Revision: 1},
+	})
+	if !errors.IsBadRequest(err) {
+		t.Fatalf("expected a bad request error for a paused deployment, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "deployment is paused") {
+		t.Errorf("expected the error to say the deployment is paused, got %v", err)
+	}
+	obj, err := storage.Deployment.Get(ctx, name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rollbackTo := obj.(*extensions.Deployment).Spec.RollbackTo; rollbackTo != nil {
+		t.Errorf("expected the rollback of a paused deployment not to be recorded, got %v", rollbackTo)
+	}
+}
+
+func TestEtcdCreateDeploymentRollbackNoDeployment(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	rollbackStorage := storage.Rollback
+	ctx := api.WithNamespace(api.NewContext(), namespace)
+
+	_, err := rollbackStorage.Create(ctx, &extensions.DeploymentRollback{
+		Name:               name,
+		UpdatedAnnotations: map[string]string{},
+		RollbackTo:         extensions.RollbackConfig{Revision: 1},
+	})
+	if err == nil {
+		t.Fatalf("Expected not-found-error but got nothing")
+	}
+	if !errors.IsNotFound(err) {
+		t.Fatalf("Unexpected error returned: %#v", err)
+	}
+}
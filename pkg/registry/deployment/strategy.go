@@ -18,6 +18,7 @@ package deployment
 
 import (
 	"fmt"
+	"reflect"
 
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/apis/extensions"
@@ -48,6 +49,7 @@ func (deploymentStrategy) NamespaceScoped() bool {
 func (deploymentStrategy) PrepareForCreate(obj runtime.Object) {
 	deployment := obj.(*extensions.Deployment)
 	deployment.Status = extensions.DeploymentStatus{}
+	deployment.Generation = 1
 }
 
 // Validate validates a new deployment.
@@ -70,6 +72,12 @@ func (deploymentStrategy) PrepareForUpdate(obj, old runtime.Object) {
 	newDeployment := obj.(*extensions.Deployment)
 	oldDeployment := old.(*extensions.Deployment)
 	newDeployment.Status = oldDeployment.Status
+
+	// Any changes to the spec increment the generation number, so that the
+	// status can tell whether the controller has seen the latest spec.
+	if !reflect.DeepEqual(newDeployment.Spec, oldDeployment.Spec) {
+		newDeployment.Generation = oldDeployment.Generation + 1
+	}
 }
 
 // ValidateUpdate is the default update validation for an end user.
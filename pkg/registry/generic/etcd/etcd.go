@@ -221,6 +221,15 @@ func (e *Etcd) Create(ctx api.Context, obj runtime.Object) (runtime.Object, erro
 	if err != nil {
 		return nil, err
 	}
+	if api.IsDryRun(ctx) {
+		// Return the object as it would have been stored.
+		if e.Decorator != nil {
+			if err := e.Decorator(obj); err != nil {
+				return nil, err
+			}
+		}
+		return obj, nil
+	}
 	trace.Step("About to create object")
 	out := e.NewFunc()
 	if err := e.Storage.Create(ctx, key, obj, out, ttl); err != nil {
@@ -267,7 +276,7 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 	// TODO: expose TTL
 	creating := false
 	out := e.NewFunc()
-	err = e.Storage.GuaranteedUpdate(ctx, key, out, true, func(existing runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
+	tryUpdate := func(existing runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
 		version, err := e.Storage.Versioner().ObjectResourceVersion(existing)
 		if err != nil {
 			return nil, nil, err
@@ -315,7 +324,21 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 			return obj, &ttl, nil
 		}
 		return obj, nil, nil
-	})
+	}
+
+	if api.IsDryRun(ctx) {
+		// Run the same checks against the current object, but return the
+		// result instead of storing it.
+		existing := e.NewFunc()
+		if err = e.Storage.Get(ctx, key, existing, true); err == nil {
+			var version uint64
+			if version, err = e.Storage.Versioner().ObjectResourceVersion(existing); err == nil {
+				out, _, err = tryUpdate(existing, storage.ResponseMeta{ResourceVersion: version})
+			}
+		}
+	} else {
+		err = e.Storage.GuaranteedUpdate(ctx, key, out, true, tryUpdate)
+	}
 
 	if err != nil {
 		if creating {
@@ -326,13 +349,14 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 		}
 		return nil, false, err
 	}
-	if creating {
+	// Nothing was stored for a dry run, so there is nothing to follow up on.
+	if creating && !api.IsDryRun(ctx) {
 		if e.AfterCreate != nil {
 			if err := e.AfterCreate(out); err != nil {
 				return nil, false, err
 			}
 		}
-	} else {
+	} else if !api.IsDryRun(ctx) {
 		if e.AfterUpdate != nil {
 			if err := e.AfterUpdate(out); err != nil {
 				return nil, false, err
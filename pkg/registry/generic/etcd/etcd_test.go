@@ -309,6 +309,66 @@ func TestEtcdUpdate(t *testing.T) {
 
 }
 
+func TestEtcdDryRun(t *testing.T) {
+	podA := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test"},
+		Spec:       api.PodSpec{NodeName: "machine"},
+	}
+	podB := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test"},
+		Spec:       api.PodSpec{NodeName: "machine2"},
+	}
+
+	testContext := api.WithNamespace(api.NewContext(), "test")
+	dryRunContext := api.WithDryRun(testContext)
+	server, registry := NewTestGenericEtcdRegistry(t)
+	defer server.Terminate(t)
+
+	// a dry run create returns the object without storing it
+	obj, err := registry.Create(dryRunContext, podA)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pod := obj.(*api.Pod); pod.Name != "foo" || pod.Spec.NodeName != "machine" {
+		t.Errorf("Unexpected object: %#v", pod)
+	}
+	if _, err := registry.Get(testContext, podA.Name); !errors.IsNotFound(err) {
+		t.Errorf("Expected the object not to be stored, got %v", err)
+	}
+
+	// a dry run update of a missing object still fails
+	if _, _, err := registry.Update(dryRunContext, podB); !errors.IsNotFound(err) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	// a dry run update returns the updated object but keeps the stored one
+	stored, err := registry.Create(testContext, podA)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	podB.ResourceVersion = stored.(*api.Pod).ResourceVersion
+	obj, created, err := registry.Update(dryRunContext, podB)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if created || obj.(*api.Pod).Spec.NodeName != "machine2" {
+		t.Errorf("Unexpected update result: %t %#v", created, obj)
+	}
+	checkObj, err := registry.Get(testContext, podA.Name)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := stored, checkObj; !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %#v, got %#v", e, a)
+	}
+
+	// a dry run update with a stale resource version conflicts
+	podB.ResourceVersion = "1"
+	if _, _, err := registry.Update(dryRunContext, podB); !errors.IsConflict(err) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
 func TestEtcdGet(t *testing.T) {
 	podA := &api.Pod{
 		ObjectMeta: api.ObjectMeta{Namespace: "test", Name: "foo"},
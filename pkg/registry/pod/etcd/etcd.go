@@ -26,6 +26,7 @@ import (
 	etcderr "k8s.io/kubernetes/pkg/api/errors/etcd"
 	"k8s.io/kubernetes/pkg/api/rest"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
@@ -42,11 +43,13 @@ import (
 type PodStorage struct {
 	Pod         *REST
 	Binding     *BindingREST
+	Eviction    *EvictionREST
 	Status      *StatusREST
 	Log         *podrest.LogREST
 	Proxy       *podrest.ProxyREST
 	Exec        *podrest.ExecREST
 	Attach      *podrest.AttachREST
+	Debug       *podrest.DebugREST
 	PortForward *podrest.PortForwardREST
 }
 
@@ -95,11 +98,13 @@ func NewStorage(s storage.Interface, storageDecorator generic.StorageDecorator,
 	return PodStorage{
 		Pod:         &REST{store, proxyTransport},
 		Binding:     &BindingREST{store: store},
+		Eviction:    &EvictionREST{store: store},
 		Status:      &StatusREST{store: &statusStore},
 		Log:         &podrest.LogREST{Store: store, KubeletConn: k},
 		Proxy:       &podrest.ProxyREST{Store: store, ProxyTransport: proxyTransport},
 		Exec:        &podrest.ExecREST{Store: store, KubeletConn: k},
 		Attach:      &podrest.AttachREST{Store: store, KubeletConn: k},
+		Debug:       &podrest.DebugREST{Store: store, KubeletConn: k},
 		PortForward: &podrest.PortForwardREST{Store: store, KubeletConn: k},
 	}
 }
@@ -147,7 +152,7 @@ func (r *BindingREST) setPodHostAndAnnotations(ctx api.Context, podID, oldMachin
 	if err != nil {
 		return nil, err
 	}
-	err = r.store.Storage.GuaranteedUpdate(ctx, podKey, &api.Pod{}, false, storage.SimpleUpdate(func(obj runtime.Object) (runtime.Object, error) {
+	assign := func(obj runtime.Object) (runtime.Object, error) {
 		pod, ok := obj.(*api.Pod)
 		if !ok {
 			return nil, fmt.Errorf("unexpected object: %#v", obj)
@@ -167,7 +172,17 @@ func (r *BindingREST) setPodHostAndAnnotations(ctx api.Context, podID, oldMachin
 		}
 		finalPod = pod
 		return pod, nil
-	}))
+	}
+	if api.IsDryRun(ctx) {
+		// Check that the binding would succeed without persisting it.
+		pod := &api.Pod{}
+		if err := r.store.Storage.Get(ctx, podKey, pod, false); err != nil {
+			return nil, err
+		}
+		_, err = assign(pod)
+		return finalPod, err
+	}
+	err = r.store.Storage.GuaranteedUpdate(ctx, podKey, &api.Pod{}, false, storage.SimpleUpdate(assign))
 	return finalPod, err
 }
 
@@ -183,6 +198,106 @@ func (r *BindingREST) assignPod(ctx api.Context, podID string, machine string, a
 	return
 }
 
+// EvictionREST implements the REST endpoint for evicting pods from nodes when etcd is in use.
+type EvictionREST struct {
+	store *etcdgeneric.Etcd
+
+	podDisruptionBudgets      rest.Lister
+	podDisruptionBudgetStatus rest.Updater
+}
+
+var _ = rest.Creater(&EvictionREST{})
+
+// New creates a new eviction resource
+func (r *EvictionREST) New() runtime.Object {
+	return &api.Eviction{}
+}
+
+// SetPodDisruptionBudgetStorage wires in the storage used to check and record
+// disruptions against pod disruption budgets. Until it is called, evictions
+// are not constrained by any budget.
+func (r *EvictionREST) SetPodDisruptionBudgetStorage(budgets rest.Lister, status rest.Updater) {
+	r.podDisruptionBudgets = budgets
+	r.podDisruptionBudgetStatus = status
+}
+
+// Create attempts to delete the named pod, refusing to do so if the deletion
+// would violate the pod disruption budget covering it.
+func (r *EvictionREST) Create(ctx api.Context, obj runtime.Object) (runtime.Object, error) {
+	eviction := obj.(*api.Eviction)
+
+	obj, err := r.store.Get(ctx, eviction.Name)
+	if err != nil {
+		return nil, err
+	}
+	pod := obj.(*api.Pod)
+
+	if r.podDisruptionBudgets != nil {
+		pdb, err := r.getPodDisruptionBudget(ctx, pod)
+		if err != nil {
+			return nil, err
+		}
+		if pdb != nil {
+			if pdb.Status.PodDisruptionsAllowed <= 0 {
+				return nil, errors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10)
+			}
+			if api.IsDryRun(ctx) {
+				return &unversioned.Status{Status: unversioned.StatusSuccess}, nil
+			}
+			// Record the disruption before deleting the pod, so that concurrent
+			// evictions against the same budget conflict instead of both succeeding.
+			// The pod is also listed as disrupted so that the disruption controller
+			// does not count it as healthy, and restore the budget, before it
+			// observes the deletion.
+			pdb.Status.PodDisruptionsAllowed--
+			if pdb.Status.DisruptedPods == nil {
+				pdb.Status.DisruptedPods = make(map[string]unversioned.Time)
+			}
+			pdb.Status.DisruptedPods[pod.Name] = unversioned.Now()
+			if _, _, err := r.podDisruptionBudgetStatus.Update(ctx, pdb); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if api.IsDryRun(ctx) {
+		return &unversioned.Status{Status: unversioned.StatusSuccess}, nil
+	}
+	if _, err := r.store.Delete(ctx, eviction.Name, eviction.DeleteOptions); err != nil {
+		return nil, err
+	}
+	return &unversioned.Status{Status: unversioned.StatusSuccess}, nil
+}
+
+// getPodDisruptionBudget returns the budget whose selector matches the pod,
+// nil if there is none, or an error if more than one budget covers it.
+func (r *EvictionREST) getPodDisruptionBudget(ctx api.Context, pod *api.Pod) (*extensions.PodDisruptionBudget, error) {
+	if len(pod.Labels) == 0 {
+		return nil, nil
+	}
+	obj, err := r.podDisruptionBudgets.List(ctx, &unversioned.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var matched *extensions.PodDisruptionBudget
+	for i := range obj.(*extensions.PodDisruptionBudgetList).Items {
+		pdb := &obj.(*extensions.PodDisruptionBudgetList).Items[i]
+		selector, err := extensions.PodSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			// Invalid selectors are rejected by validation; ignore any that slipped through.
+			continue
+		}
+		if selector.Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		if matched != nil {
+			return nil, errors.NewInternalError(fmt.Errorf("pod %q is covered by more than one pod disruption budget: %q and %q", pod.Name, matched.Name, pdb.Name))
+		}
+		matched = pdb
+	}
+	return matched, nil
+}
+
 // StatusREST implements the REST endpoint for changing the status of a pod.
 type StatusREST struct {
 	store *etcdgeneric.Etcd
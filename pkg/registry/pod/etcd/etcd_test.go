@@ -24,15 +24,18 @@ import (
 	"k8s.io/kubernetes/pkg/api/errors"
 	etcderrors "k8s.io/kubernetes/pkg/api/errors/etcd"
 	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/apis/extensions"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/registry/generic"
+	pdbetcd "k8s.io/kubernetes/pkg/registry/poddisruptionbudget/etcd"
 	"k8s.io/kubernetes/pkg/registry/registrytest"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/securitycontext"
 	"k8s.io/kubernetes/pkg/storage/etcd/etcdtest"
 	etcdtesting "k8s.io/kubernetes/pkg/storage/etcd/testing"
 	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/intstr"
 )
 
 func newStorage(t *testing.T) (*REST, *BindingREST, *StatusREST, *etcdtesting.EtcdTestServer) {
@@ -705,3 +708,160 @@ func TestEtcdUpdateStatus(t *testing.T) {
 		t.Errorf("objects differ: %v", util.ObjectDiff(podOut, podIn))
 	}
 }
+
+func TestEviction(t *testing.T) {
+	etcdStorage, server := registrytest.NewEtcdStorage(t, "")
+	defer server.Terminate(t)
+	podStorage := NewStorage(etcdStorage, generic.UndecoratedStorage, nil, nil)
+	pdbEtcdStorage, pdbServer := registrytest.NewEtcdStorage(t, "extensions")
+	defer pdbServer.Terminate(t)
+	pdbStorage, pdbStatusStorage := pdbetcd.NewREST(pdbEtcdStorage, generic.UndecoratedStorage)
+	podStorage.Eviction.SetPodDisruptionBudgetStorage(pdbStorage, pdbStatusStorage)
+	ctx := api.NewDefaultContext()
+
+	for _, name := range []string{"foo", "bar"} {
+		pod := validNewPod()
+		pod.Name = name
+		pod.Labels = map[string]string{"app": "quorum"}
+		if _, err := podStorage.Pod.Create(ctx, pod); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	pdb := &extensions.PodDisruptionBudget{
+		ObjectMeta: api.ObjectMeta{Name: "quorum", Namespace: api.NamespaceDefault},
+		Spec: extensions.PodDisruptionBudgetSpec{
+			MinAvailable: intstr.FromInt(1),
+			Selector:     &extensions.PodSelector{MatchLabels: map[string]string{"app": "quorum"}},
+		},
+	}
+	obj, err := pdbStorage.Create(ctx, pdb)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pdb = obj.(*extensions.PodDisruptionBudget)
+	pdb.Status.PodDisruptionsAllowed = 1
+	if _, _, err := pdbStatusStorage.Update(ctx, pdb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	zero := int64(0)
+	evict := func(name string) error {
+		_, err := podStorage.Eviction.Create(ctx, &api.Eviction{
+			ObjectMeta:    api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+			DeleteOptions: &api.DeleteOptions{GracePeriodSeconds: &zero},
+		})
+		return err
+	}
+
+	if err := evict("foo"); err != nil {
+		t.Fatalf("unexpected error evicting pod within budget: %v", err)
+	}
+	if _, err := podStorage.Pod.Get(ctx, "foo"); !errors.IsNotFound(err) {
+		t.Errorf("expected evicted pod to be deleted, got %v", err)
+	}
+	obj, err = pdbStorage.Get(ctx, "quorum")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	status := obj.(*extensions.PodDisruptionBudget).Status
+	if status.PodDisruptionsAllowed != 0 {
+		t.Errorf("expected eviction to consume the allowed disruption, got %d remaining", status.PodDisruptionsAllowed)
+	}
+	if _, found := status.DisruptedPods["foo"]; !found || len(status.DisruptedPods) != 1 {
+		t.Errorf("expected the evicted pod to be recorded as disrupted, got %v", status.DisruptedPods)
+	}
+
+	if err := evict("bar"); !errors.IsTooManyRequests(err) {
+		t.Errorf("expected eviction violating the budget to be refused, got %v", err)
+	}
+	if _, err := podStorage.Pod.Get(ctx, "bar"); err != nil {
+		t.Errorf("expected pod protected by the budget to remain, got %v", err)
+	}
+
+	if err := evict("baz"); !errors.IsNotFound(err) {
+		t.Errorf("expected not found evicting a missing pod, got %v", err)
+	}
+}
+
+func TestEvictionDryRun(t *testing.T) {
+	etcdStorage, server := registrytest.NewEtcdStorage(t, "")
+	defer server.Terminate(t)
+	podStorage := NewStorage(etcdStorage, generic.UndecoratedStorage, nil, nil)
+	pdbEtcdStorage, pdbServer := registrytest.NewEtcdStorage(t, "extensions")
+	defer pdbServer.Terminate(t)
+	pdbStorage, pdbStatusStorage := pdbetcd.NewREST(pdbEtcdStorage, generic.UndecoratedStorage)
+	podStorage.Eviction.SetPodDisruptionBudgetStorage(pdbStorage, pdbStatusStorage)
+	ctx := api.NewDefaultContext()
+
+	pod := validNewPod()
+	pod.Labels = map[string]string{"app": "quorum"}
+	if _, err := podStorage.Pod.Create(ctx, pod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pdb := &extensions.PodDisruptionBudget{
+		ObjectMeta: api.ObjectMeta{Name: "quorum", Namespace: api.NamespaceDefault},
+		Spec: extensions.PodDisruptionBudgetSpec{
+			MinAvailable: intstr.FromInt(1),
+			Selector:     &extensions.PodSelector{MatchLabels: map[string]string{"app": "quorum"}},
+		},
+	}
+	obj, err := pdbStorage.Create(ctx, pdb)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pdb = obj.(*extensions.PodDisruptionBudget)
+	pdb.Status.PodDisruptionsAllowed = 1
+	if _, _, err := pdbStatusStorage.Update(ctx, pdb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := podStorage.Eviction.Create(api.WithDryRun(ctx), &api.Eviction{
+		ObjectMeta: api.ObjectMeta{Name: pod.Name, Namespace: api.NamespaceDefault},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err = podStorage.Pod.Get(ctx, pod.Name)
+	if err != nil {
+		t.Fatalf("expected pod to remain after a dry run eviction, got %v", err)
+	}
+	if obj.(*api.Pod).DeletionTimestamp != nil {
+		t.Errorf("expected pod not to be deleted by a dry run eviction")
+	}
+	obj, err = pdbStorage.Get(ctx, "quorum")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	status := obj.(*extensions.PodDisruptionBudget).Status
+	if status.PodDisruptionsAllowed != 1 || len(status.DisruptedPods) != 0 {
+		t.Errorf("expected a dry run eviction to leave the budget untouched, got %+v", status)
+	}
+}
+
+func TestEtcdCreateBindingDryRun(t *testing.T) {
+	storage, bindingStorage, _, server := newStorage(t)
+	defer server.Terminate(t)
+	ctx := api.NewDefaultContext()
+
+	if _, err := storage.Create(ctx, validNewPod()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	binding := &api.Binding{
+		ObjectMeta: api.ObjectMeta{Namespace: api.NamespaceDefault, Name: "foo"},
+		Target:     api.ObjectReference{Name: "machine"},
+	}
+	if _, err := bindingStorage.Create(api.WithDryRun(ctx), binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nodeName := obj.(*api.Pod).Spec.NodeName; len(nodeName) != 0 {
+		t.Errorf("expected a dry run binding not to assign the pod, got %q", nodeName)
+	}
+
+	binding.Name = "missing"
+	if _, err := bindingStorage.Create(api.WithDryRun(ctx), binding); !errors.IsNotFound(err) {
+		t.Errorf("expected not found for a dry run binding of a missing pod, got %v", err)
+	}
+}
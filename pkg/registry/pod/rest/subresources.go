@@ -150,6 +150,43 @@ func (r *ExecREST) ConnectMethods() []string {
 	return upgradeableMethods
 }
 
+// DebugREST implements the debug subresource for a Pod
+type DebugREST struct {
+	Store       *etcdgeneric.Etcd
+	KubeletConn client.ConnectionInfoGetter
+}
+
+// Implement Connecter
+var _ = rest.Connecter(&DebugREST{})
+
+// New creates a new Pod object
+func (r *DebugREST) New() runtime.Object {
+	return &api.Pod{}
+}
+
+// Connect returns a handler for the pod debug proxy
+func (r *DebugREST) Connect(ctx api.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	debugOpts, ok := opts.(*api.PodDebugOptions)
+	if !ok {
+		return nil, fmt.Errorf("invalid options object: %#v", opts)
+	}
+	location, transport, err := pod.DebugLocation(r.Store, r.KubeletConn, ctx, name, debugOpts)
+	if err != nil {
+		return nil, err
+	}
+	return newThrottledUpgradeAwareProxyHandler(location, transport, false, true, responder), nil
+}
+
+// NewConnectOptions returns the versioned object that represents debug parameters
+func (r *DebugREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &api.PodDebugOptions{}, false, ""
+}
+
+// ConnectMethods returns the methods supported by debug
+func (r *DebugREST) ConnectMethods() []string {
+	return upgradeableMethods
+}
+
 // PortForwardREST implements the portforward subresource for a Pod
 type PortForwardREST struct {
 	Store       *etcdgeneric.Etcd
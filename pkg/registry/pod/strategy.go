@@ -319,6 +319,26 @@ func streamParams(params url.Values, opts runtime.Object) error {
 		if opts.TTY {
 			params.Add(api.ExecTTYParam, "1")
 		}
+	case *api.PodDebugOptions:
+		if opts.Stdin {
+			params.Add(api.ExecStdinParam, "1")
+		}
+		if opts.Stdout {
+			params.Add(api.ExecStdoutParam, "1")
+		}
+		if opts.Stderr {
+			params.Add(api.ExecStderrParam, "1")
+		}
+		if opts.TTY {
+			params.Add(api.ExecTTYParam, "1")
+		}
+		params.Add("image", opts.Image)
+		if len(opts.Target) > 0 {
+			params.Add("target", opts.Target)
+		}
+		for _, c := range opts.Command {
+			params.Add("command", c)
+		}
 	default:
 		return fmt.Errorf("Unknown object for streaming: %v", opts)
 	}
@@ -337,6 +357,19 @@ func ExecLocation(getter ResourceGetter, connInfo client.ConnectionInfoGetter, c
 	return streamLocation(getter, connInfo, ctx, name, opts, opts.Container, "exec")
 }
 
+// DebugLocation returns the debug URL for a pod. Unlike attach and exec, the
+// container named by opts.Container is created by the kubelet, so a name and an
+// image are always required.
+func DebugLocation(getter ResourceGetter, connInfo client.ConnectionInfoGetter, ctx api.Context, name string, opts *api.PodDebugOptions) (*url.URL, http.RoundTripper, error) {
+	if len(opts.Container) == 0 {
+		return nil, nil, errors.NewBadRequest(fmt.Sprintf("a debug container name must be specified for pod %s", name))
+	}
+	if len(opts.Image) == 0 {
+		return nil, nil, errors.NewBadRequest(fmt.Sprintf("a debug container image must be specified for pod %s", name))
+	}
+	return streamLocation(getter, connInfo, ctx, name, opts, opts.Container, "debug")
+}
+
 func streamLocation(getter ResourceGetter, connInfo client.ConnectionInfoGetter, ctx api.Context, name string, opts runtime.Object, container, path string) (*url.URL, http.RoundTripper, error) {
 	pod, err := getPod(getter, ctx, name)
 	if err != nil {
@@ -17,9 +17,12 @@ limitations under the License.
 package pod
 
 import (
+	"net/http"
 	"testing"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/runtime"
 )
 
 func TestCheckGracefulDelete(t *testing.T) {
@@ -76,3 +79,71 @@ func TestCheckGracefulDelete(t *testing.T) {
 		}
 	}
 }
+
+type mockPodGetter struct {
+	pod *api.Pod
+}
+
+func (g mockPodGetter) Get(api.Context, string) (runtime.Object, error) {
+	return g.pod, nil
+}
+
+type mockConnectionInfoGetter struct{}
+
+func (mockConnectionInfoGetter) GetConnectionInfo(host string) (string, uint, http.RoundTripper, error) {
+	return "https", 10250, nil, nil
+}
+
+func TestDebugLocation(t *testing.T) {
+	getter := mockPodGetter{pod: &api.Pod{
+		ObjectMeta: api.ObjectMeta{Namespace: "test", Name: "foo"},
+		Spec: api.PodSpec{
+			NodeName:   "node1",
+			Containers: []api.Container{{Name: "bar"}},
+		},
+	}}
+	ctx := api.NewDefaultContext()
+
+	tests := []struct {
+		opts      *api.PodDebugOptions
+		location  string
+		expectErr bool
+	}{
+		{
+			opts:     &api.PodDebugOptions{Container: "debugger", Image: "busybox", Stdout: true},
+			location: "https://node1:10250/debug/test/foo/debugger?image=busybox&output=1",
+		},
+		{
+			opts:     &api.PodDebugOptions{Container: "debugger", Image: "busybox", Stdin: true, TTY: true, Command: []string{"sh", "-c", "ls"}},
+			location: "https://node1:10250/debug/test/foo/debugger?command=sh&command=-c&command=ls&image=busybox&input=1&tty=1",
+		},
+		{
+			opts:     &api.PodDebugOptions{Container: "debugger", Target: "bar", Image: "busybox", Stdout: true},
+			location: "https://node1:10250/debug/test/foo/debugger?image=busybox&output=1&target=bar",
+		},
+		{
+			opts:      &api.PodDebugOptions{Image: "busybox"},
+			expectErr: true,
+		},
+		{
+			opts:      &api.PodDebugOptions{Container: "debugger"},
+			expectErr: true,
+		},
+	}
+	for i, test := range tests {
+		location, _, err := DebugLocation(getter, mockConnectionInfoGetter{}, ctx, "foo", test.opts)
+		if test.expectErr {
+			if !errors.IsBadRequest(err) {
+				t.Errorf("%d: expected bad request error, got %v", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if location.String() != test.location {
+			t.Errorf("%d: expected %s, got %s", i, test.location, location.String())
+		}
+	}
+}
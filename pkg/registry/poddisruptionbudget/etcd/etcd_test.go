@@ -0,0 +1,167 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	"k8s.io/kubernetes/pkg/registry/registrytest"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage/etcd/etcdtest"
+	etcdtesting "k8s.io/kubernetes/pkg/storage/etcd/testing"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *etcdtesting.EtcdTestServer) {
+	etcdStorage, server := registrytest.NewEtcdStorage(t, "extensions")
+	podDisruptionBudgetStorage, statusStorage := NewREST(etcdStorage, generic.UndecoratedStorage)
+	return podDisruptionBudgetStorage, statusStorage, server
+}
+
+func validNewPodDisruptionBudget() *extensions.PodDisruptionBudget {
+	return &extensions.PodDisruptionBudget{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "foo",
+			Namespace: api.NamespaceDefault,
+			Labels:    map[string]string{"a": "b"},
+		},
+		Spec: extensions.PodDisruptionBudgetSpec{
+			Selector:     &extensions.PodSelector{MatchLabels: map[string]string{"a": "b"}},
+			MinAvailable: intstr.FromInt(7),
+		},
+		Status: extensions.PodDisruptionBudgetStatus{},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	storage, _, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd)
+	pdb := validNewPodDisruptionBudget()
+	pdb.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		pdb,
+		// invalid (empty selector)
+		&extensions.PodDisruptionBudget{
+			Spec: extensions.PodDisruptionBudgetSpec{
+				MinAvailable: intstr.FromInt(7),
+			},
+		},
+	)
+}
+
+func TestStatusUpdate(t *testing.T) {
+	storage, statusStorage, server := newStorage(t)
+	defer server.Terminate(t)
+	ctx := api.WithNamespace(api.NewContext(), api.NamespaceDefault)
+	key, _ := storage.KeyFunc(ctx, "foo")
+	key = etcdtest.AddPrefix(key)
+	validPodDisruptionBudget := validNewPodDisruptionBudget()
+	if err := storage.Storage.Set(ctx, key, validPodDisruptionBudget, nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	obj, err := storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("failed to get pdb: %v", err)
+	}
+	obtainedPdb := obj.(*extensions.PodDisruptionBudget)
+
+	update := extensions.PodDisruptionBudget{
+		ObjectMeta: obtainedPdb.ObjectMeta,
+		Spec: extensions.PodDisruptionBudgetSpec{
+			MinAvailable: intstr.FromInt(8),
+		},
+		Status: extensions.PodDisruptionBudgetStatus{
+			PodDisruptionsAllowed: 1,
+			CurrentHealthy:        8,
+			DesiredHealthy:        7,
+			ExpectedPods:          8,
+		},
+	}
+
+	if _, _, err := statusStorage.Update(ctx, &update); err != nil {
+		t.Fatalf("failed to update status: %v", err)
+	}
+	obj, err = storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("failed to get pdb: %v", err)
+	}
+	obtainedPdb = obj.(*extensions.PodDisruptionBudget)
+	if obtainedPdb.Spec.MinAvailable.IntValue() != 7 {
+		t.Errorf("pdb spec changed by status update; expected minAvailable 7, got %v", obtainedPdb.Spec.MinAvailable.IntValue())
+	}
+	if obtainedPdb.Status.PodDisruptionsAllowed != 1 {
+		t.Errorf("pdb status didn't change; expected 1 disruption allowed, got %v", obtainedPdb.Status.PodDisruptionsAllowed)
+	}
+}
+
+func TestGet(t *testing.T) {
+	storage, _, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd)
+	test.TestGet(validNewPodDisruptionBudget())
+}
+
+func TestList(t *testing.T) {
+	storage, _, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd)
+	test.TestList(validNewPodDisruptionBudget())
+}
+
+func TestDelete(t *testing.T) {
+	storage, _, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd)
+	test.TestDelete(validNewPodDisruptionBudget())
+}
+
+func TestWatch(t *testing.T) {
+	storage, _, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd)
+	test.TestWatch(
+		validNewPodDisruptionBudget(),
+		// matching labels
+		[]labels.Set{
+			{"a": "b"},
+		},
+		// not matching labels
+		[]labels.Set{
+			{"a": "c"},
+			{"foo": "bar"},
+		},
+		// matching fields
+		[]fields.Set{
+			{"metadata.name": "foo"},
+		},
+		// not matching fields
+		[]fields.Set{
+			{"metadata.name": "bar"},
+			{"name": "foo"},
+		},
+	)
+}
+
+var _ runtime.Object = &extensions.PodDisruptionBudget{}
@@ -0,0 +1,137 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poddisruptionbudget
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/apis/extensions/validation"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	"k8s.io/kubernetes/pkg/runtime"
+	utilvalidation "k8s.io/kubernetes/pkg/util/validation"
+)
+
+// podDisruptionBudgetStrategy implements verification logic for PodDisruptionBudgets.
+type podDisruptionBudgetStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating PodDisruptionBudget objects.
+var Strategy = podDisruptionBudgetStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped returns true because all PodDisruptionBudgets need to be within a namespace.
+func (podDisruptionBudgetStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate clears the status of a PodDisruptionBudget before creation.
+func (podDisruptionBudgetStrategy) PrepareForCreate(obj runtime.Object) {
+	podDisruptionBudget := obj.(*extensions.PodDisruptionBudget)
+	// create cannot set status
+	podDisruptionBudget.Status = extensions.PodDisruptionBudgetStatus{}
+
+	podDisruptionBudget.Generation = 1
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update.
+func (podDisruptionBudgetStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newPodDisruptionBudget := obj.(*extensions.PodDisruptionBudget)
+	oldPodDisruptionBudget := old.(*extensions.PodDisruptionBudget)
+	// Update is not allowed to set status
+	newPodDisruptionBudget.Status = oldPodDisruptionBudget.Status
+
+	// Any changes to the spec increment the generation number, any changes to the
+	// status should reflect the generation number of the corresponding object.
+	// See api.ObjectMeta description for more information on Generation.
+	if !reflect.DeepEqual(oldPodDisruptionBudget.Spec, newPodDisruptionBudget.Spec) {
+		newPodDisruptionBudget.Generation = oldPodDisruptionBudget.Generation + 1
+	}
+}
+
+// Validate validates a new PodDisruptionBudget.
+func (podDisruptionBudgetStrategy) Validate(ctx api.Context, obj runtime.Object) utilvalidation.ErrorList {
+	podDisruptionBudget := obj.(*extensions.PodDisruptionBudget)
+	return validation.ValidatePodDisruptionBudget(podDisruptionBudget)
+}
+
+// Canonicalize normalizes the object after validation.
+func (podDisruptionBudgetStrategy) Canonicalize(obj runtime.Object) {
+}
+
+// AllowCreateOnUpdate is false for PodDisruptionBudget; this means POST is needed to create one.
+func (podDisruptionBudgetStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (podDisruptionBudgetStrategy) ValidateUpdate(ctx api.Context, obj, old runtime.Object) utilvalidation.ErrorList {
+	validationErrorList := validation.ValidatePodDisruptionBudget(obj.(*extensions.PodDisruptionBudget))
+	updateErrorList := validation.ValidatePodDisruptionBudgetUpdate(obj.(*extensions.PodDisruptionBudget), old.(*extensions.PodDisruptionBudget))
+	return append(validationErrorList, updateErrorList...)
+}
+
+// AllowUnconditionalUpdate is the default update policy for PodDisruptionBudget objects. Status update should
+// only be allowed if version match.
+func (podDisruptionBudgetStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// PodDisruptionBudgetToSelectableFields returns a field set that represents the object.
+func PodDisruptionBudgetToSelectableFields(podDisruptionBudget *extensions.PodDisruptionBudget) fields.Set {
+	return generic.ObjectMetaFieldsSet(podDisruptionBudget.ObjectMeta, true)
+}
+
+// MatchPodDisruptionBudget is the filter used by the generic etcd backend to watch events
+// from etcd to clients of the apiserver only interested in specific labels/fields.
+func MatchPodDisruptionBudget(label labels.Selector, field fields.Selector) generic.Matcher {
+	return &generic.SelectionPredicate{
+		Label: label,
+		Field: field,
+		GetAttrs: func(obj runtime.Object) (labels.Set, fields.Set, error) {
+			podDisruptionBudget, ok := obj.(*extensions.PodDisruptionBudget)
+			if !ok {
+				return nil, nil, fmt.Errorf("Given object is not a PodDisruptionBudget.")
+			}
+			return labels.Set(podDisruptionBudget.ObjectMeta.Labels), PodDisruptionBudgetToSelectableFields(podDisruptionBudget), nil
+		},
+	}
+}
+
+type podDisruptionBudgetStatusStrategy struct {
+	podDisruptionBudgetStrategy
+}
+
+var StatusStrategy = podDisruptionBudgetStatusStrategy{Strategy}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update of status
+func (podDisruptionBudgetStatusStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newPodDisruptionBudget := obj.(*extensions.PodDisruptionBudget)
+	oldPodDisruptionBudget := old.(*extensions.PodDisruptionBudget)
+	// status changes are not allowed to update spec
+	newPodDisruptionBudget.Spec = oldPodDisruptionBudget.Spec
+}
+
+// ValidateUpdate is the default update validation for an end user updating status
+func (podDisruptionBudgetStatusStrategy) ValidateUpdate(ctx api.Context, obj, old runtime.Object) utilvalidation.ErrorList {
+	return validation.ValidatePodDisruptionBudgetStatusUpdate(obj.(*extensions.PodDisruptionBudget), old.(*extensions.PodDisruptionBudget))
+}
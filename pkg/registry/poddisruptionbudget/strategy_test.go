@@ -0,0 +1,106 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poddisruptionbudget
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func newPodDisruptionBudget() extensions.PodDisruptionBudget {
+	return extensions.PodDisruptionBudget{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "foo",
+			Namespace: api.NamespaceDefault,
+		},
+		Spec: extensions.PodDisruptionBudgetSpec{
+			MinAvailable: intstr.FromInt(3),
+			Selector:     &extensions.PodSelector{MatchLabels: map[string]string{"a": "b"}},
+		},
+		Status: extensions.PodDisruptionBudgetStatus{
+			PodDisruptionsAllowed: 1,
+			CurrentHealthy:        4,
+			DesiredHealthy:        3,
+			ExpectedPods:          4,
+		},
+	}
+}
+
+func TestPodDisruptionBudgetStrategy(t *testing.T) {
+	ctx := api.NewDefaultContext()
+	if !Strategy.NamespaceScoped() {
+		t.Errorf("PodDisruptionBudget must be namespace scoped")
+	}
+	if Strategy.AllowCreateOnUpdate() {
+		t.Errorf("PodDisruptionBudget should not allow create on update")
+	}
+
+	pdb := newPodDisruptionBudget()
+	Strategy.PrepareForCreate(&pdb)
+	if pdb.Status.PodDisruptionsAllowed != 0 {
+		t.Error("PodDisruptionBudget should not allow setting status on create")
+	}
+	errs := Strategy.Validate(ctx, &pdb)
+	if len(errs) != 0 {
+		t.Errorf("Unexpected error validating %v", errs)
+	}
+
+	newPdb := newPodDisruptionBudget()
+	newPdb.ResourceVersion = "4"
+	newPdb.Spec.MinAvailable = intstr.FromInt(1)
+	Strategy.PrepareForUpdate(&newPdb, &pdb)
+	if newPdb.Status.PodDisruptionsAllowed != 0 {
+		t.Errorf("PodDisruptionBudget updates should not change status")
+	}
+	errs = Strategy.ValidateUpdate(ctx, &newPdb, &pdb)
+	if len(errs) == 0 {
+		t.Errorf("Expected a validation error since updates to the spec are disallowed")
+	}
+	if newPdb.ResourceVersion != "4" {
+		t.Errorf("Incoming resource version on update should not be mutated")
+	}
+}
+
+func TestPodDisruptionBudgetStatusStrategy(t *testing.T) {
+	ctx := api.NewDefaultContext()
+	if !StatusStrategy.NamespaceScoped() {
+		t.Errorf("PodDisruptionBudget must be namespace scoped")
+	}
+	if StatusStrategy.AllowCreateOnUpdate() {
+		t.Errorf("PodDisruptionBudget should not allow create on update")
+	}
+	oldPdb := newPodDisruptionBudget()
+	newPdb := newPodDisruptionBudget()
+	oldPdb.ResourceVersion = "4"
+	newPdb.ResourceVersion = "4"
+	newPdb.Spec.MinAvailable = intstr.FromInt(1)
+	newPdb.Status.PodDisruptionsAllowed = 0
+	StatusStrategy.PrepareForUpdate(&newPdb, &oldPdb)
+	if newPdb.Status.PodDisruptionsAllowed != 0 {
+		t.Errorf("PodDisruptionBudget status updates should allow change of status fields")
+	}
+	if newPdb.Spec.MinAvailable.IntValue() != 3 {
+		t.Errorf("PrepareForUpdate should have preserved old spec")
+	}
+	errs := StatusStrategy.ValidateUpdate(ctx, &newPdb, &oldPdb)
+	if len(errs) != 0 {
+		t.Errorf("Unexpected error %v", errs)
+	}
+}
@@ -122,7 +122,8 @@ func (rs *REST) Create(ctx api.Context, obj runtime.Object) (runtime.Object, err
 		err = rest.CheckGeneratedNameError(Strategy, err, service)
 	}
 
-	if err == nil {
+	// A dry run leaves the allocations to be released when we return.
+	if err == nil && !api.IsDryRun(ctx) {
 		el := nodePortOp.Commit()
 		if el != nil {
 			// these should be caught by an eventual reconciliation / restart
@@ -262,7 +263,8 @@ func (rs *REST) Update(ctx api.Context, obj runtime.Object) (runtime.Object, boo
 
 	out, err := rs.registry.UpdateService(ctx, service)
 
-	if err == nil {
+	// A dry run leaves the allocations to be rolled back when we return.
+	if err == nil && !api.IsDryRun(ctx) {
 		el := nodePortOp.Commit()
 		if el != nil {
 			// problems should be fixed by an eventual reconciliation / restart
@@ -109,6 +109,39 @@ func TestServiceRegistryCreate(t *testing.T) {
 	}
 }
 
+func TestServiceRegistryCreateDryRun(t *testing.T) {
+	storage, _ := NewTestREST(t, nil)
+
+	svc := &api.Service{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+		Spec: api.ServiceSpec{
+			Selector:        map[string]string{"bar": "baz"},
+			SessionAffinity: api.ServiceAffinityNone,
+			Type:            api.ServiceTypeNodePort,
+			Ports: []api.ServicePort{{
+				Port:       6502,
+				Protocol:   api.ProtocolTCP,
+				TargetPort: intstr.FromInt(6502),
+			}},
+		},
+	}
+	ctx := api.WithDryRun(api.NewDefaultContext())
+	created_svc, err := storage.Create(ctx, svc)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	created_service := created_svc.(*api.Service)
+	if !makeIPNet(t).Contains(net.ParseIP(created_service.Spec.ClusterIP)) {
+		t.Errorf("Unexpected ClusterIP: %s", created_service.Spec.ClusterIP)
+	}
+	if storage.serviceIPs.(*ipallocator.Range).Has(net.ParseIP(created_service.Spec.ClusterIP)) {
+		t.Errorf("Expected the ClusterIP of a dry run to be released")
+	}
+	if storage.serviceNodePorts.(*portallocator.PortAllocator).Has(created_service.Spec.Ports[0].NodePort) {
+		t.Errorf("Expected the NodePort of a dry run to be released")
+	}
+}
+
 func TestServiceStorageValidatesCreate(t *testing.T) {
 	storage, _ := NewTestREST(t, nil)
 	failureCases := map[string]api.Service{
@@ -0,0 +1,69 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache contains caches that are safe for concurrent use.
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/groupcache/lru"
+	"k8s.io/kubernetes/pkg/util"
+)
+
+// LRUExpireCache is a cache holding at most a fixed number of entries, evicting the least
+// recently used one when full.  Each entry expires after the TTL it was added with.
+type LRUExpireCache struct {
+	clock util.Clock
+
+	cache *lru.Cache
+	lock  sync.Mutex
+}
+
+type cacheEntry struct {
+	value      interface{}
+	expireTime time.Time
+}
+
+// NewLRUExpireCache returns an LRUExpireCache holding at most maxSize entries.
+func NewLRUExpireCache(maxSize int) *LRUExpireCache {
+	return &LRUExpireCache{clock: util.RealClock{}, cache: lru.New(maxSize)}
+}
+
+// Add adds value to the cache under key, replacing any previous value.  The entry expires
+// after ttl.
+func (c *LRUExpireCache) Add(key lru.Key, value interface{}, ttl time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Add(key, &cacheEntry{value, c.clock.Now().Add(ttl)})
+}
+
+// Get returns the value stored under key, if it is present and has not expired.
+func (c *LRUExpireCache) Get(key lru.Key) (interface{}, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	e, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	entry := e.(*cacheEntry)
+	if c.clock.Now().After(entry.expireTime) {
+		c.cache.Remove(key)
+		return nil, false
+	}
+	return entry.value, true
+}
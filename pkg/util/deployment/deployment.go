@@ -19,8 +19,11 @@ package deployment
 import (
 	"fmt"
 	"hash/adler32"
+	"strconv"
+	"time"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/apis/extensions"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
@@ -28,6 +31,33 @@ import (
 	"k8s.io/kubernetes/pkg/util"
 )
 
+const (
+	// The revision annotation of a deployment's replication controllers which records its rollout sequence
+	RevisionAnnotation = "deployment.kubernetes.io/revision"
+
+	// Reasons for deployment conditions.
+
+	// RCUpdatedReason is added in a deployment when one of its RCs is scaled or its spec is updated.
+	RCUpdatedReason = "ReplicationControllerUpdated"
+	// NewRCAvailableReason is added in a deployment when its new RC is fully rolled out and available.
+	NewRCAvailableReason = "NewReplicationControllerAvailable"
+	// TimedOutReason is added in a deployment when it made no progress within its progress deadline.
+	TimedOutReason = "ProgressDeadlineExceeded"
+	// PausedDeployReason is added in a deployment when it is paused.
+	PausedDeployReason = "DeploymentPaused"
+	// ResumedDeployReason is added in a deployment when it is resumed.
+	ResumedDeployReason = "DeploymentResumed"
+	// FailedRCCreateReason is added in a deployment when it cannot create its new RC.
+	FailedRCCreateReason = "ReplicationControllerCreateError"
+	// FailedRCScaleReason is added in a deployment when one of its RCs cannot be scaled.
+	FailedRCScaleReason = "ReplicationControllerScaleError"
+	// MinimumReplicasAvailable is added in a deployment when it has its minimum replicas required available.
+	MinimumReplicasAvailable = "MinimumReplicasAvailable"
+	// MinimumReplicasUnavailable is added in a deployment when it doesn't have the minimum required replicas
+	// available.
+	MinimumReplicasUnavailable = "MinimumReplicasUnavailable"
+)
+
 // Returns the old RCs targetted by the given Deployment.
 func GetOldRCs(deployment extensions.Deployment, c client.Interface) ([]*api.ReplicationController, error) {
 	namespace := deployment.ObjectMeta.Namespace
@@ -64,6 +94,33 @@ func GetOldRCs(deployment extensions.Deployment, c client.Interface) ([]*api.Rep
 	return requiredRCs, nil
 }
 
+// Returns all the old RCs of the given Deployment, including the ones that
+// have already been scaled down to zero and no longer select any pods.
+// An RC belongs to the deployment if the deployment selector matches the
+// labels of its pod template.
+func GetAllOldRCs(deployment extensions.Deployment, c client.Interface) ([]*api.ReplicationController, error) {
+	namespace := deployment.ObjectMeta.Namespace
+	rcList, err := c.ReplicationControllers(namespace).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("error listing replication controllers: %v", err)
+	}
+	selector := labels.SelectorFromSet(deployment.Spec.Selector)
+	newRCTemplate := GetNewRCTemplate(deployment)
+	allOldRCs := []*api.ReplicationController{}
+	for i := range rcList.Items {
+		rc := &rcList.Items[i]
+		if rc.Spec.Template == nil || !selector.Matches(labels.Set(rc.Spec.Template.Labels)) {
+			continue
+		}
+		// Filter out RC that has the same pod template spec as the deployment - that is the new RC.
+		if api.Semantic.DeepEqual(rc.Spec.Template, &newRCTemplate) {
+			continue
+		}
+		allOldRCs = append(allOldRCs, rc)
+	}
+	return allOldRCs, nil
+}
+
 // Returns an RC that matches the intent of the given deployment.
 // Returns nil if the new RC doesnt exist yet.
 func GetNewRC(deployment extensions.Deployment, c client.Interface) (*api.ReplicationController, error) {
@@ -114,6 +171,22 @@ func CloneAndAddLabel(labels map[string]string, labelKey string, labelValue uint
 	return newLabels
 }
 
+// Clones the given map and returns a new map with the given key removed.
+// Returns the given map, if labelKey is empty.
+func CloneAndRemoveLabel(labels map[string]string, labelKey string) map[string]string {
+	if labelKey == "" {
+		// Dont need to remove a label.
+		return labels
+	}
+	// Clone.
+	newLabels := map[string]string{}
+	for key, value := range labels {
+		newLabels[key] = value
+	}
+	delete(newLabels, labelKey)
+	return newLabels
+}
+
 func GetPodTemplateSpecHash(template api.PodTemplateSpec) uint32 {
 	podTemplateSpecHasher := adler32.New()
 	util.DeepHashObject(podTemplateSpecHasher, template)
@@ -145,6 +218,23 @@ func GetAvailablePodsForRCs(c client.Interface, rcs []*api.ReplicationController
 	return readyPodCount, nil
 }
 
+// Returns the number of pods corresponding to the given RCs that have not
+// terminated yet. Pods that are being deleted are still counted, since their
+// containers may still be running.
+func GetActivePodCountForRCs(c client.Interface, rcs []*api.ReplicationController) (int, error) {
+	allPods, err := getPodsForRCs(c, rcs)
+	if err != nil {
+		return 0, err
+	}
+	activePodCount := 0
+	for _, pod := range allPods {
+		if pod.Status.Phase != api.PodSucceeded && pod.Status.Phase != api.PodFailed {
+			activePodCount++
+		}
+	}
+	return activePodCount, nil
+}
+
 func getPodsForRCs(c client.Interface, replicationControllers []*api.ReplicationController) ([]api.Pod, error) {
 	allPods := []api.Pod{}
 	for _, rc := range replicationControllers {
@@ -156,3 +246,150 @@ func getPodsForRCs(c client.Interface, replicationControllers []*api.Replication
 	}
 	return allPods, nil
 }
+
+// Revision returns the revision number of the given RC, as recorded in its
+// revision annotation. RCs without the annotation are at revision 0.
+func Revision(rc *api.ReplicationController) (int64, error) {
+	v, ok := rc.Annotations[RevisionAnnotation]
+	if !ok {
+		return 0, nil
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
+
+// MaxRevision finds the highest revision in the given RCs.
+func MaxRevision(rcs []*api.ReplicationController) int64 {
+	max := int64(0)
+	for _, rc := range rcs {
+		if v, err := Revision(rc); err == nil && v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+// LastRevision finds the second highest revision in the given RCs, which is
+// the revision that was rolled out right before the current one.
+func LastRevision(rcs []*api.ReplicationController) int64 {
+	max, secMax := int64(0), int64(0)
+	for _, rc := range rcs {
+		v, err := Revision(rc)
+		if err != nil {
+			continue
+		}
+		if v > max {
+			secMax = max
+			max = v
+		} else if v < max && v > secMax {
+			secMax = v
+		}
+	}
+	return secMax
+}
+
+// MaxUnavailable returns the number of pods of the given deployment that are
+// allowed to be unavailable during a rollout.
+func MaxUnavailable(deployment extensions.Deployment) (int, error) {
+	if deployment.Spec.Strategy.Type != extensions.RollingUpdateDeploymentStrategyType {
+		return 0, nil
+	}
+	maxUnavailable, isPercent, err := util.GetIntOrPercentValue(&deployment.Spec.Strategy.RollingUpdate.MaxUnavailable)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for MaxUnavailable: %v", err)
+	}
+	if isPercent {
+		maxUnavailable = util.GetValueFromPercent(maxUnavailable, deployment.Spec.Replicas)
+	}
+	return maxUnavailable, nil
+}
+
+// NewDeploymentCondition creates a new deployment condition.
+func NewDeploymentCondition(condType extensions.DeploymentConditionType, status api.ConditionStatus, reason, message string) extensions.DeploymentCondition {
+	return extensions.DeploymentCondition{
+		Type:               condType,
+		Status:             status,
+		LastUpdateTime:     unversioned.Now(),
+		LastTransitionTime: unversioned.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// GetDeploymentCondition returns the condition with the provided type, or nil
+// if the status does not have it.
+func GetDeploymentCondition(status extensions.DeploymentStatus, condType extensions.DeploymentConditionType) *extensions.DeploymentCondition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == condType {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetDeploymentCondition updates the status to include the provided condition.
+// Nothing changes if the status already has the condition with the same status
+// and reason. The transition time is kept if the condition status is unchanged.
+func SetDeploymentCondition(status *extensions.DeploymentStatus, condition extensions.DeploymentCondition) {
+	currentCond := GetDeploymentCondition(*status, condition.Type)
+	if currentCond != nil && currentCond.Status == condition.Status && currentCond.Reason == condition.Reason {
+		return
+	}
+	if currentCond != nil && currentCond.Status == condition.Status {
+		condition.LastTransitionTime = currentCond.LastTransitionTime
+	}
+	RemoveDeploymentCondition(status, condition.Type)
+	status.Conditions = append(status.Conditions, condition)
+}
+
+// RemoveDeploymentCondition removes the condition with the provided type from the status.
+func RemoveDeploymentCondition(status *extensions.DeploymentStatus, condType extensions.DeploymentConditionType) {
+	conditions := []extensions.DeploymentCondition{}
+	for _, c := range status.Conditions {
+		if c.Type != condType {
+			conditions = append(conditions, c)
+		}
+	}
+	status.Conditions = conditions
+}
+
+// DeploymentComplete tells whether the given status of a deployment describes
+// a finished rollout: every desired pod runs the latest template and is available.
+func DeploymentComplete(deployment extensions.Deployment, newStatus extensions.DeploymentStatus) bool {
+	return newStatus.UpdatedReplicas == deployment.Spec.Replicas &&
+		newStatus.Replicas == deployment.Spec.Replicas &&
+		newStatus.AvailableReplicas == deployment.Spec.Replicas &&
+		newStatus.ObservedGeneration >= deployment.Generation
+}
+
+// DeploymentProgressing tells whether the deployment made progress since its
+// current status was recorded: new pods were created or became available, or
+// old pods went away.
+func DeploymentProgressing(deployment extensions.Deployment, newStatus extensions.DeploymentStatus) bool {
+	oldStatus := deployment.Status
+	oldStatusOldReplicas := oldStatus.Replicas - oldStatus.UpdatedReplicas
+	newStatusOldReplicas := newStatus.Replicas - newStatus.UpdatedReplicas
+	return newStatus.UpdatedReplicas > oldStatus.UpdatedReplicas ||
+		newStatusOldReplicas < oldStatusOldReplicas ||
+		newStatus.AvailableReplicas > oldStatus.AvailableReplicas
+}
+
+// DeploymentTimedOut tells whether the deployment made no progress within its
+// progress deadline, according to the given status.
+func DeploymentTimedOut(deployment extensions.Deployment, newStatus extensions.DeploymentStatus) bool {
+	if deployment.Spec.ProgressDeadlineSeconds == nil {
+		return false
+	}
+	condition := GetDeploymentCondition(newStatus, extensions.DeploymentProgressing)
+	if condition == nil {
+		return false
+	}
+	if condition.Reason == TimedOutReason {
+		return true
+	}
+	if condition.Reason == NewRCAvailableReason || condition.Reason == PausedDeployReason {
+		// Finished or paused rollouts cannot time out.
+		return false
+	}
+	deadline := time.Duration(*deployment.Spec.ProgressDeadlineSeconds) * time.Second
+	return condition.LastUpdateTime.Add(deadline).Before(time.Now())
+}
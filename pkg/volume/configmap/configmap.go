@@ -0,0 +1,380 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configmap
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/types"
+	"k8s.io/kubernetes/pkg/util"
+	utilio "k8s.io/kubernetes/pkg/util/io"
+	"k8s.io/kubernetes/pkg/util/mount"
+	"k8s.io/kubernetes/pkg/volume"
+	volumeutil "k8s.io/kubernetes/pkg/volume/util"
+)
+
+// ProbeVolumePlugin is the entry point for plugin detection in a package.
+func ProbeVolumePlugins() []volume.VolumePlugin {
+	return []volume.VolumePlugin{&configMapPlugin{}}
+}
+
+const (
+	configMapPluginName = "kubernetes.io/configmap"
+)
+
+// configMapPlugin implements the VolumePlugin interface.
+type configMapPlugin struct {
+	host volume.VolumeHost
+}
+
+var _ volume.VolumePlugin = &configMapPlugin{}
+
+func (plugin *configMapPlugin) Init(host volume.VolumeHost) {
+	plugin.host = host
+}
+
+func (plugin *configMapPlugin) Name() string {
+	return configMapPluginName
+}
+
+func (plugin *configMapPlugin) CanSupport(spec *volume.Spec) bool {
+	return spec.Volume != nil && spec.Volume.ConfigMap != nil
+}
+
+func (plugin *configMapPlugin) NewBuilder(spec *volume.Spec, pod *api.Pod, opts volume.VolumeOptions) (volume.Builder, error) {
+	return &configMapVolumeBuilder{
+		configMapVolume: &configMapVolume{spec.Name(), pod.UID, plugin, plugin.host.GetMounter(), plugin.host.GetWriter()},
+		source:          *spec.Volume.ConfigMap,
+		pod:             *pod,
+		opts:            &opts}, nil
+}
+
+func (plugin *configMapPlugin) NewCleaner(volName string, podUID types.UID) (volume.Cleaner, error) {
+	return &configMapVolumeCleaner{&configMapVolume{volName, podUID, plugin, plugin.host.GetMounter(), plugin.host.GetWriter()}}, nil
+}
+
+type configMapVolume struct {
+	volName string
+	podUID  types.UID
+	plugin  *configMapPlugin
+	mounter mount.Interface
+	writer  utilio.Writer
+}
+
+var _ volume.Volume = &configMapVolume{}
+
+func (sv *configMapVolume) GetPath() string {
+	return sv.plugin.host.GetPodVolumeDir(sv.podUID, util.EscapeQualifiedNameForDisk(configMapPluginName), sv.volName)
+}
+
+// configMapVolumeBuilder handles retrieving configMaps from the API server
+// and placing them into the volume on the host.  Because the kubelet sets up
+// the volumes of a pod on every sync, the contents of the volume are refreshed
+// whenever the configMap changes.
+type configMapVolumeBuilder struct {
+	*configMapVolume
+
+	source api.ConfigMapVolumeSource
+	pod    api.Pod
+	opts   *volume.VolumeOptions
+}
+
+var _ volume.Builder = &configMapVolumeBuilder{}
+
+func (sv *configMapVolume) GetAttributes() volume.Attributes {
+	return volume.Attributes{
+		ReadOnly:                    true,
+		Managed:                     true,
+		SupportsOwnershipManagement: true,
+		SupportsSELinux:             true,
+	}
+}
+
+func (b *configMapVolumeBuilder) SetUp() error {
+	return b.SetUpAt(b.GetPath())
+}
+
+// This is the spec for the volume that this plugin wraps.
+var wrappedVolumeSpec = &volume.Spec{
+	Volume: &api.Volume{VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}},
+}
+
+func (b *configMapVolumeBuilder) getMetaDir() string {
+	return path.Join(b.plugin.host.GetPodPluginDir(b.podUID, util.EscapeQualifiedNameForDisk(configMapPluginName)), b.volName)
+}
+
+func (b *configMapVolumeBuilder) SetUpAt(dir string) error {
+	// If the plugin readiness file is present for this volume and the
+	// setup dir exists, only the contents of the volume need refreshing.
+	setUp := volumeutil.IsReady(b.getMetaDir())
+	if setUp {
+		if _, err := os.Stat(dir); err != nil {
+			if !os.IsNotExist(err) {
+				return err
+			}
+			setUp = false
+		}
+	}
+
+	if !setUp {
+		glog.V(3).Infof("Setting up volume %v for pod %v at %v", b.volName, b.pod.UID, dir)
+
+		// Wrap EmptyDir, let it do the setup.
+		wrapped, err := b.plugin.host.NewWrapperBuilder(wrappedVolumeSpec, &b.pod, *b.opts)
+		if err != nil {
+			return err
+		}
+		if err := wrapped.SetUpAt(dir); err != nil {
+			return err
+		}
+	}
+
+	kubeClient := b.plugin.host.GetKubeClient()
+	if kubeClient == nil {
+		return fmt.Errorf("Cannot setup configMap volume %v because kube client is not configured", b.volName)
+	}
+
+	configMap, err := kubeClient.ConfigMaps(b.pod.Namespace).Get(b.source.Name)
+	if err != nil {
+		glog.Errorf("Couldn't get configMap %v/%v: %v", b.pod.Namespace, b.source.Name, err)
+		if setUp {
+			// Keep serving the last known contents until the API server is reachable again.
+			return nil
+		}
+		return err
+	}
+
+	payload, err := makePayload(b.source.Items, configMap)
+	if err != nil {
+		return err
+	}
+	if err := b.writePayload(dir, payload); err != nil {
+		return err
+	}
+
+	volumeutil.SetReady(b.getMetaDir())
+
+	return nil
+}
+
+// makePayload returns the file contents of the volume keyed by their path
+// relative to the volume directory.
+func makePayload(mappings []api.KeyToPath, configMap *api.ConfigMap) (map[string]string, error) {
+	payload := make(map[string]string, len(configMap.Data))
+	if len(mappings) == 0 {
+		for key, value := range configMap.Data {
+			payload[key] = value
+		}
+		return payload, nil
+	}
+
+	for _, ktp := range mappings {
+		content, ok := configMap.Data[ktp.Key]
+		if !ok {
+			return nil, fmt.Errorf("configMap %v/%v has no key %v", configMap.Namespace, configMap.Name, ktp.Key)
+		}
+		payload[path.Clean(ktp.Path)] = content
+	}
+	return payload, nil
+}
+
+const (
+	// dataDirName is the symlink to the timestamped directory holding the
+	// current contents of the volume. Names starting with ".." are reserved
+	// for the implementation.
+	dataDirName    = "..data"
+	dataDirTmpName = "..data_tmp"
+)
+
+// writePayload replaces the contents of the volume at dir with payload so
+// that readers never see a mix of old and new files:
+//
+//  1. The payload is written to a new hidden timestamped directory.
+//  2. A temporary symlink to it is created and renamed over dir/..data, which
+//     atomically switches the volume to the new contents.
+//  3. Every file of the payload is presented as a relative symlink through
+//     ..data, e.g. <dir>/path/to/key -> ../../..data/path/to/key, and the
+//     symlinks of keys no longer in the payload are removed.
+//  4. The previous timestamped directory is removed.
+//
+// Nothing is written if the current contents already match payload.
+func (b *configMapVolumeBuilder) writePayload(dir string, payload map[string]string) error {
+	dataDir := path.Join(dir, dataDirName)
+	oldTimestampDir, err := os.Readlink(dataDir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(oldTimestampDir) > 0 && payloadMatches(path.Join(dir, oldTimestampDir), payload) {
+		return b.updateSymlinks(dir, payload)
+	}
+
+	timestampDir, err := b.writeTimestampDir(dir, payload)
+	if err != nil {
+		os.RemoveAll(timestampDir)
+		glog.Errorf("Error writing configMap data to host path %v: %v", dir, err)
+		return err
+	}
+	tmpLink := path.Join(dir, dataDirTmpName)
+	os.Remove(tmpLink)
+	if err := os.Symlink(filepath.Base(timestampDir), tmpLink); err != nil {
+		os.RemoveAll(timestampDir)
+		return err
+	}
+	if err := os.Rename(tmpLink, dataDir); err != nil {
+		os.Remove(tmpLink)
+		os.RemoveAll(timestampDir)
+		return err
+	}
+	glog.V(3).Infof("Updated configMap data %v/%v in volume %v to %v", b.pod.Namespace, b.source.Name, b.volName, timestampDir)
+
+	if err := b.updateSymlinks(dir, payload); err != nil {
+		return err
+	}
+	if len(oldTimestampDir) > 0 {
+		return os.RemoveAll(path.Join(dir, oldTimestampDir))
+	}
+	return nil
+}
+
+// writeTimestampDir writes payload into a new hidden timestamped directory
+// under dir and returns its path.
+func (b *configMapVolumeBuilder) writeTimestampDir(dir string, payload map[string]string) (string, error) {
+	timestampDir, err := ioutil.TempDir(dir, ".."+time.Now().Format("2006_01_02_15_04_05."))
+	if err != nil {
+		return "", err
+	}
+	for relPath, content := range payload {
+		hostFilePath := path.Join(timestampDir, relPath)
+		if err := os.MkdirAll(path.Dir(hostFilePath), 0755); err != nil {
+			return timestampDir, err
+		}
+		glog.V(3).Infof("Writing configMap data %v/%v/%v (%v bytes) to host file %v", b.pod.Namespace, b.source.Name, relPath, len(content), hostFilePath)
+		if err := b.writer.WriteFile(hostFilePath, []byte(content), 0644); err != nil {
+			return timestampDir, err
+		}
+	}
+	// TempDir creates the directory with mode 0700.
+	return timestampDir, os.Chmod(timestampDir, 0755)
+}
+
+// updateSymlinks presents every file of payload in dir as a symlink into
+// ..data and removes the files of dir that are not part of payload.
+func (b *configMapVolumeBuilder) updateSymlinks(dir string, payload map[string]string) error {
+	for relPath := range payload {
+		hostFilePath := path.Join(dir, relPath)
+		target := path.Join(strings.Repeat("../", strings.Count(relPath, "/")), dataDirName, relPath)
+		if existing, err := os.Readlink(hostFilePath); err == nil && existing == target {
+			continue
+		}
+		if err := os.MkdirAll(path.Dir(hostFilePath), 0755); err != nil {
+			return err
+		}
+		// Replace whatever is in the way, such as a file written in place by
+		// an earlier version of this plugin.
+		if err := os.RemoveAll(hostFilePath); err != nil {
+			return err
+		}
+		if err := os.Symlink(target, hostFilePath); err != nil {
+			return err
+		}
+	}
+
+	return filepath.Walk(dir, func(hostFilePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if hostFilePath == dir {
+			return nil
+		}
+		if strings.HasPrefix(info.Name(), "..") && filepath.Dir(hostFilePath) == filepath.Clean(dir) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(dir, hostFilePath)
+		if err != nil {
+			return err
+		}
+		if _, ok := payload[filepath.ToSlash(relPath)]; ok {
+			return nil
+		}
+		glog.V(3).Infof("Removing stale configMap data %v from volume %v", relPath, b.volName)
+		return os.Remove(hostFilePath)
+	})
+}
+
+// payloadMatches returns true if dataDir holds exactly the files of payload.
+func payloadMatches(dataDir string, payload map[string]string) bool {
+	count := 0
+	err := filepath.Walk(dataDir, func(hostFilePath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relPath, err := filepath.Rel(dataDir, hostFilePath)
+		if err != nil {
+			return err
+		}
+		content, ok := payload[filepath.ToSlash(relPath)]
+		if !ok {
+			return fmt.Errorf("unexpected file %v", relPath)
+		}
+		existing, err := ioutil.ReadFile(hostFilePath)
+		if err != nil {
+			return err
+		}
+		if string(existing) != content {
+			return fmt.Errorf("content of %v changed", relPath)
+		}
+		count++
+		return nil
+	})
+	return err == nil && count == len(payload)
+}
+
+// configMapVolumeCleaner handles cleaning up configMap volumes.
+type configMapVolumeCleaner struct {
+	*configMapVolume
+}
+
+var _ volume.Cleaner = &configMapVolumeCleaner{}
+
+func (c *configMapVolumeCleaner) TearDown() error {
+	return c.TearDownAt(c.GetPath())
+}
+
+func (c *configMapVolumeCleaner) TearDownAt(dir string) error {
+	glog.V(3).Infof("Tearing down volume %v for pod %v at %v", c.volName, c.podUID, dir)
+
+	// Wrap EmptyDir, let it do the teardown.
+	wrapped, err := c.plugin.host.NewWrapperCleaner(wrappedVolumeSpec, c.podUID)
+	if err != nil {
+		return err
+	}
+	return wrapped.TearDownAt(dir)
+}
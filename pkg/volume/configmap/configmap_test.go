@@ -0,0 +1,308 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configmap
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/types"
+	"k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/empty_dir"
+	"k8s.io/kubernetes/pkg/volume/util"
+)
+
+func newTestHost(t *testing.T, client client.Interface) (string, volume.VolumeHost) {
+	tempDir, err := ioutil.TempDir("/tmp", "configmap_volume_test.")
+	if err != nil {
+		t.Fatalf("can't make a temp rootdir: %v", err)
+	}
+
+	return tempDir, volume.NewFakeVolumeHost(tempDir, client, empty_dir.ProbeVolumePlugins())
+}
+
+// newTestClient returns a client whose configMap gets always return the
+// current value of configMap.
+func newTestClient(configMap *api.ConfigMap) *testclient.Fake {
+	client := &testclient.Fake{}
+	client.AddReactor("get", "configmaps", func(action testclient.Action) (bool, runtime.Object, error) {
+		return true, configMap, nil
+	})
+	return client
+}
+
+func TestCanSupport(t *testing.T) {
+	pluginMgr := volume.VolumePluginMgr{}
+	_, host := newTestHost(t, nil)
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+	if plugin.Name() != configMapPluginName {
+		t.Errorf("Wrong name: %s", plugin.Name())
+	}
+	if !plugin.CanSupport(&volume.Spec{Volume: &api.Volume{VolumeSource: api.VolumeSource{ConfigMap: &api.ConfigMapVolumeSource{LocalObjectReference: api.LocalObjectReference{Name: ""}}}}}) {
+		t.Errorf("Expected true")
+	}
+	if plugin.CanSupport(&volume.Spec{}) {
+		t.Errorf("Expected false")
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	var (
+		testPodUID     = types.UID("test_pod_uid")
+		testVolumeName = "test_volume_name"
+		testNamespace  = "test_configmap_namespace"
+		testName       = "test_configmap_name"
+
+		volumeSpec = volumeSpec(testVolumeName, testName, nil)
+		configMap  = configMap(testNamespace, testName)
+		client     = newTestClient(&configMap)
+		pluginMgr  = volume.VolumePluginMgr{}
+		_, host    = newTestHost(t, client)
+	)
+
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: testNamespace, UID: testPodUID}}
+	builder, err := plugin.NewBuilder(volume.NewSpecFromVolume(volumeSpec), pod, volume.VolumeOptions{})
+	if err != nil {
+		t.Errorf("Failed to make a new Builder: %v", err)
+	}
+	if builder == nil {
+		t.Fatalf("Got a nil Builder")
+	}
+
+	volumePath := builder.GetPath()
+	if !strings.HasSuffix(volumePath, fmt.Sprintf("pods/test_pod_uid/volumes/kubernetes.io~configmap/test_volume_name")) {
+		t.Errorf("Got unexpected path: %s", volumePath)
+	}
+
+	err = builder.SetUp()
+	if err != nil {
+		t.Errorf("Failed to setup volume: %v", err)
+	}
+	if _, err := os.Stat(volumePath); err != nil {
+		if os.IsNotExist(err) {
+			t.Errorf("SetUp() failed, volume path not created: %s", volumePath)
+		} else {
+			t.Errorf("SetUp() failed: %v", err)
+		}
+	}
+
+	doTestConfigMapDataInVolume(volumePath, configMap.Data, t)
+	doTestCleanAndTeardown(plugin, testPodUID, testVolumeName, volumePath, t)
+}
+
+// Test that a second SetUp of a ready volume picks up changes to the configMap.
+func TestPluginRefresh(t *testing.T) {
+	var (
+		testPodUID     = types.UID("test_pod_uid2")
+		testVolumeName = "test_volume_name"
+		testNamespace  = "test_configmap_namespace"
+		testName       = "test_configmap_name"
+
+		volumeSpec = volumeSpec(testVolumeName, testName, nil)
+		configMap  = configMap(testNamespace, testName)
+		client     = newTestClient(&configMap)
+		pluginMgr  = volume.VolumePluginMgr{}
+		_, host    = newTestHost(t, client)
+	)
+
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: testNamespace, UID: testPodUID}}
+	builder, err := plugin.NewBuilder(volume.NewSpecFromVolume(volumeSpec), pod, volume.VolumeOptions{})
+	if err != nil {
+		t.Fatalf("Failed to make a new Builder: %v", err)
+	}
+	if err := builder.SetUp(); err != nil {
+		t.Fatalf("Failed to setup volume: %v", err)
+	}
+	volumePath := builder.GetPath()
+	doTestConfigMapDataInVolume(volumePath, configMap.Data, t)
+	oldDataDir, err := os.Readlink(path.Join(volumePath, dataDirName))
+	if err != nil {
+		t.Fatalf("Expected %v to link to the current data: %v", dataDirName, err)
+	}
+
+	configMap.Data = map[string]string{
+		"data-1": "value-1",
+		"data-2": "updated-value-2",
+		"data-4": "value-4",
+	}
+	if err := builder.SetUp(); err != nil {
+		t.Fatalf("Failed to refresh volume: %v", err)
+	}
+	doTestConfigMapDataInVolume(volumePath, configMap.Data, t)
+	if _, err := os.Lstat(path.Join(volumePath, "data-3")); !os.IsNotExist(err) {
+		t.Errorf("Expected the file of the removed key data-3 to be deleted, got: %v", err)
+	}
+	if _, err := os.Stat(path.Join(volumePath, oldDataDir)); !os.IsNotExist(err) {
+		t.Errorf("Expected the previous data directory %v to be removed, got: %v", oldDataDir, err)
+	}
+	for key := range configMap.Data {
+		if info, err := os.Lstat(path.Join(volumePath, key)); err != nil || info.Mode()&os.ModeSymlink == 0 {
+			t.Errorf("Expected %v to be a symlink into %v, got %v, %v", key, dataDirName, info, err)
+		}
+	}
+
+	doTestCleanAndTeardown(plugin, testPodUID, testVolumeName, volumePath, t)
+}
+
+func TestPluginItems(t *testing.T) {
+	var (
+		testPodUID     = types.UID("test_pod_uid3")
+		testVolumeName = "test_volume_name"
+		testNamespace  = "test_configmap_namespace"
+		testName       = "test_configmap_name"
+
+		items = []api.KeyToPath{
+			{Key: "data-1", Path: "path/to/first"},
+			{Key: "data-2", Path: "second"},
+		}
+		spec          = volumeSpec(testVolumeName, testName, items)
+		configMap     = configMap(testNamespace, testName)
+		client        = newTestClient(&configMap)
+		pluginMgr     = volume.VolumePluginMgr{}
+		rootDir, host = newTestHost(t, client)
+	)
+
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+
+	// The ready file exists but the volume dir does not, which is the state
+	// the system will be in after reboot.
+	podMetadataDir := fmt.Sprintf("%v/pods/test_pod_uid3/plugins/kubernetes.io~configmap/test_volume_name", rootDir)
+	util.SetReady(podMetadataDir)
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: testNamespace, UID: testPodUID}}
+	builder, err := plugin.NewBuilder(volume.NewSpecFromVolume(spec), pod, volume.VolumeOptions{})
+	if err != nil {
+		t.Fatalf("Failed to make a new Builder: %v", err)
+	}
+	if err := builder.SetUp(); err != nil {
+		t.Fatalf("Failed to setup volume: %v", err)
+	}
+	volumePath := builder.GetPath()
+	doTestConfigMapDataInVolume(volumePath, map[string]string{
+		"path/to/first": "value-1",
+		"second":        "value-2",
+	}, t)
+	if _, err := os.Stat(path.Join(volumePath, "data-3")); !os.IsNotExist(err) {
+		t.Errorf("Expected unlisted key data-3 to be absent, got: %v", err)
+	}
+
+	builder, err = plugin.NewBuilder(volume.NewSpecFromVolume(volumeSpec(testVolumeName, testName, []api.KeyToPath{{Key: "missing", Path: "missing"}})), pod, volume.VolumeOptions{})
+	if err != nil {
+		t.Fatalf("Failed to make a new Builder: %v", err)
+	}
+	if err := builder.SetUp(); err == nil {
+		t.Errorf("Expected an error for a key missing from the configMap")
+	}
+
+	doTestCleanAndTeardown(plugin, testPodUID, testVolumeName, volumePath, t)
+}
+
+func volumeSpec(volumeName, configMapName string, items []api.KeyToPath) *api.Volume {
+	return &api.Volume{
+		Name: volumeName,
+		VolumeSource: api.VolumeSource{
+			ConfigMap: &api.ConfigMapVolumeSource{
+				LocalObjectReference: api.LocalObjectReference{Name: configMapName},
+				Items:                items,
+			},
+		},
+	}
+}
+
+func configMap(namespace, name string) api.ConfigMap {
+	return api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Data: map[string]string{
+			"data-1": "value-1",
+			"data-2": "value-2",
+			"data-3": "value-3",
+		},
+	}
+}
+
+func doTestConfigMapDataInVolume(volumePath string, data map[string]string, t *testing.T) {
+	for key, value := range data {
+		configMapDataHostPath := path.Join(volumePath, key)
+		if _, err := os.Stat(configMapDataHostPath); err != nil {
+			t.Fatalf("SetUp() failed, couldn't find configMap data on disk: %v", configMapDataHostPath)
+		} else {
+			actualBytes, err := ioutil.ReadFile(configMapDataHostPath)
+			if err != nil {
+				t.Fatalf("Couldn't read configMap data from: %v", configMapDataHostPath)
+			}
+
+			actualValue := string(actualBytes)
+			if value != actualValue {
+				t.Errorf("Unexpected value; expected %q, got %q", value, actualValue)
+			}
+		}
+	}
+}
+
+func doTestCleanAndTeardown(plugin volume.VolumePlugin, podUID types.UID, testVolumeName, volumePath string, t *testing.T) {
+	cleaner, err := plugin.NewCleaner(testVolumeName, podUID)
+	if err != nil {
+		t.Errorf("Failed to make a new Cleaner: %v", err)
+	}
+	if cleaner == nil {
+		t.Errorf("Got a nil Cleaner")
+	}
+
+	if err := cleaner.TearDown(); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if _, err := os.Stat(volumePath); err == nil {
+		t.Errorf("TearDown() failed, volume path still exists: %s", volumePath)
+	} else if !os.IsNotExist(err) {
+		t.Errorf("SetUp() failed: %v", err)
+	}
+}
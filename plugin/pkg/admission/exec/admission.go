@@ -81,8 +81,11 @@ func (d *denyExec) Admit(a admission.Attributes) (err error) {
 	if !ok {
 		return errors.NewBadRequest("a connect request was received, but could not convert the request object.")
 	}
-	// Only handle exec or attach requests on pods
-	if connectRequest.ResourcePath != "pods/exec" && connectRequest.ResourcePath != "pods/attach" {
+	// Only handle exec, attach or debug requests on pods. A debug container
+	// joins the namespaces of the pod, so it is as escalating as an exec.
+	switch connectRequest.ResourcePath {
+	case "pods/exec", "pods/attach", "pods/debug":
+	default:
 		return nil
 	}
 	pod, err := d.client.Pods(a.GetNamespace()).Get(connectRequest.Name)
@@ -121,6 +121,18 @@ func testAdmission(t *testing.T, pod *api.Pod, handler *denyExec, shouldAccept b
 			t.Errorf("An error was expected from the admission handler. Received nil")
 		}
 	}
+
+	// pods/debug
+	{
+		req := &rest.ConnectRequest{Name: pod.Name, ResourcePath: "pods/debug"}
+		err := handler.Admit(admission.NewAttributesRecord(req, "Pod", "test", "name", "pods", "debug", admission.Connect, nil))
+		if shouldAccept && err != nil {
+			t.Errorf("Unexpected error returned from admission handler: %v", err)
+		}
+		if !shouldAccept && err == nil {
+			t.Errorf("An error was expected from the admission handler. Received nil")
+		}
+	}
 }
 
 // Test to ensure legacy admission controller works as expected.
@@ -70,7 +70,8 @@ func (p *provision) Admit(a admission.Attributes) (err error) {
 	if err != nil {
 		return admission.NewForbidden(a, err)
 	}
-	if exists {
+	// A dry run must not leave a namespace behind.
+	if exists || a.IsDryRun() {
 		return nil
 	}
 	_, err = p.client.Namespaces().Create(namespace)
@@ -133,7 +133,8 @@ func (q *quota) Admit(a admission.Attributes) (err error) {
 				return admission.NewForbidden(a, err)
 			}
 
-			if dirty {
+			// A dry run is checked against the quota, but never charged to it.
+			if dirty && !a.IsDryRun() {
 				// construct a usage record
 				usage := api.ResourceQuota{
 					ObjectMeta: api.ObjectMeta{
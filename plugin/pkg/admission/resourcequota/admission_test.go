@@ -100,6 +100,39 @@ func TestAdmissionIgnoresSubresources(t *testing.T) {
 
 }
 
+func TestAdmissionDryRun(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{"namespace": cache.MetaNamespaceIndexFunc})
+	client := &testclient.Fake{}
+	handler := createResourceQuota(client, indexer)
+
+	quota := &api.ResourceQuota{}
+	quota.Name = "quota"
+	quota.Namespace = "test"
+	quota.Status = api.ResourceQuotaStatus{
+		Hard: api.ResourceList{},
+		Used: api.ResourceList{},
+	}
+	quota.Status.Hard[api.ResourceMemory] = resource.MustParse("2Gi")
+	quota.Status.Used[api.ResourceMemory] = resource.MustParse("1Gi")
+
+	indexer.Add(quota)
+
+	newPod := validPod("123", 1, getResourceRequirements(getResourceList("100m", "2Gi"), getResourceList("", "")))
+	err := handler.Admit(admission.NewDryRunAttributes(admission.NewAttributesRecord(newPod, "Pod", newPod.Namespace, newPod.Name, "pods", "", admission.Create, nil)))
+	if err == nil {
+		t.Errorf("Expected an error because the pod exceeded allowed quota")
+	}
+
+	newPod = validPod("123", 1, getResourceRequirements(getResourceList("100m", "500Mi"), getResourceList("", "")))
+	err = handler.Admit(admission.NewDryRunAttributes(admission.NewAttributesRecord(newPod, "Pod", newPod.Namespace, newPod.Name, "pods", "", admission.Create, nil)))
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if len(client.Actions()) != 0 {
+		t.Errorf("Expected a dry run not to record usage, got %v", client.Actions())
+	}
+}
+
 func TestIncrementUsagePodResources(t *testing.T) {
 	type testCase struct {
 		testName      string
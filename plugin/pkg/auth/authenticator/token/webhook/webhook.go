@@ -0,0 +1,92 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements the authenticator.Token interface by asking a remote service
+// to review tokens.
+package webhook
+
+import (
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/auth/authenticator"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util/cache"
+	"k8s.io/kubernetes/plugin/pkg/webhook"
+)
+
+const (
+	// apiVersion and kind of the TokenReview documents sent to the remote service.
+	apiVersion = "authentication.k8s.io/v1beta1"
+	kind       = "TokenReview"
+
+	// cacheSize is the number of token reviews remembered between requests.
+	cacheSize = 4096
+)
+
+// Ensure WebhookTokenAuthenticator implements the authenticator.Token interface.
+var _ authenticator.Token = (*WebhookTokenAuthenticator)(nil)
+
+// WebhookTokenAuthenticator authenticates bearer tokens by posting a TokenReview to a remote
+// service.
+type WebhookTokenAuthenticator struct {
+	webhook       *webhook.GenericWebhook
+	responseCache *cache.LRUExpireCache
+	ttl           time.Duration
+}
+
+// New creates a WebhookTokenAuthenticator calling the service described by the kubeconfig
+// file.  The reviews returned by the service are cached for ttl.
+func New(kubeConfigFile string, ttl time.Duration) (*WebhookTokenAuthenticator, error) {
+	gw, err := webhook.New(kubeConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookTokenAuthenticator{
+		webhook:       gw,
+		responseCache: cache.NewLRUExpireCache(cacheSize),
+		ttl:           ttl,
+	}, nil
+}
+
+// AuthenticateToken asks the remote service, or the cache of its previous answers, whether
+// token is valid and which user it belongs to.
+func (w *WebhookTokenAuthenticator) AuthenticateToken(token string) (user.Info, bool, error) {
+	var status TokenReviewStatus
+	if cached, ok := w.responseCache.Get(token); ok {
+		status = cached.(TokenReviewStatus)
+	} else {
+		review := &TokenReview{
+			TypeMeta: unversioned.TypeMeta{APIVersion: apiVersion, Kind: kind},
+			Spec:     TokenReviewSpec{Token: token},
+		}
+		result := &TokenReview{}
+		if err := w.webhook.Post(review, result); err != nil {
+			return nil, false, err
+		}
+		status = result.Status
+		w.responseCache.Add(token, status, w.ttl)
+	}
+
+	if !status.Authenticated {
+		return nil, false, nil
+	}
+	return &user.DefaultInfo{
+		Name:   status.User.Username,
+		UID:    status.User.UID,
+		Groups: status.User.Groups,
+	}, true, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+// writeKubeConfig writes a kubeconfig file pointing at server and returns its path.
+func writeKubeConfig(t *testing.T, server string) string {
+	f, err := ioutil.TempFile("", "webhook-kubeconfig")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+	fmt.Fprintf(f, `apiVersion: v1
+kind: Config
+clusters:
+- name: remote
+  cluster:
+    server: %s
+users:
+- name: apiserver
+  user: {}
+contexts:
+- name: webhook
+  context:
+    cluster: remote
+    user: apiserver
+current-context: webhook
+`, server)
+	return f.Name()
+}
+
+// fakeTokenService authenticates the tokens it knows about and counts the reviews it receives.
+type fakeTokenService struct {
+	users   map[string]UserInfo
+	reviews int
+}
+
+func (s *fakeTokenService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.reviews++
+	var review TokenReview
+	if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if review.APIVersion != apiVersion || review.Kind != kind {
+		http.Error(w, "unexpected review type", http.StatusBadRequest)
+		return
+	}
+	if review.Spec.Token == "broken" {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	userInfo, ok := s.users[review.Spec.Token]
+	review.Status = TokenReviewStatus{Authenticated: ok, User: userInfo}
+	json.NewEncoder(w).Encode(review)
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	service := &fakeTokenService{users: map[string]UserInfo{
+		"token1": {Username: "alice", UID: "1", Groups: []string{"admins"}},
+	}}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	kubeConfigFile := writeKubeConfig(t, server.URL)
+	defer os.Remove(kubeConfigFile)
+
+	authenticator, err := New(kubeConfigFile, time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		token           string
+		expectedUser    user.Info
+		expectedOK      bool
+		expectedErr     bool
+		expectedReviews int
+	}{
+		{token: "token1", expectedUser: &user.DefaultInfo{Name: "alice", UID: "1", Groups: []string{"admins"}}, expectedOK: true, expectedReviews: 1},
+		{token: "token2", expectedReviews: 2},
+		{token: "broken", expectedErr: true, expectedReviews: 3},
+		// Known answers, both positive and negative, are served from the cache.
+		{token: "token1", expectedUser: &user.DefaultInfo{Name: "alice", UID: "1", Groups: []string{"admins"}}, expectedOK: true, expectedReviews: 3},
+		{token: "token2", expectedReviews: 3},
+		// Errors are not cached.
+		{token: "broken", expectedErr: true, expectedReviews: 4},
+	}
+	for i, tc := range testCases {
+		userInfo, ok, err := authenticator.AuthenticateToken(tc.token)
+		if (err != nil) != tc.expectedErr {
+			t.Errorf("%d: unexpected error state: %v", i, err)
+		}
+		if ok != tc.expectedOK {
+			t.Errorf("%d: expected ok %v, got %v", i, tc.expectedOK, ok)
+		}
+		if !reflect.DeepEqual(userInfo, tc.expectedUser) {
+			t.Errorf("%d: expected user %#v, got %#v", i, tc.expectedUser, userInfo)
+		}
+		if service.reviews != tc.expectedReviews {
+			t.Errorf("%d: expected %d reviews, got %d", i, tc.expectedReviews, service.reviews)
+		}
+	}
+}
+
+func TestAuthenticateTokenCacheExpires(t *testing.T) {
+	service := &fakeTokenService{users: map[string]UserInfo{"token1": {Username: "alice"}}}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	kubeConfigFile := writeKubeConfig(t, server.URL)
+	defer os.Remove(kubeConfigFile)
+
+	authenticator, err := New(kubeConfigFile, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 1; i <= 2; i++ {
+		time.Sleep(time.Millisecond)
+		if _, ok, err := authenticator.AuthenticateToken("token1"); !ok || err != nil {
+			t.Errorf("unexpected result: %v %v", ok, err)
+		}
+		if service.reviews != i {
+			t.Errorf("expected %d reviews, got %d", i, service.reviews)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// SubjectAccessReview is the document exchanged with the remote service: the API server sends
+// the attributes of the request in the spec, and the service fills in the status.
+type SubjectAccessReview struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// Spec holds information about the request being evaluated.
+	Spec SubjectAccessReviewSpec `json:"spec"`
+
+	// Status is filled in by the remote service and indicates whether the request is allowed.
+	Status SubjectAccessReviewStatus `json:"status,omitempty"`
+}
+
+// SubjectAccessReviewSpec is a description of the access request.  Exactly one of
+// ResourceAttributes and NonResourceAttributes is set.
+type SubjectAccessReviewSpec struct {
+	// ResourceAttributes describes a request for an API resource.
+	ResourceAttributes *ResourceAttributes `json:"resourceAttributes,omitempty"`
+	// NonResourceAttributes describes a request for a path that is not an API resource.
+	NonResourceAttributes *NonResourceAttributes `json:"nonResourceAttributes,omitempty"`
+	// User is the user making the request.
+	User string `json:"user,omitempty"`
+	// Groups are the groups the user making the request is a member of.
+	Groups []string `json:"group,omitempty"`
+}
+
+// ResourceAttributes describes a request for an API resource.
+type ResourceAttributes struct {
+	// Namespace of the requested resource, empty for cluster scoped resources or requests
+	// across all namespaces.
+	Namespace string `json:"namespace,omitempty"`
+	// Verb is the kube verb of the request, such as get, list, watch, create or delete.
+	Verb string `json:"verb,omitempty"`
+	// Group is the API group of the resource.
+	Group string `json:"group,omitempty"`
+	// Resource is the requested resource.
+	Resource string `json:"resource,omitempty"`
+	// Subresource is the requested subresource, if any.
+	Subresource string `json:"subresource,omitempty"`
+	// Name is the name of the requested object, empty for list and create requests.
+	Name string `json:"name,omitempty"`
+}
+
+// NonResourceAttributes describes a request for a path that is not an API resource.
+type NonResourceAttributes struct {
+	// Path is the URL path of the request.
+	Path string `json:"path,omitempty"`
+	// Verb is the verb of the request.
+	Verb string `json:"verb,omitempty"`
+}
+
+// SubjectAccessReviewStatus is the answer of the remote service.
+type SubjectAccessReviewStatus struct {
+	// Allowed is true if the request is allowed.
+	Allowed bool `json:"allowed"`
+	// Reason optionally explains why the request was allowed or denied.
+	Reason string `json:"reason,omitempty"`
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements the authorizer.Authorizer interface by asking a remote service
+// to review the attributes of each request.
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/util/cache"
+	"k8s.io/kubernetes/plugin/pkg/webhook"
+)
+
+const (
+	// apiVersion and kind of the SubjectAccessReview documents sent to the remote service.
+	apiVersion = "authorization.k8s.io/v1beta1"
+	kind       = "SubjectAccessReview"
+
+	// cacheSize is the number of access reviews remembered between requests.
+	cacheSize = 8192
+)
+
+// Ensure WebhookAuthorizer implements the authorizer.Authorizer interface.
+var _ authorizer.Authorizer = (*WebhookAuthorizer)(nil)
+
+// WebhookAuthorizer authorizes requests by posting a SubjectAccessReview to a remote service.
+type WebhookAuthorizer struct {
+	webhook         *webhook.GenericWebhook
+	responseCache   *cache.LRUExpireCache
+	authorizedTTL   time.Duration
+	unauthorizedTTL time.Duration
+}
+
+// New creates a WebhookAuthorizer calling the service described by the kubeconfig file.
+// Requests the service allowed are remembered for authorizedTTL, and requests it denied for
+// unauthorizedTTL.
+func New(kubeConfigFile string, authorizedTTL, unauthorizedTTL time.Duration) (*WebhookAuthorizer, error) {
+	gw, err := webhook.New(kubeConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookAuthorizer{
+		webhook:         gw,
+		responseCache:   cache.NewLRUExpireCache(cacheSize),
+		authorizedTTL:   authorizedTTL,
+		unauthorizedTTL: unauthorizedTTL,
+	}, nil
+}
+
+// Authorize asks the remote service, or the cache of its previous answers, whether the request
+// described by attr is allowed.
+func (w *WebhookAuthorizer) Authorize(attr authorizer.Attributes) error {
+	review := &SubjectAccessReview{
+		TypeMeta: unversioned.TypeMeta{APIVersion: apiVersion, Kind: kind},
+		Spec: SubjectAccessReviewSpec{
+			User:   attr.GetUserName(),
+			Groups: attr.GetGroups(),
+		},
+	}
+	if attr.IsResourceRequest() {
+		review.Spec.ResourceAttributes = &ResourceAttributes{
+			Namespace:   attr.GetNamespace(),
+			Verb:        attr.GetVerb(),
+			Group:       attr.GetAPIGroup(),
+			Resource:    attr.GetResource(),
+			Subresource: attr.GetSubresource(),
+			Name:        attr.GetName(),
+		}
+	} else {
+		review.Spec.NonResourceAttributes = &NonResourceAttributes{
+			Path: attr.GetPath(),
+			Verb: attr.GetVerb(),
+		}
+	}
+
+	key, err := json.Marshal(review.Spec)
+	if err != nil {
+		return err
+	}
+	var status SubjectAccessReviewStatus
+	if cached, ok := w.responseCache.Get(string(key)); ok {
+		status = cached.(SubjectAccessReviewStatus)
+	} else {
+		result := &SubjectAccessReview{}
+		if err := w.webhook.Post(review, result); err != nil {
+			return err
+		}
+		status = result.Status
+		if status.Allowed {
+			w.responseCache.Add(string(key), status, w.authorizedTTL)
+		} else {
+			w.responseCache.Add(string(key), status, w.unauthorizedTTL)
+		}
+	}
+
+	if status.Allowed {
+		return nil
+	}
+	if len(status.Reason) > 0 {
+		return errors.New(status.Reason)
+	}
+	return errors.New("webhook: request denied")
+}
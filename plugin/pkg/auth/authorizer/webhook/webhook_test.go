@@ -0,0 +1,208 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+// writeKubeConfig writes a kubeconfig file pointing at server and returns its path.
+func writeKubeConfig(t *testing.T, server string) string {
+	f, err := ioutil.TempFile("", "webhook-kubeconfig")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+	fmt.Fprintf(f, `apiVersion: v1
+kind: Config
+clusters:
+- name: remote
+  cluster:
+    server: %s
+users:
+- name: apiserver
+  user: {}
+contexts:
+- name: webhook
+  context:
+    cluster: remote
+    user: apiserver
+current-context: webhook
+`, server)
+	return f.Name()
+}
+
+// fakeAccessService allows requests from members of the admins group, records the last review
+// it received and counts the reviews.
+type fakeAccessService struct {
+	lastSpec SubjectAccessReviewSpec
+	reviews  int
+}
+
+func (s *fakeAccessService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.reviews++
+	var review SubjectAccessReview
+	if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if review.APIVersion != apiVersion || review.Kind != kind {
+		http.Error(w, "unexpected review type", http.StatusBadRequest)
+		return
+	}
+	s.lastSpec = review.Spec
+	if review.Spec.User == "broken" {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	review.Status.Allowed = false
+	review.Status.Reason = "only admins are allowed"
+	for _, group := range review.Spec.Groups {
+		if group == "admins" {
+			review.Status.Allowed = true
+			review.Status.Reason = ""
+		}
+	}
+	json.NewEncoder(w).Encode(review)
+}
+
+func newTestAuthorizer(t *testing.T, authorizedTTL, unauthorizedTTL time.Duration) (*WebhookAuthorizer, *fakeAccessService, func()) {
+	service := &fakeAccessService{}
+	server := httptest.NewServer(service)
+	kubeConfigFile := writeKubeConfig(t, server.URL)
+	cleanup := func() {
+		server.Close()
+		os.Remove(kubeConfigFile)
+	}
+	webhookAuthorizer, err := New(kubeConfigFile, authorizedTTL, unauthorizedTTL)
+	if err != nil {
+		cleanup()
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return webhookAuthorizer, service, cleanup
+}
+
+func TestAuthorizeSendsAttributes(t *testing.T) {
+	webhookAuthorizer, service, cleanup := newTestAuthorizer(t, time.Minute, time.Minute)
+	defer cleanup()
+
+	testCases := []struct {
+		attr         authorizer.Attributes
+		expectedSpec SubjectAccessReviewSpec
+	}{
+		{
+			attr: authorizer.AttributesRecord{
+				User:            &user.DefaultInfo{Name: "alice", Groups: []string{"admins"}},
+				Verb:            "get",
+				Namespace:       "ns1",
+				APIGroup:        "extensions",
+				Resource:        "deployments",
+				Subresource:     "scale",
+				Name:            "web",
+				ResourceRequest: true,
+			},
+			expectedSpec: SubjectAccessReviewSpec{
+				User:   "alice",
+				Groups: []string{"admins"},
+				ResourceAttributes: &ResourceAttributes{
+					Namespace:   "ns1",
+					Verb:        "get",
+					Group:       "extensions",
+					Resource:    "deployments",
+					Subresource: "scale",
+					Name:        "web",
+				},
+			},
+		},
+		{
+			attr: authorizer.AttributesRecord{
+				User: &user.DefaultInfo{Name: "bob"},
+				Verb: "get",
+				Path: "/version",
+			},
+			expectedSpec: SubjectAccessReviewSpec{
+				User:                  "bob",
+				NonResourceAttributes: &NonResourceAttributes{Path: "/version", Verb: "get"},
+			},
+		},
+	}
+	for i, tc := range testCases {
+		webhookAuthorizer.Authorize(tc.attr)
+		if !reflect.DeepEqual(service.lastSpec, tc.expectedSpec) {
+			t.Errorf("%d: expected spec %#v, got %#v", i, tc.expectedSpec, service.lastSpec)
+		}
+	}
+}
+
+func TestAuthorizeCache(t *testing.T) {
+	webhookAuthorizer, service, cleanup := newTestAuthorizer(t, time.Minute, 0)
+	defer cleanup()
+
+	admin := authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "alice", Groups: []string{"admins"}}, Verb: "get", Resource: "pods", ResourceRequest: true}
+	other := authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "bob"}, Verb: "get", Resource: "pods", ResourceRequest: true}
+	broken := authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "broken"}, Verb: "get", Resource: "pods", ResourceRequest: true}
+
+	testCases := []struct {
+		attr            authorizer.Attributes
+		expectAllowed   bool
+		expectedReviews int
+	}{
+		{attr: admin, expectAllowed: true, expectedReviews: 1},
+		// Allowed requests are cached for the authorized TTL.
+		{attr: admin, expectAllowed: true, expectedReviews: 1},
+		{attr: other, expectAllowed: false, expectedReviews: 2},
+		// Denied requests expire immediately with a zero unauthorized TTL.
+		{attr: other, expectAllowed: false, expectedReviews: 3},
+		// Errors deny the request and are not cached.
+		{attr: broken, expectAllowed: false, expectedReviews: 4},
+		{attr: broken, expectAllowed: false, expectedReviews: 5},
+	}
+	for i, tc := range testCases {
+		time.Sleep(time.Millisecond)
+		err := webhookAuthorizer.Authorize(tc.attr)
+		if tc.expectAllowed && err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+		}
+		if !tc.expectAllowed && err == nil {
+			t.Errorf("%d: expected request to be denied", i)
+		}
+		if service.reviews != tc.expectedReviews {
+			t.Errorf("%d: expected %d reviews, got %d", i, tc.expectedReviews, service.reviews)
+		}
+	}
+}
+
+func TestAuthorizeDeniedReason(t *testing.T) {
+	webhookAuthorizer, _, cleanup := newTestAuthorizer(t, time.Minute, time.Minute)
+	defer cleanup()
+
+	err := webhookAuthorizer.Authorize(authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "bob"}, Verb: "get", Path: "/version"})
+	if err == nil || err.Error() != "only admins are allowed" {
+		t.Errorf("expected the reason of the remote service, got %v", err)
+	}
+}
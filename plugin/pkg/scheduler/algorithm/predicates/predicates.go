@@ -22,6 +22,7 @@ import (
 	"k8s.io/kubernetes/pkg/api"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
 
 	"github.com/golang/glog"
@@ -215,12 +216,41 @@ func NewSelectorMatchPredicate(info NodeInfo) algorithm.FitPredicate {
 	return selector.PodSelectorMatches
 }
 
+// PodMatchesNodeLabels checks whether the node satisfies both the node selector and the
+// required node affinity of the pod.
 func PodMatchesNodeLabels(pod *api.Pod, node *api.Node) bool {
-	if len(pod.Spec.NodeSelector) == 0 {
-		return true
+	if len(pod.Spec.NodeSelector) > 0 {
+		selector := labels.SelectorFromSet(pod.Spec.NodeSelector)
+		if !selector.Matches(labels.Set(node.Labels)) {
+			return false
+		}
+	}
+
+	// A nil NodeSelector in the required node affinity matches all nodes, while a
+	// NodeSelector without terms matches no node.
+	affinity := pod.Spec.Affinity
+	if affinity != nil && affinity.NodeAffinity != nil && affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		nodeSelectorTerms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+		glog.V(10).Infof("Match for RequiredDuringSchedulingIgnoredDuringExecution node selector terms %+v", nodeSelectorTerms)
+		return NodeMatchesNodeSelectorTerms(node, nodeSelectorTerms)
 	}
-	selector := labels.SelectorFromSet(pod.Spec.NodeSelector)
-	return selector.Matches(labels.Set(node.Labels))
+	return true
+}
+
+// NodeMatchesNodeSelectorTerms checks whether the node matches any of the terms. The terms
+// are ORed, while the requirements of a single term are ANDed.
+func NodeMatchesNodeSelectorTerms(node *api.Node, nodeSelectorTerms []api.NodeSelectorTerm) bool {
+	for _, req := range nodeSelectorTerms {
+		nodeSelector, err := api.NodeSelectorRequirementsAsSelector(req.MatchExpressions)
+		if err != nil {
+			glog.V(10).Infof("Failed to parse MatchExpressions: %+v, regarding as not match.", req.MatchExpressions)
+			continue
+		}
+		if nodeSelector.Matches(labels.Set(node.Labels)) {
+			return true
+		}
+	}
+	return false
 }
 
 type NodeSelector struct {
@@ -380,6 +410,200 @@ func (s *ServiceAffinity) CheckServiceAffinity(pod *api.Pod, existingPods []*api
 	return affinitySelector.Matches(labels.Set(node.Labels)), nil
 }
 
+type PodAffinityChecker struct {
+	info      NodeInfo
+	podLister algorithm.PodLister
+}
+
+func NewPodAffinityPredicate(info NodeInfo, podLister algorithm.PodLister) algorithm.FitPredicate {
+	checker := &PodAffinityChecker{
+		info:      info,
+		podLister: podLister,
+	}
+	return checker.InterPodAffinityMatches
+}
+
+// InterPodAffinityMatches checks whether the pod can be scheduled onto the node given the
+// required affinity and anti-affinity terms of the pod, and the required anti-affinity
+// terms of the pods that are already running.
+//
+// A required affinity term is met if some pod matching the term runs on a node that has
+// the same value as the given node for the topology key of the term. A required
+// anti-affinity term is met if no such pod exists.
+func (c *PodAffinityChecker) InterPodAffinityMatches(pod *api.Pod, existingPods []*api.Pod, nodeID string) (bool, error) {
+	node, err := c.info.GetNodeInfo(nodeID)
+	if err != nil {
+		return false, err
+	}
+	allPods, err := c.podLister.List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	allPods = filterNonRunningPods(allPods)
+
+	// Pods that are already running may refuse the pod through their own anti-affinity.
+	for _, existingPod := range allPods {
+		if existingPod.Spec.Affinity == nil || existingPod.Spec.Affinity.PodAntiAffinity == nil {
+			continue
+		}
+		for _, term := range existingPod.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			if !PodMatchesAffinityTerm(pod, existingPod, term) {
+				continue
+			}
+			existingNode, err := c.info.GetNodeInfo(existingPod.Spec.NodeName)
+			if err != nil {
+				glog.V(10).Infof("Skipping pod %s while checking anti-affinity: %v", podName(existingPod), err)
+				continue
+			}
+			if NodesHaveSameTopologyKey(node, existingNode, term.TopologyKey) {
+				glog.V(10).Infof("Cannot schedule Pod %+v on Node %v, because it violates the anti-affinity of Pod %v", podName(pod), nodeID, podName(existingPod))
+				return false, nil
+			}
+		}
+	}
+
+	affinity := pod.Spec.Affinity
+	if affinity == nil {
+		return true, nil
+	}
+	if affinity.PodAffinity != nil {
+		for _, term := range affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			if c.anyPodMatchesTermInTopology(pod, allPods, node, term) {
+				continue
+			}
+			// The first pod of a group of pods that are affine to each other has no
+			// pod to be co-located with, so it may go anywhere.
+			if PodMatchesAffinityTerm(pod, pod, term) && !anyPodMatchesTerm(pod, allPods, term) {
+				continue
+			}
+			glog.V(10).Infof("Cannot schedule Pod %+v on Node %v, because it does not satisfy the pod affinity of the pod", podName(pod), nodeID)
+			return false, nil
+		}
+	}
+	if affinity.PodAntiAffinity != nil {
+		for _, term := range affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			if c.anyPodMatchesTermInTopology(pod, allPods, node, term) {
+				glog.V(10).Infof("Cannot schedule Pod %+v on Node %v, because it does not satisfy the pod anti-affinity of the pod", podName(pod), nodeID)
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// anyPodMatchesTermInTopology checks whether any of the given pods matches the term of
+// the pod and runs on a node in the same topology as the given node.
+func (c *PodAffinityChecker) anyPodMatchesTermInTopology(pod *api.Pod, allPods []*api.Pod, node *api.Node, term api.PodAffinityTerm) bool {
+	for _, existingPod := range allPods {
+		if !PodMatchesAffinityTerm(existingPod, pod, term) {
+			continue
+		}
+		existingNode, err := c.info.GetNodeInfo(existingPod.Spec.NodeName)
+		if err != nil {
+			glog.V(10).Infof("Skipping pod %s while checking affinity: %v", podName(existingPod), err)
+			continue
+		}
+		if NodesHaveSameTopologyKey(node, existingNode, term.TopologyKey) {
+			return true
+		}
+	}
+	return false
+}
+
+func anyPodMatchesTerm(pod *api.Pod, allPods []*api.Pod, term api.PodAffinityTerm) bool {
+	for _, existingPod := range allPods {
+		if PodMatchesAffinityTerm(existingPod, pod, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// PodMatchesAffinityTerm checks whether the pod is selected by the affinity term of
+// termOwner. A term without namespaces applies to the namespace of its owner.
+func PodMatchesAffinityTerm(pod, termOwner *api.Pod, term api.PodAffinityTerm) bool {
+	namespaces := sets.NewString(term.Namespaces...)
+	if namespaces.Len() == 0 {
+		namespaces.Insert(termOwner.Namespace)
+	}
+	if !namespaces.Has(pod.Namespace) {
+		return false
+	}
+	return labels.SelectorFromSet(term.LabelSelector).Matches(labels.Set(pod.Labels))
+}
+
+// NodesHaveSameTopologyKey checks whether both nodes have the label with the
+// topology key, with the same value.
+func NodesHaveSameTopologyKey(nodeA, nodeB *api.Node, topologyKey string) bool {
+	if len(topologyKey) == 0 {
+		return false
+	}
+	valueA, ok := nodeA.Labels[topologyKey]
+	if !ok {
+		return false
+	}
+	valueB, ok := nodeB.Labels[topologyKey]
+	return ok && valueA == valueB
+}
+
+type TolerationMatch struct {
+	info NodeInfo
+}
+
+func NewTolerationMatchPredicate(info NodeInfo) algorithm.FitPredicate {
+	tolerationMatch := &TolerationMatch{
+		info: info,
+	}
+	return tolerationMatch.PodToleratesNodeTaints
+}
+
+// PodToleratesNodeTaints checks whether the pod tolerates all the NoSchedule taints of the node.
+// Taints with the PreferNoSchedule effect are only taken into account by the priorities.
+func (t *TolerationMatch) PodToleratesNodeTaints(pod *api.Pod, existingPods []*api.Pod, nodeID string) (bool, error) {
+	node, err := t.info.GetNodeInfo(nodeID)
+	if err != nil {
+		return false, err
+	}
+	for _, taint := range node.Spec.Taints {
+		if taint.Effect != api.TaintEffectNoSchedule {
+			continue
+		}
+		if !TolerationsTolerateTaint(pod.Spec.Tolerations, taint) {
+			glog.V(10).Infof("Cannot schedule Pod %+v on Node %v, because the pod does not tolerate the taint %s=%s:%s", podName(pod), nodeID, taint.Key, taint.Value, taint.Effect)
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// TolerationsTolerateTaint checks whether any of the tolerations tolerates the taint.
+func TolerationsTolerateTaint(tolerations []api.Toleration, taint api.Taint) bool {
+	for i := range tolerations {
+		if TolerationToleratesTaint(tolerations[i], taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// TolerationToleratesTaint checks whether the toleration tolerates the taint. A toleration
+// without an effect tolerates the taint whatever its effect.
+func TolerationToleratesTaint(toleration api.Toleration, taint api.Taint) bool {
+	if len(toleration.Effect) > 0 && toleration.Effect != taint.Effect {
+		return false
+	}
+	if toleration.Key != taint.Key {
+		return false
+	}
+	switch toleration.Operator {
+	case api.TolerationOpExists:
+		return true
+	case api.TolerationOpEqual, "":
+		return toleration.Value == taint.Value
+	}
+	return false
+}
+
 func PodFitsHostPorts(pod *api.Pod, existingPods []*api.Pod, node string) (bool, error) {
 	existingPorts := getUsedPorts(existingPods...)
 	wantPorts := getUsedPorts(pod)
@@ -557,6 +557,150 @@ func TestPodFitsSelector(t *testing.T) {
 			fits: false,
 			test: "node labels are subset",
 		},
+		{
+			pod: &api.Pod{
+				Spec: api.PodSpec{
+					Affinity: &api.Affinity{
+						NodeAffinity: &api.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{
+								NodeSelectorTerms: []api.NodeSelectorTerm{
+									{MatchExpressions: []api.NodeSelectorRequirement{
+										{Key: "zone", Operator: api.NodeSelectorOpNotIn, Values: []string{"us-east-1a"}},
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			labels: map[string]string{"zone": "us-east-1b"},
+			fits:   true,
+			test:   "node affinity with NotIn, value not in the list",
+		},
+		{
+			pod: &api.Pod{
+				Spec: api.PodSpec{
+					Affinity: &api.Affinity{
+						NodeAffinity: &api.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{
+								NodeSelectorTerms: []api.NodeSelectorTerm{
+									{MatchExpressions: []api.NodeSelectorRequirement{
+										{Key: "zone", Operator: api.NodeSelectorOpNotIn, Values: []string{"us-east-1a"}},
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			labels: map[string]string{"zone": "us-east-1a"},
+			fits:   false,
+			test:   "node affinity with NotIn, value in the list",
+		},
+		{
+			pod: &api.Pod{
+				Spec: api.PodSpec{
+					Affinity: &api.Affinity{
+						NodeAffinity: &api.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{
+								NodeSelectorTerms: []api.NodeSelectorTerm{
+									{MatchExpressions: []api.NodeSelectorRequirement{
+										{Key: "cores", Operator: api.NodeSelectorOpGt, Values: []string{"4"}},
+										{Key: "dedicated", Operator: api.NodeSelectorOpDoesNotExist},
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			labels: map[string]string{"cores": "8"},
+			fits:   true,
+			test:   "node affinity with Gt and DoesNotExist, all requirements match",
+		},
+		{
+			pod: &api.Pod{
+				Spec: api.PodSpec{
+					Affinity: &api.Affinity{
+						NodeAffinity: &api.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{
+								NodeSelectorTerms: []api.NodeSelectorTerm{
+									{MatchExpressions: []api.NodeSelectorRequirement{
+										{Key: "cores", Operator: api.NodeSelectorOpLt, Values: []string{"4"}},
+										{Key: "foo", Operator: api.NodeSelectorOpExists},
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			labels: map[string]string{"cores": "2"},
+			fits:   false,
+			test:   "node affinity with Lt and Exists, one requirement does not match",
+		},
+		{
+			pod: &api.Pod{
+				Spec: api.PodSpec{
+					Affinity: &api.Affinity{
+						NodeAffinity: &api.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{
+								NodeSelectorTerms: []api.NodeSelectorTerm{
+									{MatchExpressions: []api.NodeSelectorRequirement{
+										{Key: "foo", Operator: api.NodeSelectorOpIn, Values: []string{"bar"}},
+									}},
+									{MatchExpressions: []api.NodeSelectorRequirement{
+										{Key: "zone", Operator: api.NodeSelectorOpExists},
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			labels: map[string]string{"zone": "us-east-1a"},
+			fits:   true,
+			test:   "node affinity terms are ORed",
+		},
+		{
+			pod: &api.Pod{
+				Spec: api.PodSpec{
+					Affinity: &api.Affinity{
+						NodeAffinity: &api.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{
+								NodeSelectorTerms: []api.NodeSelectorTerm{},
+							},
+						},
+					},
+				},
+			},
+			labels: map[string]string{"foo": "bar"},
+			fits:   false,
+			test:   "node affinity without terms matches no node",
+		},
+		{
+			pod: &api.Pod{
+				Spec: api.PodSpec{
+					NodeSelector: map[string]string{
+						"foo": "bar",
+					},
+					Affinity: &api.Affinity{
+						NodeAffinity: &api.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{
+								NodeSelectorTerms: []api.NodeSelectorTerm{
+									{MatchExpressions: []api.NodeSelectorRequirement{
+										{Key: "zone", Operator: api.NodeSelectorOpExists},
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			labels: map[string]string{"zone": "us-east-1a"},
+			fits:   false,
+			test:   "node selector and node affinity must both match",
+		},
 	}
 	for _, test := range tests {
 		node := api.Node{ObjectMeta: api.ObjectMeta{Labels: test.labels}}
@@ -771,3 +915,211 @@ func TestServiceAffinity(t *testing.T) {
 		}
 	}
 }
+
+func TestInterPodAffinity(t *testing.T) {
+	podLabel := map[string]string{"service": "securityscan"}
+	podLabel2 := map[string]string{"security": "S1"}
+	labels1 := map[string]string{
+		"region": "r1",
+		"zone":   "z11",
+	}
+	labels2 := map[string]string{
+		"region": "r1",
+		"zone":   "z12",
+	}
+	labels3 := map[string]string{
+		"region": "r2",
+		"zone":   "z21",
+	}
+	node1 := api.Node{ObjectMeta: api.ObjectMeta{Name: "machine1", Labels: labels1}}
+	node2 := api.Node{ObjectMeta: api.ObjectMeta{Name: "machine2", Labels: labels2}}
+	node3 := api.Node{ObjectMeta: api.ObjectMeta{Name: "machine3", Labels: labels3}}
+	affinityTo := func(selector map[string]string, topologyKey string) *api.Affinity {
+		return &api.Affinity{
+			PodAffinity: &api.PodAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []api.PodAffinityTerm{
+					{LabelSelector: selector, TopologyKey: topologyKey},
+				},
+			},
+		}
+	}
+	antiAffinityTo := func(selector map[string]string, topologyKey string) *api.Affinity {
+		return &api.Affinity{
+			PodAntiAffinity: &api.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []api.PodAffinityTerm{
+					{LabelSelector: selector, TopologyKey: topologyKey},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		pod  *api.Pod
+		pods []*api.Pod
+		node string
+		fits bool
+		test string
+	}{
+		{
+			pod:  new(api.Pod),
+			node: "machine1",
+			fits: true,
+			test: "nothing scheduled",
+		},
+		{
+			pod:  &api.Pod{Spec: api.PodSpec{Affinity: affinityTo(podLabel, "region")}},
+			pods: []*api.Pod{{Spec: api.PodSpec{NodeName: "machine2"}, ObjectMeta: api.ObjectMeta{Labels: podLabel}}},
+			node: "machine1",
+			fits: true,
+			test: "matching pod in the same region",
+		},
+		{
+			pod:  &api.Pod{Spec: api.PodSpec{Affinity: affinityTo(podLabel, "zone")}},
+			pods: []*api.Pod{{Spec: api.PodSpec{NodeName: "machine2"}, ObjectMeta: api.ObjectMeta{Labels: podLabel}}},
+			node: "machine1",
+			fits: false,
+			test: "matching pod in another zone",
+		},
+		{
+			pod:  &api.Pod{Spec: api.PodSpec{Affinity: affinityTo(podLabel, "region")}},
+			pods: []*api.Pod{{Spec: api.PodSpec{NodeName: "machine1"}, ObjectMeta: api.ObjectMeta{Labels: podLabel, Namespace: "ns1"}}},
+			node: "machine1",
+			fits: false,
+			test: "matching pod in another namespace",
+		},
+		{
+			pod: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Labels: podLabel},
+				Spec:       api.PodSpec{Affinity: affinityTo(podLabel, "region")},
+			},
+			node: "machine3",
+			fits: true,
+			test: "first pod of a group that matches its own affinity",
+		},
+		{
+			pod:  &api.Pod{Spec: api.PodSpec{Affinity: antiAffinityTo(podLabel, "region")}},
+			pods: []*api.Pod{{Spec: api.PodSpec{NodeName: "machine2"}, ObjectMeta: api.ObjectMeta{Labels: podLabel}}},
+			node: "machine1",
+			fits: false,
+			test: "anti-affinity to a pod in the same region",
+		},
+		{
+			pod:  &api.Pod{Spec: api.PodSpec{Affinity: antiAffinityTo(podLabel, "region")}},
+			pods: []*api.Pod{{Spec: api.PodSpec{NodeName: "machine2"}, ObjectMeta: api.ObjectMeta{Labels: podLabel}}},
+			node: "machine3",
+			fits: true,
+			test: "anti-affinity to a pod in another region",
+		},
+		{
+			pod: &api.Pod{ObjectMeta: api.ObjectMeta{Labels: podLabel2}},
+			pods: []*api.Pod{{
+				Spec:       api.PodSpec{NodeName: "machine1", Affinity: antiAffinityTo(podLabel2, "zone")},
+				ObjectMeta: api.ObjectMeta{Labels: podLabel},
+			}},
+			node: "machine1",
+			fits: false,
+			test: "existing pod with anti-affinity to the pod in the same zone",
+		},
+		{
+			pod: &api.Pod{ObjectMeta: api.ObjectMeta{Labels: podLabel2}},
+			pods: []*api.Pod{{
+				Spec:       api.PodSpec{NodeName: "machine1", Affinity: antiAffinityTo(podLabel2, "zone")},
+				ObjectMeta: api.ObjectMeta{Labels: podLabel},
+			}},
+			node: "machine2",
+			fits: true,
+			test: "existing pod with anti-affinity to the pod in another zone",
+		},
+	}
+
+	for _, test := range tests {
+		nodes := []api.Node{node1, node2, node3}
+		fit := PodAffinityChecker{FakeNodeListInfo(nodes), algorithm.FakePodLister(test.pods)}
+		fits, err := fit.InterPodAffinityMatches(test.pod, []*api.Pod{}, test.node)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.test, err)
+		}
+		if fits != test.fits {
+			t.Errorf("%s: expected: %v got %v", test.test, test.fits, fits)
+		}
+	}
+}
+
+func TestPodToleratesTaints(t *testing.T) {
+	dedicatedTaint := api.Taint{Key: "dedicated", Value: "ingress", Effect: api.TaintEffectNoSchedule}
+	tests := []struct {
+		pod    *api.Pod
+		taints []api.Taint
+		fits   bool
+		test   string
+	}{
+		{
+			pod:  new(api.Pod),
+			fits: true,
+			test: "node without taints",
+		},
+		{
+			pod:    new(api.Pod),
+			taints: []api.Taint{dedicatedTaint},
+			fits:   false,
+			test:   "pod without tolerations on a tainted node",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Tolerations: []api.Toleration{
+				{Key: "dedicated", Operator: api.TolerationOpEqual, Value: "ingress", Effect: api.TaintEffectNoSchedule},
+			}}},
+			taints: []api.Taint{dedicatedTaint},
+			fits:   true,
+			test:   "toleration with equal key, value and effect",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Tolerations: []api.Toleration{
+				{Key: "dedicated", Value: "build"},
+			}}},
+			taints: []api.Taint{dedicatedTaint},
+			fits:   false,
+			test:   "toleration with a different value",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Tolerations: []api.Toleration{
+				{Key: "dedicated", Operator: api.TolerationOpExists},
+			}}},
+			taints: []api.Taint{dedicatedTaint},
+			fits:   true,
+			test:   "toleration with operator Exists and no effect",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Tolerations: []api.Toleration{
+				{Key: "dedicated", Operator: api.TolerationOpExists, Effect: api.TaintEffectPreferNoSchedule},
+			}}},
+			taints: []api.Taint{dedicatedTaint},
+			fits:   false,
+			test:   "toleration with a different effect",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Tolerations: []api.Toleration{
+				{Key: "dedicated", Value: "ingress"},
+			}}},
+			taints: []api.Taint{dedicatedTaint, {Key: "gpu", Effect: api.TaintEffectNoSchedule}},
+			fits:   false,
+			test:   "one of several taints not tolerated",
+		},
+		{
+			pod:    new(api.Pod),
+			taints: []api.Taint{{Key: "dedicated", Value: "ingress", Effect: api.TaintEffectPreferNoSchedule}},
+			fits:   true,
+			test:   "PreferNoSchedule taints are ignored",
+		},
+	}
+
+	for _, test := range tests {
+		node := api.Node{ObjectMeta: api.ObjectMeta{Name: "machine1"}, Spec: api.NodeSpec{Taints: test.taints}}
+		fit := TolerationMatch{FakeNodeInfo(node)}
+		fits, err := fit.PodToleratesNodeTaints(test.pod, []*api.Pod{}, "machine1")
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.test, err)
+		}
+		if fits != test.fits {
+			t.Errorf("%s: expected: %v got %v", test.test, test.fits, fits)
+		}
+	}
+}
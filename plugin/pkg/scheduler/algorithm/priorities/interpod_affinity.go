@@ -0,0 +1,121 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
+	schedulerapi "k8s.io/kubernetes/plugin/pkg/scheduler/api"
+)
+
+type InterPodAffinity struct {
+	info predicates.NodeInfo
+}
+
+func NewInterPodAffinityPriority(info predicates.NodeInfo) algorithm.PriorityFunction {
+	interPodAffinity := &InterPodAffinity{
+		info: info,
+	}
+	return interPodAffinity.CalculateInterPodAffinityPriority
+}
+
+// CalculateInterPodAffinityPriority prefers the nodes that satisfy the preferred pod affinity
+// and anti-affinity terms of the pod. For every running pod that matches a term, the weight of
+// the term is added to (affinity) or subtracted from (anti-affinity) the score of each node in
+// the same topology as the node of the running pod. The preferred terms of the running pods
+// that match the pod being scheduled are counted the same way. Scores are then scaled to 0-10.
+func (ipa *InterPodAffinity) CalculateInterPodAffinityPriority(pod *api.Pod, podLister algorithm.PodLister, nodeLister algorithm.NodeLister) (schedulerapi.HostPriorityList, error) {
+	nodes, err := nodeLister.List()
+	if err != nil {
+		return nil, err
+	}
+	allPods, err := podLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{}
+	processTerm := func(term api.PodAffinityTerm, termOwner, podToCheck *api.Pod, existingNode *api.Node, weight int) {
+		if !predicates.PodMatchesAffinityTerm(podToCheck, termOwner, term) {
+			return
+		}
+		for i := range nodes.Items {
+			if predicates.NodesHaveSameTopologyKey(&nodes.Items[i], existingNode, term.TopologyKey) {
+				counts[nodes.Items[i].Name] += weight
+			}
+		}
+	}
+	processTerms := func(affinity *api.Affinity, termOwner, podToCheck *api.Pod, existingNode *api.Node) {
+		if affinity == nil {
+			return
+		}
+		if affinity.PodAffinity != nil {
+			for _, term := range affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+				processTerm(term.PodAffinityTerm, termOwner, podToCheck, existingNode, term.Weight)
+			}
+		}
+		if affinity.PodAntiAffinity != nil {
+			for _, term := range affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+				processTerm(term.PodAffinityTerm, termOwner, podToCheck, existingNode, -term.Weight)
+			}
+		}
+	}
+
+	for _, existingPod := range allPods {
+		if pod.Spec.Affinity == nil && existingPod.Spec.Affinity == nil {
+			continue
+		}
+		if existingPod.Status.Phase == api.PodSucceeded || existingPod.Status.Phase == api.PodFailed {
+			continue
+		}
+		existingNode, err := ipa.info.GetNodeInfo(existingPod.Spec.NodeName)
+		if err != nil {
+			glog.V(10).Infof("Skipping pod %s/%s while computing affinity priority: %v", existingPod.Namespace, existingPod.Name, err)
+			continue
+		}
+		// The preferences of the pod about the running pods.
+		processTerms(pod.Spec.Affinity, pod, existingPod, existingNode)
+		// The preferences of the running pods about the pod.
+		processTerms(existingPod.Spec.Affinity, existingPod, pod, existingNode)
+	}
+
+	maxCount, minCount := 0, 0
+	for i := range nodes.Items {
+		count := counts[nodes.Items[i].Name]
+		if count > maxCount {
+			maxCount = count
+		}
+		if count < minCount {
+			minCount = count
+		}
+	}
+
+	result := []schedulerapi.HostPriority{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		fScore := float64(0)
+		if maxCount-minCount > 0 {
+			fScore = 10 * (float64(counts[node.Name]-minCount) / float64(maxCount-minCount))
+		}
+		result = append(result, schedulerapi.HostPriority{Host: node.Name, Score: int(fScore)})
+		glog.V(10).Infof("%v -> %v: InterPodAffinityPriority, Score: (%d)", pod.Name, node.Name, int(fScore))
+	}
+	return result, nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+	schedulerapi "k8s.io/kubernetes/plugin/pkg/scheduler/api"
+)
+
+type FakeNodeListInfo []api.Node
+
+func (nodes FakeNodeListInfo) GetNodeInfo(nodeName string) (*api.Node, error) {
+	for _, node := range nodes {
+		if node.Name == nodeName {
+			return &node, nil
+		}
+	}
+	return nil, fmt.Errorf("Unable to find node: %s", nodeName)
+}
+
+func TestInterPodAffinityPriority(t *testing.T) {
+	podLabel := map[string]string{"service": "securityscan"}
+	podLabel2 := map[string]string{"security": "S1"}
+	nodes := []api.Node{
+		{ObjectMeta: api.ObjectMeta{Name: "machine1", Labels: map[string]string{"region": "r1", "zone": "z11"}}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine2", Labels: map[string]string{"region": "r1", "zone": "z12"}}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine3", Labels: map[string]string{"region": "r2", "zone": "z21"}}},
+	}
+	preferredTerm := func(selector map[string]string, topologyKey string) []api.WeightedPodAffinityTerm {
+		return []api.WeightedPodAffinityTerm{{
+			Weight:          5,
+			PodAffinityTerm: api.PodAffinityTerm{LabelSelector: selector, TopologyKey: topologyKey},
+		}}
+	}
+	tests := []struct {
+		pod          *api.Pod
+		pods         []*api.Pod
+		expectedList schedulerapi.HostPriorityList
+		test         string
+	}{
+		{
+			pod:          new(api.Pod),
+			pods:         []*api.Pod{{Spec: api.PodSpec{NodeName: "machine1"}, ObjectMeta: api.ObjectMeta{Labels: podLabel}}},
+			expectedList: []schedulerapi.HostPriority{{"machine1", 0}, {"machine2", 0}, {"machine3", 0}},
+			test:         "no affinity",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Affinity: &api.Affinity{
+				PodAffinity: &api.PodAffinity{PreferredDuringSchedulingIgnoredDuringExecution: preferredTerm(podLabel, "region")},
+			}}},
+			pods:         []*api.Pod{{Spec: api.PodSpec{NodeName: "machine1"}, ObjectMeta: api.ObjectMeta{Labels: podLabel}}},
+			expectedList: []schedulerapi.HostPriority{{"machine1", 10}, {"machine2", 10}, {"machine3", 0}},
+			test:         "affinity to a pod, nodes in the same region are preferred",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Affinity: &api.Affinity{
+				PodAntiAffinity: &api.PodAntiAffinity{PreferredDuringSchedulingIgnoredDuringExecution: preferredTerm(podLabel, "region")},
+			}}},
+			pods:         []*api.Pod{{Spec: api.PodSpec{NodeName: "machine1"}, ObjectMeta: api.ObjectMeta{Labels: podLabel}}},
+			expectedList: []schedulerapi.HostPriority{{"machine1", 0}, {"machine2", 0}, {"machine3", 10}},
+			test:         "anti-affinity to a pod, nodes in other regions are preferred",
+		},
+		{
+			pod: &api.Pod{ObjectMeta: api.ObjectMeta{Labels: podLabel2}},
+			pods: []*api.Pod{{
+				Spec: api.PodSpec{NodeName: "machine1", Affinity: &api.Affinity{
+					PodAffinity: &api.PodAffinity{PreferredDuringSchedulingIgnoredDuringExecution: preferredTerm(podLabel2, "zone")},
+				}},
+				ObjectMeta: api.ObjectMeta{Labels: podLabel},
+			}},
+			expectedList: []schedulerapi.HostPriority{{"machine1", 10}, {"machine2", 0}, {"machine3", 0}},
+			test:         "existing pod with affinity to the pod, nodes in the same zone are preferred",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Affinity: &api.Affinity{
+				PodAffinity: &api.PodAffinity{PreferredDuringSchedulingIgnoredDuringExecution: preferredTerm(podLabel, "region")},
+			}}},
+			pods: []*api.Pod{{
+				Spec:       api.PodSpec{NodeName: "machine1"},
+				ObjectMeta: api.ObjectMeta{Labels: podLabel},
+				Status:     api.PodStatus{Phase: api.PodSucceeded},
+			}},
+			expectedList: []schedulerapi.HostPriority{{"machine1", 0}, {"machine2", 0}, {"machine3", 0}},
+			test:         "terminated pods are ignored",
+		},
+	}
+
+	for _, test := range tests {
+		interPodAffinity := InterPodAffinity{info: FakeNodeListInfo(nodes)}
+		list, err := interPodAffinity.CalculateInterPodAffinityPriority(test.pod, algorithm.FakePodLister(test.pods), algorithm.FakeNodeLister(api.NodeList{Items: nodes}))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.test, err)
+		}
+		if !reflect.DeepEqual(test.expectedList, list) {
+			t.Errorf("%s: expected %#v, got %#v", test.test, test.expectedList, list)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+	schedulerapi "k8s.io/kubernetes/plugin/pkg/scheduler/api"
+)
+
+// CalculateNodeAffinityPriority prioritizes nodes according to the preferred node affinity of the pod.
+// Each preferred scheduling term the node matches adds the weight of the term to the score of the node.
+// The node with the highest sum scores 10, the others are scaled down proportionally.
+func CalculateNodeAffinityPriority(pod *api.Pod, podLister algorithm.PodLister, nodeLister algorithm.NodeLister) (schedulerapi.HostPriorityList, error) {
+	nodes, err := nodeLister.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var maxCount int
+	counts := map[string]int{}
+
+	affinity := pod.Spec.Affinity
+	if affinity != nil && affinity.NodeAffinity != nil {
+		for _, preferredSchedulingTerm := range affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			if preferredSchedulingTerm.Weight == 0 {
+				continue
+			}
+
+			nodeSelector, err := api.NodeSelectorRequirementsAsSelector(preferredSchedulingTerm.Preference.MatchExpressions)
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range nodes.Items {
+				node := &nodes.Items[i]
+				if nodeSelector.Matches(labels.Set(node.Labels)) {
+					counts[node.Name] += preferredSchedulingTerm.Weight
+				}
+				if counts[node.Name] > maxCount {
+					maxCount = counts[node.Name]
+				}
+			}
+		}
+	}
+
+	result := []schedulerapi.HostPriority{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		fScore := float64(0)
+		if maxCount > 0 {
+			fScore = 10 * (float64(counts[node.Name]) / float64(maxCount))
+		}
+		result = append(result, schedulerapi.HostPriority{Host: node.Name, Score: int(fScore)})
+		glog.V(10).Infof("%v -> %v: NodeAffinityPriority, Score: (%d)", pod.Name, node.Name, int(fScore))
+	}
+	return result, nil
+}
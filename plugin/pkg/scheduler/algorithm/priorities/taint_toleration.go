@@ -0,0 +1,73 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
+	schedulerapi "k8s.io/kubernetes/plugin/pkg/scheduler/api"
+)
+
+// countIntolerableTaintsPreferNoSchedule counts the PreferNoSchedule taints of the node
+// that are not tolerated by the tolerations.
+func countIntolerableTaintsPreferNoSchedule(taints []api.Taint, tolerations []api.Toleration) int {
+	intolerableTaints := 0
+	for _, taint := range taints {
+		if taint.Effect != api.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !predicates.TolerationsTolerateTaint(tolerations, taint) {
+			intolerableTaints++
+		}
+	}
+	return intolerableTaints
+}
+
+// ComputeTaintTolerationPriority prefers the nodes with the fewest PreferNoSchedule taints
+// that the pod does not tolerate. Nodes whose taints are all tolerated score 10, the node
+// with the most intolerable taints scores 0.
+func ComputeTaintTolerationPriority(pod *api.Pod, podLister algorithm.PodLister, nodeLister algorithm.NodeLister) (schedulerapi.HostPriorityList, error) {
+	nodes, err := nodeLister.List()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{}
+	maxCount := 0
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		count := countIntolerableTaintsPreferNoSchedule(node.Spec.Taints, pod.Spec.Tolerations)
+		counts[node.Name] = count
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+
+	result := []schedulerapi.HostPriority{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		fScore := float64(10)
+		if maxCount > 0 {
+			fScore = 10 * (1 - float64(counts[node.Name])/float64(maxCount))
+		}
+		result = append(result, schedulerapi.HostPriority{Host: node.Name, Score: int(fScore)})
+		glog.V(10).Infof("%v -> %v: TaintTolerationPriority, Score: (%d)", pod.Name, node.Name, int(fScore))
+	}
+	return result, nil
+}
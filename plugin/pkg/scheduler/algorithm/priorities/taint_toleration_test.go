@@ -0,0 +1,88 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+	schedulerapi "k8s.io/kubernetes/plugin/pkg/scheduler/api"
+)
+
+func makeTaintedNode(name string, taints ...api.Taint) api.Node {
+	return api.Node{ObjectMeta: api.ObjectMeta{Name: name}, Spec: api.NodeSpec{Taints: taints}}
+}
+
+func TestTaintTolerationPriority(t *testing.T) {
+	preferIngress := api.Taint{Key: "dedicated", Value: "ingress", Effect: api.TaintEffectPreferNoSchedule}
+	preferGPU := api.Taint{Key: "gpu", Effect: api.TaintEffectPreferNoSchedule}
+	tests := []struct {
+		pod          *api.Pod
+		nodes        []api.Node
+		expectedList schedulerapi.HostPriorityList
+		test         string
+	}{
+		{
+			pod:          new(api.Pod),
+			nodes:        []api.Node{makeTaintedNode("machine1"), makeTaintedNode("machine2")},
+			expectedList: []schedulerapi.HostPriority{{"machine1", 10}, {"machine2", 10}},
+			test:         "nodes without taints",
+		},
+		{
+			pod: new(api.Pod),
+			nodes: []api.Node{
+				makeTaintedNode("machine1"),
+				makeTaintedNode("machine2", preferIngress),
+				makeTaintedNode("machine3", preferIngress, preferGPU),
+			},
+			expectedList: []schedulerapi.HostPriority{{"machine1", 10}, {"machine2", 5}, {"machine3", 0}},
+			test:         "nodes with more intolerable taints are less preferred",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Tolerations: []api.Toleration{
+				{Key: "dedicated", Value: "ingress", Effect: api.TaintEffectPreferNoSchedule},
+			}}},
+			nodes: []api.Node{
+				makeTaintedNode("machine1", preferIngress),
+				makeTaintedNode("machine2", preferGPU),
+			},
+			expectedList: []schedulerapi.HostPriority{{"machine1", 10}, {"machine2", 0}},
+			test:         "tolerated taints are ignored",
+		},
+		{
+			pod: new(api.Pod),
+			nodes: []api.Node{
+				makeTaintedNode("machine1", api.Taint{Key: "dedicated", Value: "ingress", Effect: api.TaintEffectNoSchedule}),
+				makeTaintedNode("machine2"),
+			},
+			expectedList: []schedulerapi.HostPriority{{"machine1", 10}, {"machine2", 10}},
+			test:         "NoSchedule taints are left to the predicates",
+		},
+	}
+
+	for _, test := range tests {
+		list, err := ComputeTaintTolerationPriority(test.pod, algorithm.FakePodLister([]*api.Pod{}), algorithm.FakeNodeLister(api.NodeList{Items: test.nodes}))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.test, err)
+		}
+		if !reflect.DeepEqual(test.expectedList, list) {
+			t.Errorf("%s: expected %#v, got %#v", test.test, test.expectedList, list)
+		}
+	}
+}
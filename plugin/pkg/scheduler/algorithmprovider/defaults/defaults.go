@@ -73,6 +73,20 @@ func defaultPredicates() sets.String {
 		),
 		// Fit is determined by the presence of the Host parameter and a string match
 		factory.RegisterFitPredicate("HostName", predicates.PodFitsHost),
+		// Fit is determined by the required pod affinity and anti-affinity of the pod and of the pods already running.
+		factory.RegisterFitPredicateFactory(
+			"MatchInterPodAffinity",
+			func(args factory.PluginFactoryArgs) algorithm.FitPredicate {
+				return predicates.NewPodAffinityPredicate(args.NodeInfo, args.PodLister)
+			},
+		),
+		// Fit is determined by the tolerations of the pod for the NoSchedule taints of the node.
+		factory.RegisterFitPredicateFactory(
+			"PodToleratesNodeTaints",
+			func(args factory.PluginFactoryArgs) algorithm.FitPredicate {
+				return predicates.NewTolerationMatchPredicate(args.NodeInfo)
+			},
+		),
 	)
 }
 
@@ -92,5 +106,19 @@ func defaultPriorities() sets.String {
 				Weight: 1,
 			},
 		),
+		// prefers nodes according to the preferred pod affinity and anti-affinity of the pod and of the pods already running.
+		factory.RegisterPriorityConfigFactory(
+			"InterPodAffinityPriority",
+			factory.PriorityConfigFactory{
+				Function: func(args factory.PluginFactoryArgs) algorithm.PriorityFunction {
+					return priorities.NewInterPodAffinityPriority(args.NodeInfo)
+				},
+				Weight: 1,
+			},
+		),
+		// prefers nodes that match the preferred node affinity of the pod.
+		factory.RegisterPriorityFunction("NodeAffinityPriority", priorities.CalculateNodeAffinityPriority, 1),
+		// prefers nodes with the fewest PreferNoSchedule taints that the pod does not tolerate.
+		factory.RegisterPriorityFunction("TaintTolerationPriority", priorities.ComputeTaintTolerationPriority, 1),
 	)
 }
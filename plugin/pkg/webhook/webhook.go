@@ -0,0 +1,90 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements a generic client for calling out to remote services
+// configured with a kubeconfig file.
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
+)
+
+// defaultRequestTimeout bounds how long a request to the remote service may take.
+const defaultRequestTimeout = 30 * time.Second
+
+// GenericWebhook posts JSON documents to a remote service and decodes its JSON responses.
+type GenericWebhook struct {
+	url    string
+	client *http.Client
+}
+
+// New creates a GenericWebhook from a kubeconfig file.  The server of the current context's
+// cluster is the URL requests are posted to; the cluster's certificate authority and the
+// user's client certificate or token are used to secure the connection.
+func New(kubeConfigFile string) (*GenericWebhook, error) {
+	config, err := clientcmd.LoadFromFile(kubeConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := clientcmd.ResolveLocalPaths(config); err != nil {
+		return nil, err
+	}
+	clientConfig, err := clientcmd.NewNonInteractiveClientConfig(*config, config.CurrentContext, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport, err := unversioned.TransportFor(clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &GenericWebhook{
+		url:    clientConfig.Host + clientConfig.Prefix,
+		client: &http.Client{Transport: transport, Timeout: defaultRequestTimeout},
+	}, nil
+}
+
+// Post encodes request as JSON, posts it to the remote service and decodes the JSON response
+// into response.  Any status other than 200 is an error.
+func (g *GenericWebhook) Post(request, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	resp, err := g.client.Post(g.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("webhook %s returned status %d: %s", g.url, resp.StatusCode, string(data))
+	}
+	if err := json.Unmarshal(data, response); err != nil {
+		return fmt.Errorf("unable to decode response from webhook %s: %v", g.url, err)
+	}
+	return nil
+}
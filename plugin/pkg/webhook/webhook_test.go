@@ -0,0 +1,107 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+// writeKubeConfig writes a kubeconfig file pointing at server and returns its path.
+func writeKubeConfig(t *testing.T, server string) string {
+	f, err := ioutil.TempFile("", "webhook-kubeconfig")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+	fmt.Fprintf(f, `apiVersion: v1
+kind: Config
+clusters:
+- name: remote
+  cluster:
+    server: %s
+users:
+- name: apiserver
+  user: {}
+contexts:
+- name: webhook
+  context:
+    cluster: remote
+    user: apiserver
+current-context: webhook
+`, server)
+	return f.Name()
+}
+
+type echo struct {
+	Message string `json:"message"`
+}
+
+func TestPost(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		if r.Method != "POST" || r.Header.Get("Content-Type") != "application/json" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		var in echo
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if in.Message == "fail" {
+			http.Error(w, "failed", http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(echo{Message: "re: " + in.Message})
+	}))
+	defer server.Close()
+
+	kubeConfigFile := writeKubeConfig(t, server.URL+"/review")
+	defer os.Remove(kubeConfigFile)
+	gw, err := New(kubeConfigFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := echo{}
+	if err := gw.Post(echo{Message: "hello"}, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Message != "re: hello" {
+		t.Errorf("unexpected response: %#v", out)
+	}
+	if path != "/review" {
+		t.Errorf("expected request to /review, got %q", path)
+	}
+
+	if err := gw.Post(echo{Message: "fail"}, &out); err == nil {
+		t.Errorf("expected an error for a failed request")
+	}
+}
+
+func TestNewMissingFile(t *testing.T) {
+	if _, err := New("/does/not/exist"); err == nil {
+		t.Errorf("expected an error for a missing kubeconfig file")
+	}
+}